@@ -14,9 +14,6 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package v1 chứa định nghĩa schema API cho nhóm API music v1
-// +kubebuilder:object:generate=true
-// +groupName=music.mixcorp.org
 package v1
 
 import (
@@ -37,4 +34,12 @@ var (
 
 	// AddToScheme thêm các kiểu trong group-version này vào scheme được cung cấp.
 	AddToScheme = SchemeBuilder.AddToScheme
+
+	// SchemeGroupVersion là tên mà clientset, lister và informer sinh bởi client-gen tham chiếu tới
+	SchemeGroupVersion = GroupVersion
 )
+
+// Resource trả về GroupResource đủ điều kiện cho resource không kèm group, dùng bởi lister được sinh
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
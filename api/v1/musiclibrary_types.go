@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ vòng đời volume của thư viện, xem internal/controller/musiclibrary_controller.go.
+// - Nếu chưa rõ PVC/PV được dựng thế nào, xem pkg/builder/library.go.
+// - MusicService tham chiếu thư viện qua spec.libraries (xem musicservice_types.go).
+
+// MusicLibrarySpec định nghĩa volume nội dung dùng chung; chỉ được chọn một trong PVC hoặc Bucket
+// +kubebuilder:validation:XValidation:rule="has(self.pvc) != has(self.bucket)",message="exactly one of pvc or bucket must be set"
+type MusicLibrarySpec struct {
+	// PVC tạo một PersistentVolumeClaim nhiều node đọc được để chứa nội dung
+	// +optional
+	PVC *LibraryPVCSource `json:"pvc,omitempty"`
+
+	// Bucket mount một bucket object storage thông qua CSI driver (PersistentVolume tĩnh)
+	// +optional
+	Bucket *LibraryBucketSource `json:"bucket,omitempty"`
+}
+
+// LibraryPVCSource định nghĩa PVC do operator tạo cho thư viện
+type LibraryPVCSource struct {
+	// Size là kích thước PVC (ví dụ: "500Gi")
+	// +kubebuilder:validation:MinLength=1
+	Size string `json:"size"`
+
+	// StorageClassName là StorageClass hỗ trợ ReadWriteMany/ReadOnlyMany (ví dụ: NFS, CephFS)
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// AccessMode là chế độ truy cập của PVC (mặc định ReadWriteMany để có thể nạp nội dung)
+	// +kubebuilder:validation:Enum=ReadWriteMany;ReadOnlyMany
+	// +kubebuilder:default=ReadWriteMany
+	// +optional
+	AccessMode corev1.PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+}
+
+// LibraryBucketSource định nghĩa bucket được mount qua CSI driver
+type LibraryBucketSource struct {
+	// Driver là tên CSI driver hỗ trợ bucket (ví dụ: "s3.csi.aws.com")
+	// +kubebuilder:validation:MinLength=1
+	Driver string `json:"driver"`
+
+	// Name là tên bucket, dùng làm volumeHandle của PersistentVolume
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// VolumeAttributes là các thuộc tính bổ sung truyền cho CSI driver (ví dụ: region, prefix)
+	// +optional
+	VolumeAttributes map[string]string `json:"volumeAttributes,omitempty"`
+
+	// Capacity là dung lượng khai báo cho PersistentVolume (mặc định "1Ti"; bucket không giới hạn thực tế)
+	// +optional
+	Capacity string `json:"capacity,omitempty"`
+}
+
+// MusicLibraryStatus định nghĩa trạng thái quan sát được của MusicLibrary
+type MusicLibraryStatus struct {
+	// Phase biểu thị trạng thái hiện tại của volume
+	// +kubebuilder:validation:Enum=Pending;Bound;Terminating;Failed
+	Phase string `json:"phase,omitempty"`
+
+	// ClaimName là tên PVC mà các MusicService mount
+	ClaimName string `json:"claimName,omitempty"`
+
+	// Consumers là danh sách MusicService đang mount thư viện
+	// +optional
+	Consumers []string `json:"consumers,omitempty"`
+
+	// Conditions thể hiện các quan sát mới nhất về trạng thái của MusicLibrary
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Claim",type="string",JSONPath=".status.claimName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MusicLibrary là schema cho API musiclibraries: một volume nội dung dùng chung,
+// được mount chỉ-đọc vào pod ứng dụng của các MusicService tham chiếu tới nó
+type MusicLibrary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MusicLibrarySpec   `json:"spec,omitempty"`
+	Status MusicLibraryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MusicLibraryList chứa danh sách MusicLibrary
+type MusicLibraryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MusicLibrary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MusicLibrary{}, &MusicLibraryList{})
+}
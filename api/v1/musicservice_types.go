@@ -17,37 +17,184 @@ limitations under the License.
 package v1
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Hướng dẫn đọc nhanh:
 // - Nếu chưa rõ luồng reconcile, xem internal/controller/musicservice_controller.go.
-// - Nếu chưa rõ cách tạo tài nguyên từ spec, xem internal/builder/resource_builder.go.
+// - Nếu chưa rõ cách tạo tài nguyên từ spec, xem pkg/builder/resource_builder.go.
 // - Nếu chưa rõ autoscaling/HPA, xem internal/reconciler/app.go.
 
 // StreamingSpec định nghĩa cấu hình streaming
 type StreamingSpec struct {
-	// Bitrate cho streaming âm thanh (ví dụ: "320k", "192k")
-	// +kubebuilder:validation:MinLength=1
+	// Bitrate cho streaming âm thanh tính bằng kbit/s (ví dụ: "320k", "192k", "1411k")
+	// +kubebuilder:validation:Pattern=`^[1-9][0-9]{0,3}k$`
 	Bitrate string `json:"bitrate"`
 
 	// MaxConnections là số kết nối đồng thời tối đa cho streaming
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=10000
 	MaxConnections int32 `json:"maxConnections"`
+
+	// Drain bật rút kết nối trước khi giảm replica: pod sắp bị xóa được đưa ra khỏi Service
+	// và chỉ bị xóa khi hết kết nối đang phát hoặc hết thời gian chờ
+	// +optional
+	Drain *DrainSpec `json:"drain,omitempty"`
+}
+
+// DrainSpec định nghĩa cách rút kết nối khỏi pod ứng dụng khi giảm replica
+type DrainSpec struct {
+	// TimeoutSeconds là thời gian tối đa chờ các kết nối đang phát kết thúc;
+	// cũng là terminationGracePeriodSeconds của pod cho các lần xóa pod không qua operator (ví dụ: HPA)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:default=300
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
 }
 
 // StorageSpec định nghĩa yêu cầu lưu trữ
+// +kubebuilder:validation:XValidation:rule="!has(self.accessMode) || self.accessMode != 'ReadWriteMany' || !has(self.updatePolicy) || self.updatePolicy == 'Resize'",message="accessMode ReadWriteMany only supports updatePolicy Resize"
 type StorageSpec struct {
 	// Kích thước persistent volume (ví dụ: "10Gi", "100Gi")
 	// +kubebuilder:validation:MinLength=1
 	Size string `json:"size"`
 
 	// UpdatePolicy kiểm soát cách áp dụng thay đổi kích thước lưu trữ
-	// +kubebuilder:validation:Enum=Resize;Recreate
+	// Migrate chỉ áp dụng cho spec.storage: co nhỏ bằng cách sao lưu, tạo lại PVC rồi khôi phục dữ liệu
+	// +kubebuilder:validation:Enum=Resize;Recreate;Migrate
 	// +optional
 	UpdatePolicy StorageUpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// AccessMode chọn cách cấp PVC music-data cho pod ứng dụng (mặc định ReadWriteOnce):
+	// ReadWriteOnce tạo PVC riêng cho từng pod qua volumeClaimTemplates,
+	// ReadWriteMany dùng một PVC chung cho mọi replica (NFS/CephFS) để cả fleet phục vụ cùng một catalog.
+	// Đổi chế độ sẽ dừng ứng dụng và chép dữ liệu sang PVC của chế độ mới; PVC cũ được giữ lại.
+	// Chỉ áp dụng cho spec.storage
+	// +kubebuilder:validation:Enum=ReadWriteOnce;ReadWriteMany
+	// +optional
+	AccessMode StorageAccessMode `json:"accessMode,omitempty"`
+
+	// StorageClassName là StorageClass của PVC music-data dùng chung, thường là class hỗ trợ ReadWriteMany
+	// Chỉ áp dụng khi AccessMode là ReadWriteMany
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// VolumeMode là volumeMode của các PVC được tạo, để khớp với PV dựng sẵn khai báo volumeMode tường minh.
+	// Chỉ hỗ trợ Filesystem vì music-data và db-data được mount dạng thư mục
+	// +kubebuilder:validation:Enum=Filesystem
+	// +optional
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+
+	// DataSource khởi tạo PVC mới từ VolumeSnapshot hoặc PVC khác, ví dụ khôi phục catalog từ snapshot.
+	// Chỉ áp dụng khi PVC được tạo lần đầu; mỗi pod nhận một bản sao riêng
+	// +optional
+	DataSource *corev1.TypedLocalObjectReference `json:"dataSource,omitempty"`
+
+	// Selector chỉ bind PVC vào các PV dựng sẵn có label khớp
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// VolumeSnapshotClassName ghi đè spec.snapshots.volumeSnapshotClassName cho PVC của thành phần này,
+	// khi dữ liệu ứng dụng và cơ sở dữ liệu nằm trên các CSI driver khác nhau
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+
+	// ClaimLabels được gắn lên mọi PVC sinh ra từ volumeClaimTemplates, ví dụ selector của công cụ backup/snapshot
+	// +optional
+	ClaimLabels map[string]string `json:"claimLabels,omitempty"`
+
+	// ClaimAnnotations được gắn lên mọi PVC sinh ra từ volumeClaimTemplates, ví dụ tag cost-center
+	// +optional
+	ClaimAnnotations map[string]string `json:"claimAnnotations,omitempty"`
+
+	// Cache thêm volume tạm cho dữ liệu transcode/cache, tách khỏi PVC music-data
+	// Chỉ áp dụng cho pod ứng dụng (spec.storage)
+	// +optional
+	Cache *CacheVolumeSpec `json:"cache,omitempty"`
+
+	// AutoGrow tự mở rộng PVC khi dung lượng đã dùng (đọc từ kubelet) vượt ngưỡng,
+	// trước khi music-data hoặc db-data đầy. StorageClass phải cho phép mở rộng volume
+	// +optional
+	AutoGrow *StorageAutoGrowSpec `json:"autoGrow,omitempty"`
+
+	// AlmostFullPercent là phần trăm dung lượng đã dùng để đặt condition StorageAlmostFull
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=90
+	// +optional
+	AlmostFullPercent int32 `json:"almostFullPercent,omitempty"`
+}
+
+// StorageAutoGrowSpec định nghĩa cách PVC tự mở rộng theo mức sử dụng
+type StorageAutoGrowSpec struct {
+	// ThresholdPercent là phần trăm dung lượng đã dùng để bắt đầu mở rộng PVC
+	// +kubebuilder:validation:Minimum=50
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default=80
+	// +optional
+	ThresholdPercent int32 `json:"thresholdPercent,omitempty"`
+
+	// Step là dung lượng cộng thêm mỗi lần mở rộng, ví dụ "5Gi"
+	// +kubebuilder:validation:MinLength=1
+	Step string `json:"step"`
+
+	// MaxSize là kích thước tối đa PVC được tự mở rộng tới
+	// +kubebuilder:validation:MinLength=1
+	MaxSize string `json:"maxSize"`
+}
+
+// SnapshotSpec định nghĩa cách operator chụp VolumeSnapshot (snapshot.storage.k8s.io/v1)
+type SnapshotSpec struct {
+	// BeforeRecreate chụp VolumeSnapshot mọi PVC và chờ readyToUse trước khi updatePolicy Recreate xóa chúng;
+	// snapshot không có owner reference nên vẫn còn sau khi xóa MusicService
+	// +optional
+	BeforeRecreate bool `json:"beforeRecreate,omitempty"`
+
+	// VolumeSnapshotClassName là VolumeSnapshotClass mặc định; bỏ trống để dùng class mặc định của cluster.
+	// spec.storage.volumeSnapshotClassName và spec.database.storage.volumeSnapshotClassName ghi đè theo thành phần
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// StorageAccessMode định nghĩa cách cấp PVC music-data cho các replica
+type StorageAccessMode string
+
+const (
+	// StorageAccessModeReadWriteOnce tạo PVC riêng cho từng pod
+	StorageAccessModeReadWriteOnce StorageAccessMode = "ReadWriteOnce"
+	// StorageAccessModeReadWriteMany dùng một PVC ReadWriteMany chung cho mọi pod
+	StorageAccessModeReadWriteMany StorageAccessMode = "ReadWriteMany"
+)
+
+// CacheMedium định nghĩa nơi lưu volume cache
+type CacheMedium string
+
+const (
+	// CacheMediumDisk lưu cache trên đĩa của node
+	CacheMediumDisk CacheMedium = "Disk"
+	// CacheMediumMemory lưu cache trên tmpfs, tính vào memory limit của container
+	CacheMediumMemory CacheMedium = "Memory"
+)
+
+// CacheVolumeSpec định nghĩa volume cache dạng emptyDir cho pod ứng dụng
+type CacheVolumeSpec struct {
+	// Size giới hạn dung lượng của volume cache (ví dụ: "2Gi")
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// Medium là nơi lưu cache (mặc định Disk)
+	// +kubebuilder:validation:Enum=Disk;Memory
+	// +optional
+	Medium CacheMedium `json:"medium,omitempty"`
+
+	// MountPath là đường dẫn mount volume cache trong container (mặc định /cache)
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
 }
 
 // StorageUpdatePolicy định nghĩa hành vi khi kích thước lưu trữ thay đổi
@@ -58,10 +205,48 @@ const (
 	StorageUpdatePolicyResize StorageUpdatePolicy = "Resize"
 	// StorageUpdatePolicyRecreate xóa và tạo lại PVC cùng pod
 	StorageUpdatePolicyRecreate StorageUpdatePolicy = "Recreate"
+	// StorageUpdatePolicyMigrate co nhỏ PVC bằng quy trình sao lưu - tạo lại - khôi phục - kiểm tra
+	StorageUpdatePolicyMigrate StorageUpdatePolicy = "Migrate"
+)
+
+// QoSMode định nghĩa lớp QoS mong muốn cho pod ứng dụng và cơ sở dữ liệu
+type QoSMode string
+
+const (
+	// QoSBurstable giữ nguyên requests/limits như khai báo
+	QoSBurstable QoSMode = "Burstable"
+	// QoSGuaranteed đặt requests bằng limits cho CPU và bộ nhớ của mọi container
+	QoSGuaranteed QoSMode = "Guaranteed"
 )
 
+// GPUSpec định nghĩa extended resource và ràng buộc lập lịch cho encode bằng phần cứng
+type GPUSpec struct {
+	// ResourceName là tên extended resource do device plugin cung cấp (mặc định nvidia.com/gpu)
+	// +kubebuilder:default="nvidia.com/gpu"
+	// +optional
+	ResourceName corev1.ResourceName `json:"resourceName,omitempty"`
+
+	// Count là số thiết bị mỗi pod ứng dụng yêu cầu
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// NodeSelector chọn node có GPU (ví dụ nvidia.com/gpu.present: "true")
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations cho phép pod chạy trên node GPU đã bị taint
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
 // AutoscalingSpec định nghĩa cấu hình autoscaling
 type AutoscalingSpec struct {
+	// Enabled bật/tắt autoscaling mà không cần xóa cấu hình (mặc định bật)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// MinReplicas là số replica tối thiểu
 	// +kubebuilder:validation:Minimum=1
 	MinReplicas int32 `json:"minReplicas"`
@@ -83,6 +268,8 @@ type AutoscalingSpec struct {
 }
 
 // DatabaseSpec định nghĩa cấu hình cơ sở dữ liệu
+// +kubebuilder:validation:XValidation:rule="!has(self.rootPassword) || !has(self.rootPasswordSecretRef)",message="rootPassword and rootPasswordSecretRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.storage) || !has(self.storage.updatePolicy) || self.storage.updatePolicy != 'Migrate'",message="updatePolicy Migrate is only supported for spec.storage"
 type DatabaseSpec struct {
 	// Enabled cho biết có triển khai cơ sở dữ liệu hay không
 	Enabled bool `json:"enabled"`
@@ -97,18 +284,95 @@ type DatabaseSpec struct {
 	// +optional
 	Image string `json:"image,omitempty"`
 
+	// Engine là loại cơ sở dữ liệu, quyết định container, cổng, probe và script cấu hình của master/replica;
+	// không đổi được sau khi tạo vì data directory của engine này không dùng được cho engine khác
+	// +kubebuilder:validation:Enum=mariadb;mysql;postgresql
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="engine is immutable"
+	// +kubebuilder:default=mariadb
+	// +optional
+	Engine DatabaseEngine `json:"engine,omitempty"`
+
+	// StartupTimeoutSeconds bật startupProbe cho container MariaDB để liveness không giết pod
+	// trong lúc InnoDB recovery hoặc khởi tạo data directory lâu; để trống sẽ không dùng startupProbe
+	// +kubebuilder:validation:Minimum=10
+	// +optional
+	StartupTimeoutSeconds int32 `json:"startupTimeoutSeconds,omitempty"`
+
+	// SafeToEvict đặt annotation cluster-autoscaler.kubernetes.io/safe-to-evict trên pod master, replica và Galera;
+	// false để cluster-autoscaler không bao giờ evict node cơ sở dữ liệu khi thu hồi node, để trống dùng quy tắc mặc định
+	// +optional
+	SafeToEvict *bool `json:"safeToEvict,omitempty"`
+
+	// Probes điều chỉnh kiểu và thời gian readiness/liveness probe của container MariaDB,
+	// ví dụ nới timeout trên storage chậm để liveness không khởi động lại pod đang recovery
+	// +optional
+	Probes *DatabaseProbesSpec `json:"probes,omitempty"`
+
+	// AuditLog bật plugin server_audit của MariaDB trên master, replica và Galera để ghi lại kết nối
+	// và truy vấn DDL/DML theo user; log được sidecar đẩy ra stdout cho hệ thống thu thập log của cluster
+	// +optional
+	AuditLog *DatabaseAuditLogSpec `json:"auditLog,omitempty"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container MariaDB của master, replica và Galera
+	// cùng init container sinh cấu hình; đổi giá trị sẽ khởi động lại lần lượt các pod cơ sở dữ liệu
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// MinReadySeconds là số giây pod cơ sở dữ liệu phải Ready liên tục trước khi rollout chuyển sang pod kế tiếp
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// ImagePullPolicy áp dụng cho mọi container dùng image cơ sở dữ liệu
+	// (master, replica, Galera, init container và Job sao lưu/khôi phục); để trống sẽ dùng mặc định của Kubernetes
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// CharacterSet là character-set-server của MariaDB, ví dụ utf8mb4 để lưu đủ ký tự trong metadata bài hát
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+$`
+	// +optional
+	CharacterSet string `json:"characterSet,omitempty"`
+
+	// Collation là collation-server, phải thuộc CharacterSet nếu cả hai được khai báo (ví dụ utf8mb4_unicode_ci)
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	// +optional
+	Collation string `json:"collation,omitempty"`
+
+	// TimeZone là default_time_zone: SYSTEM, độ lệch như "+07:00" hoặc tên IANA như "Asia/Ho_Chi_Minh"
+	// (tên IANA dùng bảng time zone mà image MariaDB nạp khi khởi tạo data directory)
+	// +kubebuilder:validation:Pattern=`^(SYSTEM|[+-][0-9]{2}:[0-5][0-9]|[A-Za-z][A-Za-z0-9_+-]*(/[A-Za-z0-9_+-]+)*)$`
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
 	// Storage định nghĩa cấu hình lưu trữ của cơ sở dữ liệu
 	// +optional
 	Storage *StorageSpec `json:"storage,omitempty"`
 
-	// RootPassword là mật khẩu root của cơ sở dữ liệu (nên dùng secret trong production)
+	// RootPassword là mật khẩu root của cơ sở dữ liệu, lưu dạng văn bản thuần trong MusicService.
+	// Deprecated: dùng RootPasswordSecretRef; field này sẽ bị gỡ bỏ và webhook trả cảnh báo khi nó được đặt
 	// +optional
 	RootPassword string `json:"rootPassword,omitempty"`
 
+	// RootPasswordSecretRef trỏ tới key chứa mật khẩu root trong Secret cùng namespace với pod cơ sở dữ liệu;
+	// pod đọc mật khẩu qua secretKeyRef nên giá trị không xuất hiện trong MusicService hay pod spec
+	// +optional
+	RootPasswordSecretRef *corev1.SecretKeySelector `json:"rootPasswordSecretRef,omitempty"`
+
 	// Replication định nghĩa cấu hình replication giữa master và replica
 	// +optional
 	Replication *DatabaseReplicationSpec `json:"replication,omitempty"`
 
+	// InjectEnv thêm DB_HOST, DB_PORT, DB_NAME, DB_USER, DB_PASSWORD vào container music-service,
+	// lấy từ Secret thông tin kết nối <name>-db-conn
+	// +optional
+	InjectEnv bool `json:"injectEnv,omitempty"`
+
+	// ReadFallbackToMaster trỏ Service db-read về master khi replicas = 0 hoặc không có replica nào sẵn sàng
+	// để lưu lượng đọc vẫn được phục vụ thay vì không có endpoint (không áp dụng cho Galera)
+	// +optional
+	ReadFallbackToMaster bool `json:"readFallbackToMaster,omitempty"`
+
 	// Autoscaling định nghĩa cấu hình autoscaling cho replica của cơ sở dữ liệu
 	// +optional
 	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
@@ -117,6 +381,230 @@ type DatabaseSpec struct {
 	// Khi bật, tất cả các node ngang hàng; nếu node master chết thì slave sẽ được đưa lên làm primary
 	// +optional
 	HighAvailability *DatabaseHighAvailabilitySpec `json:"highAvailability,omitempty"`
+
+	// Backup cấu hình sao lưu định kỳ cho cơ sở dữ liệu
+	// +optional
+	Backup *DatabaseBackupSpec `json:"backup,omitempty"`
+
+	// InitFrom khởi tạo dữ liệu từ bản sao lưu của một MusicService khác (ví dụ: tạo staging từ production)
+	// Việc khôi phục chỉ chạy một lần, trước khi thiết lập replication
+	// +optional
+	InitFrom *DatabaseInitFromSpec `json:"initFrom,omitempty"`
+
+	// InitScriptsConfigMap là tên ConfigMap chứa script .sql/.sql.gz/.sh được mount vào /docker-entrypoint-initdb.d
+	// của master (ConfigMap phải nằm cùng namespace với pod cơ sở dữ liệu); image MariaDB chỉ chạy các script này
+	// ở lần khởi động đầu tiên, khi data directory còn trống
+	// +optional
+	InitScriptsConfigMap string `json:"initScriptsConfigMap,omitempty"`
+}
+
+// CloneAllowedNamespacesAnnotation là annotation trên MusicService nguồn liệt kê các namespace
+// (phân tách bằng dấu phẩy, "*" cho tất cả) được phép clone dữ liệu của nó
+const CloneAllowedNamespacesAnnotation = "music.mixcorp.org/clone-allowed-namespaces"
+
+// RotateReplicationCredentialsAnnotation yêu cầu xoay vòng thông tin đăng nhập replication:
+// mỗi lần giá trị annotation thay đổi (ví dụ đặt thành thời điểm hiện tại) operator xoay vòng một lần
+const RotateReplicationCredentialsAnnotation = "music.mixcorp.org/rotate-replication-credentials"
+
+// DatabaseInitFromSpec định nghĩa nguồn dữ liệu ban đầu cho cơ sở dữ liệu
+type DatabaseInitFromSpec struct {
+	// MusicService là tên MusicService nguồn có cấu hình backup tới PVC
+	// +kubebuilder:validation:MinLength=1
+	MusicService string `json:"musicService"`
+
+	// Namespace là namespace của MusicService nguồn; để trống nghĩa là cùng namespace
+	// Clone khác namespace chỉ hỗ trợ sao lưu Logical và yêu cầu MusicService nguồn cấp quyền
+	// qua annotation music.mixcorp.org/clone-allowed-namespaces
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Archive là tên file sao lưu cần khôi phục; để trống sẽ dùng bản mới nhất
+	// +optional
+	Archive string `json:"archive,omitempty"`
+}
+
+// DatabaseBackupSpec định nghĩa cấu hình sao lưu định kỳ
+type DatabaseBackupSpec struct {
+	// Enabled bật/tắt CronJob sao lưu
+	Enabled bool `json:"enabled"`
+
+	// Schedule là lịch chạy sao lưu theo cú pháp cron 5 trường hoặc macro @daily/@hourly/... (ví dụ: "0 3 * * *");
+	// múi giờ đặt qua TimeZone thay vì tiền tố TZ= trong lịch
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('TZ=') && !self.startsWith('CRON_TZ=')",message="set the time zone with timeZone instead of a TZ= prefix"
+	Schedule string `json:"schedule"`
+
+	// TimeZone là múi giờ IANA của Schedule (ví dụ: "Asia/Ho_Chi_Minh"); mặc định theo múi giờ của kube-controller-manager
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Method chọn phương thức sao lưu: Logical (mysqldump) hoặc Physical (mariabackup)
+	// +kubebuilder:validation:Enum=Logical;Physical
+	// +kubebuilder:default=Logical
+	// +optional
+	Method BackupMethod `json:"method,omitempty"`
+
+	// Retention là số bản dump được giữ lại, các bản cũ hơn sẽ bị xoay vòng (mặc định 7)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Retention *int32 `json:"retention,omitempty"`
+
+	// Destination định nghĩa nơi lưu bản sao lưu
+	Destination BackupDestinationSpec `json:"destination"`
+}
+
+// BackupMethod định nghĩa phương thức sao lưu cơ sở dữ liệu
+type BackupMethod string
+
+const (
+	// BackupMethodLogical dump dữ liệu dạng SQL bằng mysqldump
+	BackupMethodLogical BackupMethod = "Logical"
+	// BackupMethodPhysical sao chép file dữ liệu InnoDB bằng mariabackup
+	BackupMethodPhysical BackupMethod = "Physical"
+)
+
+// DatabaseEngine là loại cơ sở dữ liệu, trùng tên provider trong pkg/database
+type DatabaseEngine string
+
+const (
+	// DatabaseEngineMariaDB là MariaDB (mặc định), hỗ trợ đủ Galera, sao lưu, audit log và xoay vòng credential
+	DatabaseEngineMariaDB DatabaseEngine = "mariadb"
+	// DatabaseEngineMySQL là MySQL với replication theo GTID
+	DatabaseEngineMySQL DatabaseEngine = "mysql"
+	// DatabaseEnginePostgreSQL là PostgreSQL với streaming replication qua pg_basebackup
+	DatabaseEnginePostgreSQL DatabaseEngine = "postgresql"
+)
+
+// DatabaseProbeHandler định nghĩa cách probe kiểm tra MariaDB
+type DatabaseProbeHandler string
+
+const (
+	// DatabaseProbePing chạy lệnh ping của engine, ví dụ mysqladmin ping (mặc định)
+	DatabaseProbePing DatabaseProbeHandler = "Ping"
+	// DatabaseProbeQuery chạy SELECT 1 qua client mysql, phát hiện server nhận kết nối nhưng không thực thi được truy vấn
+	DatabaseProbeQuery DatabaseProbeHandler = "Query"
+	// DatabaseProbeTCP chỉ mở kết nối TCP tới cổng của engine (3306 với MariaDB), nhẹ nhất và không cần fork client trong container
+	DatabaseProbeTCP DatabaseProbeHandler = "TCP"
+)
+
+// AuditLogEvent là một loại sự kiện của plugin server_audit
+// +kubebuilder:validation:Enum=CONNECT;QUERY;TABLE;QUERY_DDL;QUERY_DML;QUERY_DML_NO_SELECT;QUERY_DCL
+type AuditLogEvent string
+
+// DatabaseAuditLogSpec định nghĩa cấu hình plugin server_audit và sidecar chuyển log
+// +kubebuilder:validation:XValidation:rule="!(has(self.includeUsers) && has(self.excludeUsers))",message="includeUsers and excludeUsers are mutually exclusive"
+type DatabaseAuditLogSpec struct {
+	// Enabled bật/tắt audit log; đổi giá trị sẽ khởi động lại lần lượt các pod cơ sở dữ liệu
+	Enabled bool `json:"enabled"`
+
+	// Events là các loại sự kiện được ghi (server_audit_events); mặc định CONNECT, QUERY_DDL và QUERY_DML
+	// +optional
+	Events []AuditLogEvent `json:"events,omitempty"`
+
+	// IncludeUsers chỉ ghi sự kiện của các user này (server_audit_incl_users)
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_.-]+$`
+	// +optional
+	IncludeUsers []string `json:"includeUsers,omitempty"`
+
+	// ExcludeUsers bỏ qua sự kiện của các user này (server_audit_excl_users), ví dụ user của probe hay exporter
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_.-]+$`
+	// +optional
+	ExcludeUsers []string `json:"excludeUsers,omitempty"`
+
+	// FileRotateSize là kích thước file log trước khi xoay vòng, ví dụ "100Mi" (mặc định 1000000 byte của plugin)
+	// +optional
+	FileRotateSize string `json:"fileRotateSize,omitempty"`
+
+	// FileRotations là số file log cũ được giữ lại trong volume tạm của pod
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	FileRotations *int32 `json:"fileRotations,omitempty"`
+
+	// ShipperImage là image của sidecar đọc file audit và ghi ra stdout (mặc định busybox:1.36)
+	// +optional
+	ShipperImage string `json:"shipperImage,omitempty"`
+
+	// ShipperResources là tài nguyên tính toán của sidecar chuyển log
+	// +optional
+	ShipperResources *corev1.ResourceRequirements `json:"shipperResources,omitempty"`
+}
+
+// DatabaseProbesSpec định nghĩa readiness/liveness probe của container MariaDB
+type DatabaseProbesSpec struct {
+	// Handler là cách kiểm tra dùng cho liveness, startup và readiness của master/Galera.
+	// Readiness của replica luôn kiểm tra độ trễ replication khi bật replication
+	// +kubebuilder:validation:Enum=Ping;Query;TCP
+	// +kubebuilder:default=Ping
+	// +optional
+	Handler DatabaseProbeHandler `json:"handler,omitempty"`
+
+	// Readiness ghi đè thời gian của readiness probe (mặc định initialDelay 10s, period 10s)
+	// +optional
+	Readiness *ProbeTimingSpec `json:"readiness,omitempty"`
+
+	// Liveness ghi đè thời gian của liveness probe (mặc định initialDelay 30s, period 20s)
+	// +optional
+	Liveness *ProbeTimingSpec `json:"liveness,omitempty"`
+}
+
+// ProbeTimingSpec định nghĩa các ngưỡng thời gian của một probe; field bỏ trống giữ giá trị mặc định
+type ProbeTimingSpec struct {
+	// InitialDelaySeconds là thời gian chờ trước lần probe đầu tiên
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+
+	// PeriodSeconds là khoảng cách giữa hai lần probe
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+
+	// TimeoutSeconds là thời gian tối đa của một lần probe (mặc định 1)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// FailureThreshold là số lần thất bại liên tiếp trước khi pod bị coi là hỏng (mặc định 3)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// BackupDestinationSpec định nghĩa đích lưu trữ của bản sao lưu
+type BackupDestinationSpec struct {
+	// PVC lưu bản sao lưu vào một PersistentVolumeClaim do operator tạo,
+	// dùng cho cluster không có object storage
+	// +optional
+	PVC *BackupPVCDestination `json:"pvc,omitempty"`
+
+	// ObjectStorage sao chép các bản sao lưu trong PVC lên một bucket của MinIO trong cluster (spec.objectStorage)
+	// sau mỗi lần sao lưu; bản bị xoay vòng khỏi PVC cũng bị xóa khỏi bucket
+	// +optional
+	ObjectStorage *BackupObjectStorageDestination `json:"objectStorage,omitempty"`
+}
+
+// BackupObjectStorageDestination định nghĩa bucket nhận bản sao của các bản sao lưu
+type BackupObjectStorageDestination struct {
+	// Bucket là tên bucket, được tạo nếu chưa có
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
+	Bucket string `json:"bucket"`
+
+	// Prefix là thư mục trong bucket (mặc định là tên MusicService)
+	// +kubebuilder:validation:Pattern=`^[^/].*[^/]$|^[^/]$`
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// BackupPVCDestination định nghĩa PVC dùng để lưu bản sao lưu
+type BackupPVCDestination struct {
+	// Size là kích thước PVC sao lưu (ví dụ: "20Gi")
+	// +kubebuilder:validation:MinLength=1
+	Size string `json:"size"`
+
+	// StorageClassName là StorageClass dùng cho PVC sao lưu
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
 }
 
 // DatabaseReplicationSpec định nghĩa cấu hình replication
@@ -128,6 +616,83 @@ type DatabaseReplicationSpec struct {
 	// GTID bật/tắt GTID replication (mặc định bật)
 	// +optional
 	GTID *bool `json:"gtid,omitempty"`
+
+	// MinReplicas là số replica tối thiểu của HPA replica, ưu tiên hơn database.autoscaling.minReplicas
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas là số replica tối đa của HPA replica, ưu tiên hơn database.autoscaling.maxReplicas
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// MaxLagSeconds là độ trễ replication tối đa (Seconds_Behind_Master) để replica còn nhận lưu lượng db-read
+	// Replica trễ hơn ngưỡng này bị đánh dấu chưa sẵn sàng cho đến khi bắt kịp (mặc định 30)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxLagSeconds *int32 `json:"maxLagSeconds,omitempty"`
+
+	// DeletePVCOnScaleDown xóa PVC db-data của replica sau khi replica được gỡ khỏi cluster khi giảm replicas
+	// Mặc định giữ PVC để lần tăng replica sau dùng lại dữ liệu
+	// +optional
+	DeletePVCOnScaleDown bool `json:"deletePVCOnScaleDown,omitempty"`
+
+	// CredentialRotationInterval tự động xoay vòng user replication sau mỗi khoảng thời gian này (ví dụ "720h").
+	// Xoay vòng tạo user mới trên master, khởi động lại lần lượt từng replica rồi xóa user cũ.
+	// Không đặt thì chỉ xoay vòng khi annotation music.mixcorp.org/rotate-replication-credentials thay đổi
+	// +optional
+	CredentialRotationInterval *metav1.Duration `json:"credentialRotationInterval,omitempty"`
+
+	// Filters giới hạn những database/bảng được replica áp dụng, ví dụ chỉ nhân bản bảng catalog
+	// và bỏ qua các bảng thống kê lớn. Chỉ ảnh hưởng replica, master vẫn ghi binlog đầy đủ
+	// +optional
+	Filters *ReplicationFiltersSpec `json:"filters,omitempty"`
+
+	// ParallelThreads là số luồng áp dụng song song trên replica (slave_parallel_threads).
+	// Mặc định 0 là áp dụng tuần tự; tăng lên khi replica không theo kịp lượng ghi lớn
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16383
+	// +optional
+	ParallelThreads *int32 `json:"parallelThreads,omitempty"`
+
+	// ParallelMode là chế độ áp dụng song song (slave_parallel_mode), chỉ có tác dụng khi parallelThreads > 0
+	// +kubebuilder:validation:Enum=conservative;optimistic;aggressive;minimal;none
+	// +optional
+	ParallelMode string `json:"parallelMode,omitempty"`
+}
+
+// ReplicationFiltersSpec ánh xạ tới các tùy chọn replicate-* của MariaDB trên replica
+type ReplicationFiltersSpec struct {
+	// DoDB chỉ áp dụng thay đổi của các database này (replicate_do_db)
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_]+$`
+	// +optional
+	DoDB []string `json:"doDB,omitempty"`
+
+	// IgnoreDB bỏ qua thay đổi của các database này (replicate_ignore_db)
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_]+$`
+	// +optional
+	IgnoreDB []string `json:"ignoreDB,omitempty"`
+
+	// DoTable chỉ áp dụng thay đổi của các bảng dạng db.table (replicate_do_table)
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_]+\.[A-Za-z0-9_]+$`
+	// +optional
+	DoTable []string `json:"doTable,omitempty"`
+
+	// IgnoreTable bỏ qua thay đổi của các bảng dạng db.table (replicate_ignore_table)
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_]+\.[A-Za-z0-9_]+$`
+	// +optional
+	IgnoreTable []string `json:"ignoreTable,omitempty"`
+
+	// WildDoTable giống DoTable nhưng cho phép ký tự đại diện % và _ (replicate_wild_do_table), ví dụ "music.catalog_%"
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_%]+\.[A-Za-z0-9_%]+$`
+	// +optional
+	WildDoTable []string `json:"wildDoTable,omitempty"`
+
+	// WildIgnoreTable giống IgnoreTable nhưng cho phép ký tự đại diện % và _ (replicate_wild_ignore_table)
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9_%]+\.[A-Za-z0-9_%]+$`
+	// +optional
+	WildIgnoreTable []string `json:"wildIgnoreTable,omitempty"`
 }
 
 // DatabaseHighAvailabilitySpec cấu hình Galera Cluster để tự động chuyển đổi dự phòng
@@ -162,41 +727,1113 @@ type DatabaseStatus struct {
 
 	// ReplicationReady cho biết replication giữa master/replica đã sẵn sàng
 	ReplicationReady bool `json:"replicationReady,omitempty"`
-}
 
-// MusicServiceSpec định nghĩa trạng thái mong muốn của MusicService
-type MusicServiceSpec struct {
-	// Replicas là số pod mong muốn
-	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:validation:Maximum=100
-	Replicas int32 `json:"replicas"`
+	// GaleraClusterSize là wsrep_cluster_size của primary component, đọc từ sidecar mysqld_exporter của các
+	// node Galera Ready; gồm cả node đang nhận SST/IST nên có thể lớn hơn galeraReadyNodes
+	// +optional
+	GaleraClusterSize int32 `json:"galeraClusterSize,omitempty"`
 
-	// Image là image container cần triển khai
-	// +kubebuilder:validation:MinLength=1
-	Image string `json:"image"`
+	// GaleraReadyNodes là số pod Galera Ready; readiness probe chỉ pass khi node đã Synced trong primary component
+	// nên đây là số node đang phục vụ, khác wsrep_cluster_size vốn tính cả node đang nhận SST/IST
+	// +optional
+	GaleraReadyNodes int32 `json:"galeraReadyNodes,omitempty"`
 
-	// Port là cổng Service cho streaming nhạc
-	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:validation:Maximum=65535
-	Port int32 `json:"port"`
+	// GaleraClusterStatus là Primary khi có node Synced trong primary component, NonPrimary khi pod
+	// đang chạy nhưng không node nào thuộc primary component (mất quorum), Disconnected khi không có pod nào
+	// +kubebuilder:validation:Enum=Primary;NonPrimary;Disconnected
+	// +optional
+	GaleraClusterStatus string `json:"galeraClusterStatus,omitempty"`
 
-	// Storage định nghĩa cấu hình lưu trữ
-	Storage StorageSpec `json:"storage"`
+	// BootstrapNode là pod gần nhất đã khởi tạo cluster Galera mới (wsrep_cluster_address rỗng vì data directory
+	// chưa có grastate.dat), lấy từ termination message của init container init-galera-config
+	// chứ không suy ra từ ordinal
+	// +optional
+	BootstrapNode string `json:"bootstrapNode,omitempty"`
 
-	// Streaming định nghĩa cấu hình streaming
-	Streaming StreamingSpec `json:"streaming"`
+	// FailoverHistory là các lần endpoint ghi mất hoặc khôi phục (master mất/có lại pod Ready,
+	// Galera mất/lấy lại primary component), mới nhất ở cuối và chỉ giữ 10 lần gần nhất
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	FailoverHistory []FailoverEvent `json:"failoverHistory,omitempty"`
 
-	// Resources định nghĩa tài nguyên tính toán cho container
+	// Nodes là trạng thái từng pod cơ sở dữ liệu (master, replica hoặc thành viên Galera)
+	// để biết chính xác node nào gặp sự cố mà không cần exec vào pod
 	// +optional
-	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	Nodes []DatabaseNodeStatus `json:"nodes,omitempty"`
+
+	// InitializedFrom ghi lại MusicService nguồn đã được khôi phục dữ liệu khi khởi tạo
+	// +optional
+	InitializedFrom string `json:"initializedFrom,omitempty"`
+
+	// Backup tóm tắt bản sao lưu gần nhất và lần chạy kế tiếp của CronJob sao lưu
+	// +optional
+	Backup *BackupStatus `json:"backup,omitempty"`
+
+	// ReplicationCredentials theo dõi việc xoay vòng user replication
+	// +optional
+	ReplicationCredentials *ReplicationCredentialsStatus `json:"replicationCredentials,omitempty"`
+}
+
+// DatabaseNodeStatus là trạng thái của một pod cơ sở dữ liệu
+type DatabaseNodeStatus struct {
+	// Name là tên pod
+	Name string `json:"name"`
+
+	// Role là master, replica hoặc galera
+	// +kubebuilder:validation:Enum=master;replica;galera
+	Role string `json:"role"`
+
+	// NodeName là node Kubernetes đang chạy pod
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// Ready cho biết pod đã vượt qua readiness probe
+	Ready bool `json:"ready"`
+
+	// State tóm tắt trạng thái từ readiness probe: Ready/NotReady cho master, Replicating/NotReplicating
+	// cho replica (luồng replication chạy và độ trễ trong giới hạn), Synced/NotSynced cho Galera;
+	// hoặc lý do chờ của container như CrashLoopBackOff, Pending, Terminating
+	State string `json:"state"`
+
+	// Restarts là số lần container MariaDB đã khởi động lại
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+}
+
+// FailoverEvent là một lần endpoint ghi của cơ sở dữ liệu thay đổi
+type FailoverEvent struct {
+	// Time là thời điểm operator phát hiện thay đổi
+	Time metav1.Time `json:"time"`
+
+	// From là pod hoặc trạng thái cluster nhận ghi trước thay đổi; rỗng khi trước đó không có
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// To là pod hoặc trạng thái cluster nhận ghi sau thay đổi; rỗng khi không còn node nào nhận ghi
+	// +optional
+	To string `json:"to,omitempty"`
+
+	// Reason là MasterNotReady, MasterRecovered, QuorumLost hoặc QuorumRestored
+	Reason string `json:"reason"`
+}
+
+// ReplicationCredentialsStatus mô tả lần xoay vòng user replication gần nhất
+type ReplicationCredentialsStatus struct {
+	// Phase là bước hiện tại: Granting (tạo user mới trên master), Rolling (khởi động lại lần lượt từng replica
+	// để chạy lại CHANGE MASTER), Retiring (xóa user cũ) và Completed
+	// +kubebuilder:validation:Enum=Granting;Rolling;Retiring;Completed
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// User là user replication đang dùng
+	// +optional
+	User string `json:"user,omitempty"`
+
+	// PreviousUser là user bị thay thế, được xóa khỏi master ở bước Retiring
+	// +optional
+	PreviousUser string `json:"previousUser,omitempty"`
+
+	// StartedAt là thời điểm bắt đầu lần xoay vòng hiện tại hoặc gần nhất
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// LastRotationTime là thời điểm lần xoay vòng gần nhất hoàn tất
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// LastTrigger là giá trị annotation rotate-replication-credentials đã được xử lý
+	// +optional
+	LastTrigger string `json:"lastTrigger,omitempty"`
+
+	// Message mô tả tiến độ hoặc lỗi của bước hiện tại
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupStatus cho biết dữ liệu có đang được sao lưu đều đặn hay không
+type BackupStatus struct {
+	// LastBackupTime là thời điểm Job sao lưu thành công gần nhất
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// LastBackupSizeBytes là kích thước file sao lưu gần nhất
+	// +optional
+	LastBackupSizeBytes int64 `json:"lastBackupSizeBytes,omitempty"`
+
+	// LastBackupLocation là vị trí file sao lưu gần nhất, dạng pvc://<tên PVC>/<tên file>
+	// +optional
+	LastBackupLocation string `json:"lastBackupLocation,omitempty"`
+
+	// NextScheduledBackup là lần chạy kế tiếp theo spec.database.backup.schedule
+	// +optional
+	NextScheduledBackup *metav1.Time `json:"nextScheduledBackup,omitempty"`
+
+	// LastFailedBackupTime là thời điểm Job sao lưu thất bại gần nhất (đã được gửi thông báo)
+	// +optional
+	LastFailedBackupTime *metav1.Time `json:"lastFailedBackupTime,omitempty"`
+}
+
+// ResourceFootprint tổng hợp tài nguyên tối đa mà một MusicService có thể chiếm dụng
+// (ứng dụng + master + replica của cơ sở dữ liệu, tính theo MaxReplicas của HPA)
+type ResourceFootprint struct {
+	// CPU là tổng CPU request của tất cả pod
+	// +optional
+	CPU resource.Quantity `json:"cpu,omitempty"`
+
+	// Memory là tổng memory request của tất cả pod
+	// +optional
+	Memory resource.Quantity `json:"memory,omitempty"`
+
+	// Storage là tổng dung lượng PVC (dữ liệu và sao lưu)
+	// +optional
+	Storage resource.Quantity `json:"storage,omitempty"`
+}
+
+// TenancyMode định nghĩa nơi đặt các tài nguyên con của MusicService
+type TenancyMode string
+
+const (
+	// TenancyModeShared đặt tài nguyên con cùng namespace với MusicService
+	TenancyModeShared TenancyMode = "Shared"
+	// TenancyModeDedicated tạo một namespace tenant riêng chứa quota, NetworkPolicy và tài nguyên con
+	TenancyModeDedicated TenancyMode = "Dedicated"
+)
+
+// TenancySpec định nghĩa chế độ multi-tenancy của MusicService
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenancy is immutable"
+type TenancySpec struct {
+	// Mode chọn Shared (mặc định) hoặc Dedicated
+	// Dedicated chỉ được chấp nhận khi MusicService nằm trong management namespace của operator
+	// +kubebuilder:validation:Enum=Shared;Dedicated
+	// +kubebuilder:default=Shared
+	// +optional
+	Mode TenancyMode `json:"mode,omitempty"`
+
+	// Namespace là tên namespace tenant; để trống sẽ dùng "tenant-<tên MusicService>"
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Quota là giới hạn cứng của ResourceQuota trong namespace tenant (ví dụ: requests.cpu, persistentvolumeclaims)
+	// +optional
+	Quota corev1.ResourceList `json:"quota,omitempty"`
+}
+
+// RegistryCredentialsSpec định nghĩa thông tin đăng nhập registry riêng cho image ứng dụng và cơ sở dữ liệu
+// Operator luôn tạo Secret "<tên>-registry" kiểu dockerconfigjson trong namespace chạy workload và gắn vào imagePullSecrets của mọi pod
+// +kubebuilder:validation:XValidation:rule="has(self.secretName) != has(self.server)",message="set exactly one of secretName or server"
+// +kubebuilder:validation:XValidation:rule="!has(self.server) || (has(self.username) && has(self.passwordSecretRef))",message="server requires username and passwordSecretRef"
+type RegistryCredentialsSpec struct {
+	// SecretName là Secret kiểu kubernetes.io/dockerconfigjson có sẵn trong namespace của MusicService
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Server là địa chỉ registry (ví dụ: registry.example.com) khi khai báo thông tin đăng nhập trực tiếp
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// Username là tên đăng nhập registry
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecretRef trỏ tới key chứa mật khẩu hoặc token registry trong namespace của MusicService
+	// +optional
+	PasswordSecretRef *corev1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// WorkloadType định nghĩa kiểu workload chạy ứng dụng
+type WorkloadType string
+
+const (
+	// WorkloadTypeStatefulSet chạy ứng dụng bằng StatefulSet, mỗi pod có PVC music-data riêng
+	WorkloadTypeStatefulSet WorkloadType = "StatefulSet"
+	// WorkloadTypeDeployment chạy ứng dụng bằng Deployment không có PVC, dành cho dữ liệu nhạc trên object storage
+	WorkloadTypeDeployment WorkloadType = "Deployment"
+)
+
+// DriftPolicy định nghĩa cách operator xử lý thay đổi ngoài luồng trên tài nguyên con
+type DriftPolicy string
+
+const (
+	// DriftPolicyRevert ghi đè thay đổi ngoài luồng bằng spec mong muốn (mặc định) và ghi lại vào status.drift
+	DriftPolicyRevert DriftPolicy = "Revert"
+	// DriftPolicyReport chỉ ghi thay đổi ngoài luồng vào status.drift, không ghi đè tài nguyên con
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
+// MusicServiceSpec định nghĩa trạng thái mong muốn của MusicService
+// +kubebuilder:validation:XValidation:rule="!has(self.workloadType) || self.workloadType != 'Deployment' || !has(self.seed)",message="seed requires workloadType StatefulSet"
+// +kubebuilder:validation:XValidation:rule="!has(self.workloadType) || self.workloadType != 'Deployment' || !has(self.storage) || !has(self.storage.updatePolicy) || self.storage.updatePolicy != 'Migrate'",message="updatePolicy Migrate requires workloadType StatefulSet"
+// +kubebuilder:validation:XValidation:rule="has(self.storage) || (has(self.workloadType) && self.workloadType == 'Deployment')",message="storage is required unless workloadType is Deployment"
+// +kubebuilder:validation:XValidation:rule="!has(self.ttlSecondsAfterLastUse) || has(self.connectionMetrics)",message="ttlSecondsAfterLastUse requires connectionMetrics"
+// +kubebuilder:validation:XValidation:rule="!has(self.tlsPassthrough) || !has(self.ingress) || (!has(self.ingress.tlsSecretName) && (!has(self.ingress.stickySessions) || !self.ingress.stickySessions.enabled))",message="ingress tlsSecretName and stickySessions cannot be used with tlsPassthrough"
+// +kubebuilder:validation:XValidation:rule="!has(self.shards) || size(self.shards) == 0 || ((!has(self.workloadType) || self.workloadType != 'Deployment') && !has(self.autoscaling) && !has(self.seed) && !has(self.streaming.drain))",message="shards cannot be used with workloadType Deployment, autoscaling, seed or streaming.drain; set autoscaling per shard"
+// +kubebuilder:validation:XValidation:rule="!has(self.partitioning) || (has(self.shards) && size(self.shards) > 0)",message="partitioning requires shards"
+// +kubebuilder:validation:XValidation:rule="!has(self.tlsPassthrough) || ((has(self.tlsPassthrough.port) ? self.tlsPassthrough.port : 8443) != (has(self.containerPort) ? self.containerPort : 80) && (has(self.tlsPassthrough.servicePort) ? self.tlsPassthrough.servicePort : 443) != self.port)",message="tlsPassthrough ports must differ from containerPort and port"
+// +kubebuilder:validation:XValidation:rule="!has(self.database) || !has(self.database.backup) || !has(self.database.backup.destination.objectStorage) || has(self.objectStorage)",message="database.backup.destination.objectStorage requires objectStorage"
+// +kubebuilder:validation:XValidation:rule="!has(self.seed) || !self.seed.sources.exists(s, has(s.objectStorage) && s.objectStorage) || has(self.objectStorage)",message="seed sources with objectStorage require objectStorage"
+type MusicServiceSpec struct {
+	// Replicas là số pod mong muốn
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Replicas int32 `json:"replicas"`
+
+	// WorkloadType chọn StatefulSet (mặc định) hoặc Deployment cho ứng dụng. Deployment không tạo PVC (music-data là emptyDir),
+	// rollout theo kiểu surge và HPA trỏ vào Deployment. Khi đổi kiểu, workload cũ bị xóa nhưng PVC music-data được giữ lại
+	// +kubebuilder:validation:Enum=StatefulSet;Deployment
+	// +optional
+	WorkloadType WorkloadType `json:"workloadType,omitempty"`
+
+	// PodManagementPolicy của StatefulSet ứng dụng; Parallel khởi động/xóa mọi pod cùng lúc thay vì lần lượt
+	// Field này bất biến trên StatefulSet nên khi đổi, operator xóa StatefulSet (giữ pod và PVC) rồi tạo lại
+	// +kubebuilder:validation:Enum=OrderedReady;Parallel
+	// +optional
+	PodManagementPolicy appsv1.PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+
+	// DriftPolicy chọn cách xử lý khi Service, StatefulSet hoặc Deployment ứng dụng bị sửa ngoài operator.
+	// Revert (mặc định) đưa tài nguyên về spec mong muốn; Report giữ nguyên thay đổi để công cụ GitOps quyết định.
+	// Cả hai chế độ đều liệt kê các field lệch trong status.drift
+	// +kubebuilder:validation:Enum=Revert;Report
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// MinReadySeconds là số giây pod ứng dụng phải Ready liên tục trước khi rollout chuyển sang pod kế tiếp,
+	// tránh restart dây chuyền khi readiness chập chờn lúc tải cao
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// Image là image container cần triển khai
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// ImagePullPolicy áp dụng cho container ứng dụng, read pool và init container nạp catalog;
+	// Always phù hợp với tag thay đổi được khi dev, IfNotPresent cho cluster edge hạn chế băng thông
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// RegistryCredentials cấu hình thông tin đăng nhập registry riêng cho image ứng dụng và cơ sở dữ liệu
+	// +optional
+	RegistryCredentials *RegistryCredentialsSpec `json:"registryCredentials,omitempty"`
+
+	// Command ghi đè entrypoint của container music-service (ví dụ: chế độ debug, server binary khác)
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args ghi đè tham số của container music-service
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// RuntimeClassName chạy pod ứng dụng và read pool bằng RuntimeClass chỉ định (ví dụ gVisor, Kata)
+	// cho tenant cần cách ly mạnh hơn; RuntimeClass phải có sẵn trong cluster
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// Port là cổng Service cho streaming nhạc
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// ContainerPort là cổng ứng dụng lắng nghe trong container, cũng là targetPort của Service
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=80
+	// +optional
+	ContainerPort int32 `json:"containerPort,omitempty"`
+
+	// Storage định nghĩa PVC music-data của từng pod; có thể bỏ trống với workloadType Deployment
+	// khi dữ liệu nhạc nằm trên object storage
+	// +optional
+	Storage *StorageSpec `json:"storage,omitempty"`
+
+	// Snapshots cấu hình VolumeSnapshot do operator tạo cho PVC music-data và db-data
+	// +optional
+	Snapshots *SnapshotSpec `json:"snapshots,omitempty"`
+
+	// Streaming định nghĩa cấu hình streaming
+	Streaming StreamingSpec `json:"streaming"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// GPU yêu cầu extended resource tăng tốc phần cứng cho container music-service (encode/transcode)
+	// và đưa pod ứng dụng lên node GPU
+	// +optional
+	GPU *GPUSpec `json:"gpu,omitempty"`
+
+	// QoS Guaranteed đặt requests bằng limits (ưu tiên limits, thiếu thì lấy requests rồi mặc định của operator)
+	// cho CPU và bộ nhớ của mọi container ứng dụng và cơ sở dữ liệu, để pod không bị throttle hay evict trước
+	// +kubebuilder:validation:Enum=Burstable;Guaranteed
+	// +optional
+	QoS QoSMode `json:"qos,omitempty"`
 
 	// Autoscaling định nghĩa cấu hình autoscaling
 	// +optional
-	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// Database định nghĩa cấu hình cơ sở dữ liệu
+	// +optional
+	Database *DatabaseSpec `json:"database,omitempty"`
+
+	// Queue triển khai một broker Kafka/RabbitMQ nhỏ (một node) hoặc tham chiếu broker bên ngoài, rồi truyền
+	// thông tin kết nối QUEUE_* vào ứng dụng để gửi sự kiện phát nhạc và dữ liệu tính bản quyền
+	// +optional
+	Queue *QueueSpec `json:"queue,omitempty"`
+
+	// Search triển khai OpenSearch/Meilisearch một node cho tìm kiếm catalog và truyền endpoint SEARCH_* vào ứng dụng
+	// +optional
+	Search *SearchSpec `json:"search,omitempty"`
+
+	// SessionStore triển khai Redis có bật persistence và mật khẩu để lưu trạng thái đăng nhập/phiên,
+	// tách khỏi volume cache; endpoint và mật khẩu được truyền vào ứng dụng qua Secret kết nối
+	// +optional
+	SessionStore *SessionStoreSpec `json:"sessionStore,omitempty"`
+
+	// ObjectStorage triển khai MinIO trong cluster cho cluster không có S3 bên ngoài; nguồn seed S3 và
+	// destination.objectStorage của sao lưu dùng endpoint và Secret thông tin đăng nhập của nó
+	// +optional
+	ObjectStorage *ObjectStorageSpec `json:"objectStorage,omitempty"`
+
+	// Tenancy chọn đặt tài nguyên con cùng namespace hay trong namespace tenant riêng
+	// +optional
+	Tenancy *TenancySpec `json:"tenancy,omitempty"`
+
+	// Libraries là các MusicLibrary (cùng namespace với tài nguyên con) được mount chỉ-đọc vào pod ứng dụng
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Libraries []LibraryMount `json:"libraries,omitempty"`
+
+	// Seed nạp nội dung ban đầu vào volume music-data bằng Job trước khi đánh dấu Available
+	// +optional
+	Seed *SeedSpec `json:"seed,omitempty"`
+
+	// SeedInitContainer nạp catalog ban đầu bằng init container, chỉ ở lần khởi động đầu tiên của mỗi pod
+	// +optional
+	SeedInitContainer *SeedInitContainerSpec `json:"seedInitContainer,omitempty"`
+
+	// ReadPool tạo thêm một Deployment chỉ-đọc kết nối tới Service db-read, có Service và HPA riêng
+	// +optional
+	ReadPool *ReadPoolSpec `json:"readPool,omitempty"`
+
+	// Shards chia ứng dụng thành nhiều StatefulSet <name>-<shard>, mỗi shard có Service, số replica và HPA riêng,
+	// dành cho catalog quá lớn cho một pool. Service <name> vẫn trỏ tới pod của mọi shard; khi có shards thì
+	// spec.replicas không còn dùng và StatefulSet <name> bị xóa (PVC music-data cũ được giữ lại)
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self.all(s, !s.name.startsWith('db-') && !(s.name in ['read', 'registry', 'dashboard', 'quota', 'autoscaler', 'storage-migration', 'partitions', 'queue', 'queue-conn', 'search', 'search-conn', 'session', 'session-conn', 'minio', 'minio-credentials']))",message="shard names must not collide with other generated resources (db-*, read, registry, dashboard, quota, autoscaler, storage-migration, partitions, queue, queue-conn, search, search-conn, session, session-conn, minio, minio-credentials)"
+	// +optional
+	Shards []AppShardSpec `json:"shards,omitempty"`
+
+	// Partitioning chia nội dung cho các shard theo thể loại hoặc khoảng hash; operator truyền phần của từng shard
+	// qua biến môi trường, công bố bảng phân chia trong status.partitioning và ConfigMap <name>-partitions
+	// để lớp định tuyến biết shard nào giữ nội dung nào
+	// +optional
+	Partitioning *PartitioningSpec `json:"partitioning,omitempty"`
+
+	// HealthCheck bật readiness/liveness probe HTTP cho container music-service của ứng dụng và read pool
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+
+	// ConnectionMetrics đọc số kết nối streaming đang mở từ endpoint metrics của từng pod ứng dụng
+	// và ghi tổng cùng trung bình mỗi replica vào status
+	// +optional
+	ConnectionMetrics *ConnectionMetricsSpec `json:"connectionMetrics,omitempty"`
+
+	// TTLSecondsAfterCreation tự xóa MusicService sau số giây này kể từ khi tạo,
+	// dành cho môi trường preview tạo theo từng pull request
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTLSecondsAfterCreation *int64 `json:"ttlSecondsAfterCreation,omitempty"`
+
+	// TTLSecondsAfterLastUse tự xóa MusicService khi không có kết nối streaming nào trong số giây này
+	// (tính từ lúc tạo nếu chưa từng có kết nối); cần spec.connectionMetrics để biết lần dùng gần nhất
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTLSecondsAfterLastUse *int64 `json:"ttlSecondsAfterLastUse,omitempty"`
+
+	// Service định nghĩa tùy chọn định tuyến cho các Service phục vụ lưu lượng đọc/streaming
+	// +optional
+	Service *ServiceOptionsSpec `json:"service,omitempty"`
+
+	// Ingress công khai Service ứng dụng qua một Ingress theo host
+	// +optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+
+	// TLSPassthrough để ứng dụng tự kết thúc TLS bằng chứng chỉ mount từ Secret; Service có thêm port https
+	// và Ingress chuyển thẳng luồng TLS tới pod, dành cho luồng có DRM không được giải mã lại ở edge
+	// +optional
+	TLSPassthrough *TLSPassthroughSpec `json:"tlsPassthrough,omitempty"`
+
+	// Monitoring tạo PodMonitor của prometheus-operator cho endpoint metrics của pod ứng dụng và read pool
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+
+	// AccessLogs thêm sidecar Fluent Bit gửi access log của ứng dụng và read pool tới Loki hoặc Elasticsearch;
+	// access log là nguồn dữ liệu chính để tính phí và bản quyền
+	// +optional
+	AccessLogs *AccessLogShippingSpec `json:"accessLogs,omitempty"`
+
+	// Logs đặt thư mục log của ứng dụng trên volume riêng có giới hạn dung lượng và thêm sidecar xoay vòng,
+	// xóa log cũ để pod chạy lâu không làm đầy PVC bằng log
+	// +optional
+	Logs *AppLogsSpec `json:"logs,omitempty"`
+
+	// Config mount ConfigMap cấu hình vào pod ứng dụng và read pool. Thay đổi key thường khởi động lại pod
+	// qua checksum trên pod template; thay đổi key trong reloadableKeys chỉ gửi tín hiệu để ứng dụng nạp lại,
+	// giữ nguyên các phiên nghe nhạc đang mở
+	// +optional
+	Config *AppConfigSpec `json:"config,omitempty"`
+
+	// SafeToEvict đặt annotation cluster-autoscaler.kubernetes.io/safe-to-evict trên pod ứng dụng và read pool;
+	// true cho phép cluster-autoscaler dồn các pod streaming không trạng thái để thu hồi node, để trống dùng quy tắc mặc định
+	// +optional
+	SafeToEvict *bool `json:"safeToEvict,omitempty"`
+
+	// Labels được gắn lên mọi tài nguyên con (StatefulSet, Deployment, Service, PVC, HPA, Secret, CronJob...)
+	// để công cụ phân bổ chi phí và policy chọn theo nhãn team/cost-center; không được dùng key nhãn nội bộ của operator
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k != 'app' && k != 'component' && !k.startsWith('app.kubernetes.io/') && !k.startsWith('music.mixcorp.org/'))",message="labels must not use keys reserved by the operator (app, component, app.kubernetes.io/*, music.mixcorp.org/*)"
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations được gắn lên mọi tài nguyên con; annotation riêng của tài nguyên (ví dụ sticky session của Ingress) thắng khi trùng key
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// SeedSourceType định nghĩa loại nguồn nội dung để nạp vào volume music-data
+type SeedSourceType string
+
+const (
+	// SeedSourceHTTP tải một file qua HTTP(S); file .tar.gz/.tgz được giải nén
+	SeedSourceHTTP SeedSourceType = "HTTP"
+	// SeedSourceS3 đồng bộ một prefix S3 (ví dụ: s3://bucket/catalog/)
+	SeedSourceS3 SeedSourceType = "S3"
+	// SeedSourceOCI kéo một OCI artifact (ví dụ: ghcr.io/org/catalog:v1)
+	SeedSourceOCI SeedSourceType = "OCI"
+)
+
+// SeedSpec định nghĩa nội dung ban đầu được Job nạp vào volume music-data của từng pod
+type SeedSpec struct {
+	// Sources là danh sách nguồn được nạp lần lượt theo thứ tự
+	// +kubebuilder:validation:MinItems=1
+	Sources []SeedSource `json:"sources"`
+
+	// CredentialsSecret là Secret trong namespace của tài nguyên con được nạp làm biến môi trường cho các container tải
+	// (ví dụ: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, ORAS_USERNAME, ORAS_PASSWORD);
+	// nguồn có objectStorage dùng Secret của MinIO thay cho Secret này
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// SeedSource định nghĩa một nguồn nội dung
+// +kubebuilder:validation:XValidation:rule="!has(self.objectStorage) || !self.objectStorage || (self.type == 'S3' && !has(self.endpoint))",message="objectStorage is only valid for S3 sources without an endpoint"
+type SeedSource struct {
+	// Type là loại nguồn: HTTP, S3 hoặc OCI
+	// +kubebuilder:validation:Enum=HTTP;S3;OCI
+	Type SeedSourceType `json:"type"`
+
+	// URL là địa chỉ nguồn: URL HTTP(S), URI s3://bucket/prefix hoặc tham chiếu OCI artifact
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// Path là thư mục đích tương đối trong /data (mặc định là /data)
+	// +kubebuilder:validation:Pattern=`^[^/].*$`
+	// +kubebuilder:validation:XValidation:rule="!self.contains('..')",message="path must not contain '..'"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Endpoint là endpoint S3 tùy chỉnh; chỉ dùng với Type S3, để trống thì aws-cli dùng endpoint AWS mặc định
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ObjectStorage đọc nguồn S3 từ MinIO của spec.objectStorage bằng endpoint và Secret thông tin đăng nhập của MinIO;
+	// nguồn không bật vẫn dùng AWS (hoặc Endpoint) và CredentialsSecret, kể cả khi dùng IRSA
+	// +optional
+	ObjectStorage bool `json:"objectStorage,omitempty"`
+}
+
+// SeedInitContainerSpec định nghĩa init container nạp catalog ban đầu vào /data ở lần khởi động đầu tiên.
+// Đây là lựa chọn nhẹ hơn spec.seed: không cần Job, nhưng pod chỉ sẵn sàng sau khi tải xong
+type SeedInitContainerSpec struct {
+	// Image là image chứa công cụ tải catalog; image phải có /bin/sh
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// Command là lệnh tải catalog vào /data, chỉ chạy khi chưa có file đánh dấu
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// Env là biến môi trường bổ sung cho init container
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources định nghĩa tài nguyên cho init container
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// MarkerFile là file đánh dấu (tương đối trong /data) được tạo sau khi tải xong; mặc định ".seeded"
+	// +kubebuilder:validation:Pattern=`^[^/]+$`
+	// +optional
+	MarkerFile string `json:"markerFile,omitempty"`
+}
+
+// SeedStatus định nghĩa trạng thái nạp nội dung ban đầu
+type SeedStatus struct {
+	// Phase biểu thị trạng thái nạp nội dung
+	// +kubebuilder:validation:Enum=Running;Completed;Failed
+	Phase string `json:"phase,omitempty"`
+
+	// CompletedReplicas là số pod đã được nạp xong nội dung
+	CompletedReplicas int32 `json:"completedReplicas,omitempty"`
+
+	// Hash là mã băm của spec.seed đã áp dụng; thay đổi nguồn sẽ chạy lại Job nạp
+	Hash string `json:"hash,omitempty"`
+}
+
+// ReadPoolSpec định nghĩa nhóm pod chỉ-đọc phục vụ duyệt catalog, scale độc lập với pod upload/ingest
+type ReadPoolSpec struct {
+	// Enabled bật/tắt read pool
+	Enabled bool `json:"enabled"`
+
+	// Replicas là số pod của read pool khi không bật autoscaling
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=2
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Image là image của read pool; để trống sẽ dùng spec.image
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container của read pool
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Autoscaling định nghĩa HPA riêng cho read pool
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// AppShardSpec định nghĩa một shard của ứng dụng
+type AppShardSpec struct {
+	// Name là tên shard, dùng làm hậu tố tên StatefulSet và Service (<name>-<shard>) và truyền qua biến SHARD_NAME
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=20
+	Name string `json:"name"`
+
+	// Replicas là số pod của shard
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Replicas int32 `json:"replicas"`
+
+	// Autoscaling định nghĩa HPA riêng cho shard
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// Genres là các thể loại shard giữ khi partitioning.strategy là Genre; mỗi thể loại chỉ thuộc một shard
+	// +listType=set
+	// +optional
+	Genres []string `json:"genres,omitempty"`
+}
+
+// PartitionStrategy là cách chia nội dung cho các shard
+// +kubebuilder:validation:Enum=Genre;HashRange
+type PartitionStrategy string
+
+const (
+	// PartitionStrategyGenre gán thể loại cho shard theo shards[].genres
+	PartitionStrategyGenre PartitionStrategy = "Genre"
+	// PartitionStrategyHashRange chia đều các bucket hash của mã nội dung cho các shard theo thứ tự khai báo
+	PartitionStrategyHashRange PartitionStrategy = "HashRange"
+)
+
+// PartitioningSpec định nghĩa cách chia nội dung cho spec.shards
+type PartitioningSpec struct {
+	// Strategy là cách chia nội dung
+	Strategy PartitionStrategy `json:"strategy"`
+
+	// HashBuckets là số bucket của không gian hash (bucket = FNV-1a 32-bit của mã nội dung mod hashBuckets),
+	// chỉ dùng với HashRange; nên lớn hơn nhiều so với số shard để sau này chia lại mịn hơn
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65536
+	// +kubebuilder:default=1024
+	// +optional
+	HashBuckets int32 `json:"hashBuckets,omitempty"`
+
+	// DefaultShard nhận các thể loại không được gán cho shard nào khi strategy là Genre; để trống dùng shard đầu tiên
+	// +optional
+	DefaultShard string `json:"defaultShard,omitempty"`
+}
+
+// TopologyRoutingMode định nghĩa cách Service ưu tiên endpoint cùng zone
+type TopologyRoutingMode string
+
+const (
+	// TopologyRoutingNone không bật định tuyến theo topology
+	TopologyRoutingNone TopologyRoutingMode = "None"
+	// TopologyRoutingPreferClose đặt spec.trafficDistribution=PreferClose (Kubernetes 1.30+)
+	TopologyRoutingPreferClose TopologyRoutingMode = "PreferClose"
+	// TopologyRoutingAuto đặt annotation service.kubernetes.io/topology-mode=Auto cho cluster cũ hơn
+	TopologyRoutingAuto TopologyRoutingMode = "Auto"
+)
+
+// ConnectionMetricsSpec định nghĩa endpoint Prometheus của image streaming để đọc số kết nối đang mở
+type ConnectionMetricsSpec struct {
+	// Path là đường dẫn HTTP của endpoint metrics
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/metrics"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Port là cổng container phục vụ metrics; để trống sẽ dùng spec.containerPort
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// MetricName là tên gauge số kết nối; các series có nhãn khác nhau được cộng lại
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_:][a-zA-Z0-9_:]*$`
+	// +kubebuilder:default="music_streaming_active_connections"
+	// +optional
+	MetricName string `json:"metricName,omitempty"`
+}
+
+// HealthCheckSpec định nghĩa endpoint health của image streaming (ví dụ: /healthz, /status, /ping)
+type HealthCheckSpec struct {
+	// Path là đường dẫn HTTP của endpoint health
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/healthz"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Port là số cổng hoặc tên port của container; để trống sẽ dùng port "http" (spec.containerPort)
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// Scheme là HTTP hoặc HTTPS
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +kubebuilder:default=HTTP
+	// +optional
+	Scheme corev1.URIScheme `json:"scheme,omitempty"`
+
+	// InitialDelaySeconds là thời gian chờ trước lần probe đầu tiên
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// StartupTimeoutSeconds bật startupProbe trên cùng endpoint: liveness/readiness chỉ chạy sau khi endpoint
+	// trả lời, nên lần khởi động đầu với catalog lớn (dựng index) có tối đa chừng này giây
+	// +kubebuilder:validation:Minimum=10
+	// +optional
+	StartupTimeoutSeconds int32 `json:"startupTimeoutSeconds,omitempty"`
+}
+
+// ServiceOptionsSpec định nghĩa tùy chọn định tuyến cho Service ứng dụng, read pool và db-read
+// +kubebuilder:validation:XValidation:rule="!has(self.externalTrafficPolicy) || self.externalTrafficPolicy == 'Cluster' || (has(self.type) && self.type != 'ClusterIP')",message="externalTrafficPolicy Local requires type NodePort or LoadBalancer"
+type ServiceOptionsSpec struct {
+	// Type là kiểu Service ứng dụng (mặc định: ClusterIP)
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +optional
+	Type corev1.ServiceType `json:"type,omitempty"`
+
+	// ExternalTrafficPolicy của Service ứng dụng; Local giữ nguyên IP nguồn của client
+	// để kiểm tra bản quyền theo vùng và để load balancer chỉ health-check node có pod
+	// +kubebuilder:validation:Enum=Cluster;Local
+	// +optional
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+
+	// InternalTrafficPolicy của Service ứng dụng; Local chỉ chuyển lưu lượng trong cluster tới pod cùng node
+	// +kubebuilder:validation:Enum=Cluster;Local
+	// +optional
+	InternalTrafficPolicy corev1.ServiceInternalTrafficPolicy `json:"internalTrafficPolicy,omitempty"`
+
+	// TopologyRouting giữ lưu lượng streaming trong cùng zone để giảm chi phí truyền dữ liệu liên zone
+	// +kubebuilder:validation:Enum=None;PreferClose;Auto
+	// +kubebuilder:default=None
+	// +optional
+	TopologyRouting TopologyRoutingMode `json:"topologyRouting,omitempty"`
+
+	// TargetPort ghi đè targetPort của Service ứng dụng và read pool bằng số cổng hoặc tên port của container;
+	// để trống sẽ dùng port "http" của container, tức spec.containerPort
+	// +optional
+	TargetPort *intstr.IntOrString `json:"targetPort,omitempty"`
+}
+
+// IngressSpec định nghĩa Ingress công khai Service ứng dụng
+type IngressSpec struct {
+	// Host là tên miền của Ingress
+	Host string `json:"host"`
+
+	// ClassName là IngressClass xử lý Ingress; để trống sẽ dùng class mặc định của cluster
+	// +optional
+	ClassName string `json:"className,omitempty"`
+
+	// TLSSecretName là Secret chứa chứng chỉ TLS cho Host; để trống sẽ chỉ phục vụ HTTP
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// StickySessions giữ một phiên streaming thích ứng trên cùng pod backend bằng cookie
+	// +optional
+	StickySessions *StickySessionSpec `json:"stickySessions,omitempty"`
+}
+
+// AppLogsSpec định nghĩa volume, xoay vòng và thời gian giữ log trên đĩa của ứng dụng
+type AppLogsSpec struct {
+	// Path là thư mục log trong container music-service, truyền cho ứng dụng qua biến LOG_DIR
+	// +kubebuilder:validation:Pattern=`^/.+`
+	// +kubebuilder:default="/var/log/music"
+	// +optional
+	Path string `json:"path,omitempty"`
 
-	// Database định nghĩa cấu hình cơ sở dữ liệu
+	// VolumeSize là giới hạn dung lượng của emptyDir chứa log; vượt quá thì kubelet evict pod (mặc định 1Gi)
 	// +optional
-	Database *DatabaseSpec `json:"database,omitempty"`
+	VolumeSize string `json:"volumeSize,omitempty"`
+
+	// MaxFileSize xoay vòng file *.log khi vượt kích thước này (mặc định 100Mi)
+	// +optional
+	MaxFileSize string `json:"maxFileSize,omitempty"`
+
+	// MaxAgeHours xoay vòng mọi file *.log sau số giờ này kể cả khi chưa đủ maxFileSize; để trống chỉ xoay theo kích thước
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAgeHours int32 `json:"maxAgeHours,omitempty"`
+
+	// MaxFiles là số file đã xoay vòng giữ lại cho mỗi file log
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=5
+	// +optional
+	MaxFiles int32 `json:"maxFiles,omitempty"`
+
+	// RetentionHours xóa file đã xoay vòng cũ hơn số giờ này; để trống chỉ giới hạn theo maxFiles
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetentionHours int32 `json:"retentionHours,omitempty"`
+
+	// Resources là tài nguyên của sidecar log-rotator
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// QueueEngine là loại message broker
+// +kubebuilder:validation:Enum=RabbitMQ;Kafka
+type QueueEngine string
+
+const (
+	// QueueEngineRabbitMQ dùng RabbitMQ (AMQP 0-9-1) với user do operator sinh
+	QueueEngineRabbitMQ QueueEngine = "RabbitMQ"
+	// QueueEngineKafka dùng Kafka chế độ KRaft, listener PLAINTEXT không xác thực trong cluster
+	QueueEngineKafka QueueEngine = "Kafka"
+)
+
+// QueueSpec định nghĩa message broker của ứng dụng
+// +kubebuilder:validation:XValidation:rule="!has(self.external) || (!has(self.image) && !has(self.storage) && !has(self.resources))",message="image, storage and resources only apply to a broker deployed by the operator"
+type QueueSpec struct {
+	// Engine là loại broker
+	Engine QueueEngine `json:"engine"`
+
+	// Image ghi đè image của broker do operator triển khai
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Storage định nghĩa PVC queue-data của broker; để trống thì dữ liệu nằm trên emptyDir và mất khi pod khởi động lại
+	// +optional
+	Storage *StorageSpec `json:"storage,omitempty"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container broker
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// External dùng broker có sẵn thay vì triển khai broker mới
+	// +optional
+	External *ExternalQueueSpec `json:"external,omitempty"`
+}
+
+// ExternalQueueSpec tham chiếu tới broker nằm ngoài MusicService
+type ExternalQueueSpec struct {
+	// Brokers là danh sách host:port của broker, cách nhau bởi dấu phẩy
+	// +kubebuilder:validation:MinLength=1
+	Brokers string `json:"brokers"`
+
+	// CredentialsSecret là Secret cùng namespace với pod ứng dụng chứa key username và password
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// SearchEngine là loại search engine
+// +kubebuilder:validation:Enum=Meilisearch;OpenSearch
+type SearchEngine string
+
+const (
+	// SearchEngineMeilisearch dùng Meilisearch với master key do operator sinh
+	SearchEngineMeilisearch SearchEngine = "Meilisearch"
+	// SearchEngineOpenSearch dùng OpenSearch single-node, tắt security plugin nên chỉ truy cập trong cluster
+	SearchEngineOpenSearch SearchEngine = "OpenSearch"
+)
+
+// SearchSpec định nghĩa search engine của ứng dụng
+type SearchSpec struct {
+	// Engine là loại search engine
+	Engine SearchEngine `json:"engine"`
+
+	// Image ghi đè image mặc định của search engine
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Storage định nghĩa PVC search-data chứa index; để trống thì index nằm trên emptyDir và phải dựng lại khi pod khởi động lại
+	// +optional
+	Storage *StorageSpec `json:"storage,omitempty"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container search engine
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// SessionStoreSpec định nghĩa Redis lưu phiên đăng nhập của ứng dụng
+type SessionStoreSpec struct {
+	// Image ghi đè image Redis mặc định
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Storage định nghĩa PVC session-data chứa file AOF; để trống thì AOF nằm trên emptyDir,
+	// phiên chỉ còn khi container khởi động lại chứ không còn khi pod bị xóa
+	// +optional
+	Storage *StorageSpec `json:"storage,omitempty"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container Redis
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ObjectStorageSpec định nghĩa MinIO một node do operator triển khai
+type ObjectStorageSpec struct {
+	// Image ghi đè image MinIO mặc định; image phải có sẵn mc để tạo bucket
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Storage định nghĩa PVC minio-data chứa dữ liệu object
+	Storage StorageSpec `json:"storage"`
+
+	// Resources định nghĩa tài nguyên tính toán cho container MinIO
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Buckets là các bucket được tạo khi MinIO khởi động
+	// +listType=set
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
+	// +optional
+	Buckets []string `json:"buckets,omitempty"`
+}
+
+// ConfigReloadSignal là tín hiệu gửi cho tiến trình ứng dụng để nạp lại cấu hình
+// +kubebuilder:validation:Enum=HUP;USR1;USR2
+type ConfigReloadSignal string
+
+const (
+	// ConfigReloadSignalHUP gửi SIGHUP, quy ước nạp lại cấu hình của đa số daemon
+	ConfigReloadSignalHUP ConfigReloadSignal = "HUP"
+	// ConfigReloadSignalUSR1 gửi SIGUSR1
+	ConfigReloadSignalUSR1 ConfigReloadSignal = "USR1"
+	// ConfigReloadSignalUSR2 gửi SIGUSR2
+	ConfigReloadSignalUSR2 ConfigReloadSignal = "USR2"
+)
+
+// AppConfigSpec định nghĩa ConfigMap cấu hình của ứng dụng và cách áp dụng thay đổi
+// +kubebuilder:validation:XValidation:rule="!has(self.reload) || (has(self.reloadableKeys) && size(self.reloadableKeys) > 0)",message="config.reload requires config.reloadableKeys"
+type AppConfigSpec struct {
+	// ConfigMapName là tên ConfigMap cấu hình, nằm cùng namespace với pod ứng dụng
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName"`
+
+	// MountPath là thư mục mount ConfigMap trong container music-service, truyền qua biến CONFIG_DIR
+	// +kubebuilder:validation:Pattern=`^/.+`
+	// +kubebuilder:default="/etc/music/config"
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// ReloadableKeys là các key ứng dụng nạp lại được khi đang chạy; để trống thì mọi thay đổi đều khởi động lại pod
+	// +optional
+	ReloadableKeys []string `json:"reloadableKeys,omitempty"`
+
+	// Reload cấu hình sidecar config-reloader, chỉ dùng khi có reloadableKeys
+	// +optional
+	Reload *ConfigReloadSpec `json:"reload,omitempty"`
+}
+
+// ConfigReloadSpec định nghĩa sidecar gửi tín hiệu nạp lại cấu hình
+type ConfigReloadSpec struct {
+	// Signal là tín hiệu gửi cho tiến trình ứng dụng
+	// +kubebuilder:default=HUP
+	// +optional
+	Signal ConfigReloadSignal `json:"signal,omitempty"`
+
+	// ProcessName là chuỗi khớp với dòng lệnh của tiến trình ứng dụng (pkill -f)
+	// +kubebuilder:default="music-service"
+	// +optional
+	ProcessName string `json:"processName,omitempty"`
+
+	// Image là image của sidecar config-reloader, cần có sh, sha256sum và pkill
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources là tài nguyên của sidecar config-reloader
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// AccessLogSinkType là hệ thống nhận access log
+// +kubebuilder:validation:Enum=Loki;Elasticsearch
+type AccessLogSinkType string
+
+const (
+	// AccessLogSinkLoki gửi access log tới Loki
+	AccessLogSinkLoki AccessLogSinkType = "Loki"
+	// AccessLogSinkElasticsearch gửi access log tới Elasticsearch
+	AccessLogSinkElasticsearch AccessLogSinkType = "Elasticsearch"
+)
+
+// AccessLogShippingSpec định nghĩa sidecar Fluent Bit gửi access log
+type AccessLogShippingSpec struct {
+	// Enabled bật/tắt sidecar
+	Enabled bool `json:"enabled"`
+
+	// Path là file access log ứng dụng ghi ra, truyền cho ứng dụng qua biến ACCESS_LOG_FILE;
+	// thư mục chứa file là emptyDir dùng chung với sidecar
+	// +kubebuilder:validation:Pattern=`^/.+/[^/]+$`
+	// +kubebuilder:default="/var/log/music/access.log"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Sink là nơi nhận access log
+	Sink AccessLogSinkSpec `json:"sink"`
+
+	// Image là image Fluent Bit của sidecar (mặc định fluent/fluent-bit:3.0)
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources là tài nguyên của sidecar
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// AccessLogSinkSpec định nghĩa đích gửi access log
+type AccessLogSinkSpec struct {
+	// Type là Loki hoặc Elasticsearch
+	Type AccessLogSinkType `json:"type"`
+
+	// Host là địa chỉ của sink, ví dụ loki-gateway.monitoring.svc
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port là cổng của sink; để trống dùng 3100 cho Loki và 9200 cho Elasticsearch
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// TLS bật kết nối TLS tới sink
+	// +optional
+	TLS bool `json:"tls,omitempty"`
+
+	// Index là index Elasticsearch nhận bản ghi (mặc định music-access)
+	// +optional
+	Index string `json:"index,omitempty"`
+
+	// Labels là nhãn Loki gắn thêm vào luồng log, bên cạnh job, musicservice và namespace
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// CredentialsSecret là Secret chứa key username và password cho xác thực HTTP basic với sink
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// MonitoringSpec định nghĩa PodMonitor cho metrics của ứng dụng
+type MonitoringSpec struct {
+	// Enabled bật/tắt PodMonitor; tắt thì PodMonitor đã tạo bị xóa
+	Enabled bool `json:"enabled"`
+
+	// Path là đường dẫn HTTP của endpoint metrics
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/metrics"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Port là cổng container phục vụ metrics; khác spec.containerPort thì container có thêm port "metrics",
+	// để trống sẽ scrape port "http"
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Interval là chu kỳ scrape theo định dạng duration của Prometheus
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	// +kubebuilder:default="30s"
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// Labels được gắn thêm lên PodMonitor để khớp podMonitorSelector của Prometheus, ví dụ release: prometheus
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// TLSPassthroughSpec định nghĩa chứng chỉ và cổng TLS mà ứng dụng tự phục vụ
+type TLSPassthroughSpec struct {
+	// SecretName là Secret kiểu kubernetes.io/tls chứa tls.crt và tls.key
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+
+	// Port là cổng TLS ứng dụng lắng nghe trong container (port "https")
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=8443
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// ServicePort là cổng https của Service ứng dụng
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=443
+	// +optional
+	ServicePort int32 `json:"servicePort,omitempty"`
+
+	// MountPath là thư mục mount chứng chỉ; đường dẫn file được truyền qua biến TLS_CERT_FILE và TLS_KEY_FILE
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/etc/music/tls"
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+// StickySessionSpec định nghĩa session affinity dựa trên cookie của Ingress (annotation ingress-nginx)
+type StickySessionSpec struct {
+	// Enabled bật/tắt session affinity
+	Enabled bool `json:"enabled"`
+
+	// CookieName là tên cookie lưu backend được chọn
+	// +kubebuilder:default=music-session
+	// +optional
+	CookieName string `json:"cookieName,omitempty"`
+
+	// MaxAgeSeconds là thời gian sống của cookie; để trống cookie hết hạn cùng phiên trình duyệt
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAgeSeconds *int32 `json:"maxAgeSeconds,omitempty"`
+}
+
+// LibraryMount tham chiếu một MusicLibrary cần mount vào pod ứng dụng
+type LibraryMount struct {
+	// Name là tên MusicLibrary
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// MountPath là đường dẫn mount trong container (mặc định /library/<tên>)
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
 }
 
 // MusicServiceStatus định nghĩa trạng thái quan sát được của MusicService
@@ -220,14 +1857,225 @@ type MusicServiceStatus struct {
 	// LastError là lỗi gần nhất trong quá trình đồng bộ
 	LastError string `json:"lastError,omitempty"`
 
+	// LastAppliedSpecHash là mã băm spec của lần reconcile đầy đủ thành công gần nhất;
+	// công cụ GitOps so sánh giá trị này để biết cluster đã khớp với spec đã commit
+	// +optional
+	LastAppliedSpecHash string `json:"lastAppliedSpecHash,omitempty"`
+
 	// Conditions thể hiện các quan sát mới nhất về trạng thái của MusicService
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	// Database là trạng thái cơ sở dữ liệu nếu được bật
 	// +optional
 	Database *DatabaseStatus `json:"database,omitempty"`
+
+	// Footprint là tổng tài nguyên tối đa của tất cả thành phần
+	// +optional
+	Footprint *ResourceFootprint `json:"footprint,omitempty"`
+
+	// TenantNamespace là namespace chứa tài nguyên con khi Tenancy.Mode là Dedicated
+	// +optional
+	TenantNamespace string `json:"tenantNamespace,omitempty"`
+
+	// Seed là trạng thái nạp nội dung ban đầu nếu cấu hình spec.seed
+	// +optional
+	Seed *SeedStatus `json:"seed,omitempty"`
+
+	// StorageMigration theo dõi quá trình co nhỏ music-data khi updatePolicy là Migrate
+	// +optional
+	StorageMigration *StorageMigrationStatus `json:"storageMigration,omitempty"`
+
+	// Volumes là mức sử dụng các PVC music-data và db-data do kubelet báo cáo ở lần reconcile gần nhất
+	// +optional
+	Volumes []VolumeUsageStatus `json:"volumes,omitempty"`
+
+	// Components là trạng thái từng tài nguyên con theo tên thành phần (app, service, hpa, db-master,
+	// db-replica, db-galera, db-hpa, backup) để công cụ bên ngoài không phải phân tích conditions
+	// +optional
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+
+	// TotalActiveConnections là tổng số kết nối streaming đang mở trên các pod ứng dụng sẵn sàng,
+	// đọc từ spec.connectionMetrics ở lần reconcile gần nhất
+	// +optional
+	TotalActiveConnections *int64 `json:"totalActiveConnections,omitempty"`
+
+	// ConnectionsPerReplica là số kết nối trung bình trên mỗi pod ứng dụng đã đọc được metrics
+	// +optional
+	ConnectionsPerReplica *int64 `json:"connectionsPerReplica,omitempty"`
+
+	// LastUsedTime là lần reconcile gần nhất thấy ít nhất một kết nối streaming đang mở
+	// +optional
+	LastUsedTime *metav1.Time `json:"lastUsedTime,omitempty"`
+
+	// ExpirationTime là thời điểm MusicService sẽ bị tự xóa theo ttlSecondsAfterCreation hoặc ttlSecondsAfterLastUse
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// Shards là trạng thái của từng shard khi có spec.shards; readyReplicas và desiredReplicas là tổng các shard
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Shards []ShardStatus `json:"shards,omitempty"`
+
+	// Partitioning là bảng phân chia nội dung cho các shard đang được áp dụng
+	// +optional
+	Partitioning *PartitioningStatus `json:"partitioning,omitempty"`
+
+	// Drift liệt kê các tài nguyên con có spec thực tế lệch khỏi spec operator dựng ra
+	// trong khi spec của MusicService không đổi, tức là bị sửa ngoài operator
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=name
+	// +optional
+	Drift []DriftedResource `json:"drift,omitempty"`
+}
+
+// DriftedResource là một tài nguyên con bị sửa ngoài operator
+type DriftedResource struct {
+	// Kind là kind của tài nguyên, ví dụ StatefulSet hoặc Service
+	Kind string `json:"kind"`
+
+	// Name là tên tài nguyên trong namespace của workload
+	Name string `json:"name"`
+
+	// Fields là đường dẫn các field lệch, ví dụ spec.replicas hoặc spec.template.spec.containers[music-service].image
+	Fields []string `json:"fields"`
+
+	// LastDetectedTime là lần reconcile gần nhất phát hiện khác biệt
+	LastDetectedTime metav1.Time `json:"lastDetectedTime"`
+
+	// Reverted cho biết operator đã ghi đè khác biệt (driftPolicy Revert). Mục này được giữ
+	// đến khi spec của MusicService đổi để thay đổi ngoài luồng vẫn được thấy sau khi bị ghi đè
+	// +optional
+	Reverted bool `json:"reverted,omitempty"`
+
+	// Generation là metadata.generation của MusicService lúc phát hiện khác biệt
+	Generation int64 `json:"generation"`
+}
+
+// PartitioningStatus là bảng phân chia nội dung đã render từ spec.partitioning
+type PartitioningStatus struct {
+	// Strategy là cách chia nội dung
+	Strategy PartitionStrategy `json:"strategy"`
+
+	// HashBuckets là số bucket của không gian hash khi strategy là HashRange
+	// +optional
+	HashBuckets int32 `json:"hashBuckets,omitempty"`
+
+	// Assignments là phần nội dung của từng shard, theo thứ tự spec.shards
+	Assignments []ShardPartition `json:"assignments"`
+}
+
+// ShardPartition là phần nội dung một shard sở hữu
+type ShardPartition struct {
+	// Shard là tên shard
+	Shard string `json:"shard"`
+
+	// Service là Service của shard mà lớp định tuyến gửi yêu cầu tới
+	Service string `json:"service"`
+
+	// Genres là các thể loại shard sở hữu (strategy Genre)
+	// +optional
+	Genres []string `json:"genres,omitempty"`
+
+	// Default cho biết shard nhận các thể loại chưa được gán (strategy Genre)
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// HashRange là khoảng bucket shard sở hữu (strategy HashRange)
+	// +optional
+	HashRange *HashRange `json:"hashRange,omitempty"`
+}
+
+// HashRange là khoảng bucket hash [start, end], tính cả hai đầu
+type HashRange struct {
+	// Start là bucket đầu tiên của khoảng
+	Start int32 `json:"start"`
+
+	// End là bucket cuối cùng của khoảng
+	End int32 `json:"end"`
+}
+
+// ShardStatus là trạng thái của một shard ứng dụng
+type ShardStatus struct {
+	// Name là tên shard
+	Name string `json:"name"`
+
+	// DesiredReplicas là số pod mong muốn của shard, có thể do HPA điều chỉnh
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// ReadyReplicas là số pod sẵn sàng của shard
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+// ComponentStatus là trạng thái tổng hợp của một tài nguyên con
+type ComponentStatus struct {
+	// Kind là kind của tài nguyên, ví dụ StatefulSet hoặc CronJob
+	Kind string `json:"kind"`
+
+	// Name là tên tài nguyên trong namespace của workload
+	Name string `json:"name"`
+
+	// Ready cho biết tài nguyên đã tồn tại và đạt trạng thái mong muốn
+	Ready bool `json:"ready"`
+
+	// Message mô tả ngắn trạng thái hiện tại, ví dụ "2/3 replicas ready"
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VolumeUsageStatus là dung lượng đã dùng của một PVC
+type VolumeUsageStatus struct {
+	// Component là thành phần sử dụng PVC: app, db-master, db-replica hoặc db-galera
+	Component string `json:"component"`
+
+	// PVC là tên PersistentVolumeClaim
+	PVC string `json:"pvc"`
+
+	// UsedBytes là số byte đã dùng trên filesystem của volume
+	UsedBytes int64 `json:"usedBytes"`
+
+	// CapacityBytes là tổng dung lượng filesystem của volume
+	CapacityBytes int64 `json:"capacityBytes"`
+
+	// UsedPercent là phần trăm đã dùng, làm tròn xuống
+	UsedPercent int32 `json:"usedPercent"`
+}
+
+// StorageMigrationStatus định nghĩa trạng thái di chuyển music-data: co nhỏ bằng sao lưu - tạo lại - khôi phục,
+// hoặc chuyển giữa PVC riêng từng pod và PVC dùng chung (Quiescing -> Copying -> Completed)
+type StorageMigrationStatus struct {
+	// Phase là bước hiện tại của quá trình
+	// +kubebuilder:validation:Enum=Quiescing;BackingUp;Recreating;Restoring;Verifying;Copying;Completed;Failed
+	Phase string `json:"phase,omitempty"`
+
+	// AccessMode là chế độ đích khi quá trình chuyển đổi spec.storage.accessMode; rỗng khi co nhỏ
+	// +optional
+	AccessMode StorageAccessMode `json:"accessMode,omitempty"`
+
+	// FailedPhase là bước bị lỗi khi Phase là Failed; xóa Job lỗi để chạy lại bước này
+	// +optional
+	FailedPhase string `json:"failedPhase,omitempty"`
+
+	// FromSize là kích thước PVC trước khi co nhỏ
+	FromSize string `json:"fromSize,omitempty"`
+
+	// TargetSize là kích thước PVC sau khi co nhỏ
+	TargetSize string `json:"targetSize,omitempty"`
+
+	// Replicas là số PVC music-data được di chuyển
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// StartTime là thời điểm bắt đầu
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Message mô tả bước hiện tại hoặc lỗi gần nhất
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas"
@@ -0,0 +1,487 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/example/managedapp-operator/internal/cron"
+	"github.com/example/managedapp-operator/pkg/database"
+)
+
+// AllowDataLossAnnotation cho phép thu nhỏ dung lượng lưu trữ khi UpdatePolicy là Recreate,
+// chấp nhận xóa và tạo lại PVC cùng toàn bộ dữ liệu
+const AllowDataLossAnnotation = "music.mixcorp.org/allow-data-loss"
+
+// log is for logging in this package.
+var musicservicelog = logf.Log.WithName("musicservice-resource")
+
+// MusicServiceValidator kiểm tra ngữ nghĩa của MusicService khi tạo và cập nhật
+type MusicServiceValidator struct {
+	// MemoryPerConnection là bộ nhớ tối thiểu cho mỗi kết nối streaming;
+	// giới hạn bộ nhớ của pod thấp hơn MaxConnections * MemoryPerConnection sẽ bị từ chối (0 = không kiểm tra)
+	MemoryPerConnection resource.Quantity
+
+	// ConnectionsPerCPU là số kết nối streaming một CPU phục vụ được;
+	// vượt quá chỉ sinh cảnh báo vì thiếu CPU làm chậm chứ không làm chết pod (0 = không kiểm tra)
+	ConnectionsPerCPU int64
+}
+
+// SetupWebhookWithManager đăng ký webhook của MusicService với manager
+func (r *MusicService) SetupWebhookWithManager(mgr ctrl.Manager, validator *MusicServiceValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(validator).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-music-mixcorp-org-v1-musicservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=music.mixcorp.org,resources=musicservices,verbs=create;update,versions=v1,name=vmusicservice.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &MusicServiceValidator{}
+
+// ValidateCreate kiểm tra tài nguyên so với số kết nối streaming
+func (v *MusicServiceValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ms, ok := obj.(*MusicService)
+	if !ok {
+		return nil, fmt.Errorf("expected a MusicService but got a %T", obj)
+	}
+	musicservicelog.V(1).Info("validate create", "name", ms.Name)
+
+	warnings, allErrs := v.validateStreamingCapacity(ms)
+	allErrs = append(allErrs, ms.ValidateQuantities()...)
+	allErrs = append(allErrs, ms.validateEphemeralStorage()...)
+	allErrs = append(allErrs, ms.validateBackupSchedule()...)
+	allErrs = append(allErrs, ms.validateDatabaseLocale()...)
+	allErrs = append(allErrs, ms.validateDatabaseEngine()...)
+	allErrs = append(allErrs, ms.validatePartitioning()...)
+	warnings = append(warnings, ms.deprecationWarnings()...)
+	return warnings, toInvalid(ms, allErrs)
+}
+
+// ValidateUpdate kiểm tra như ValidateCreate, đồng thời từ chối thu nhỏ spec.storage.size và
+// spec.database.storage.size vì PVC không thể thu nhỏ; chỉ cho phép khi UpdatePolicy là Migrate,
+// hoặc là Recreate và có annotation AllowDataLossAnnotation="true"
+func (v *MusicServiceValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldMS, ok := oldObj.(*MusicService)
+	if !ok {
+		return nil, fmt.Errorf("expected a MusicService but got a %T", oldObj)
+	}
+	ms, ok := newObj.(*MusicService)
+	if !ok {
+		return nil, fmt.Errorf("expected a MusicService but got a %T", newObj)
+	}
+	musicservicelog.V(1).Info("validate update", "name", ms.Name)
+
+	warnings, allErrs := v.validateStreamingCapacity(ms)
+	allErrs = append(allErrs, ms.ValidateQuantities()...)
+	allErrs = append(allErrs, ms.validateEphemeralStorage()...)
+	allErrs = append(allErrs, ms.validateBackupSchedule()...)
+	allErrs = append(allErrs, ms.validateDatabaseLocale()...)
+	allErrs = append(allErrs, ms.validateDatabaseEngine()...)
+	allErrs = append(allErrs, ms.validatePartitioning()...)
+	allErrs = append(allErrs, ms.validateStorageShrink(oldMS.Spec.Storage, ms.Spec.Storage, field.NewPath("spec", "storage"))...)
+
+	if oldMS.Spec.Database != nil && ms.Spec.Database != nil {
+		allErrs = append(allErrs, ms.validateStorageShrink(oldMS.Spec.Database.Storage, ms.Spec.Database.Storage,
+			field.NewPath("spec", "database", "storage"))...)
+	}
+
+	warnings = append(warnings, ms.deprecationWarnings()...)
+	return warnings, toInvalid(ms, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (v *MusicServiceValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func toInvalid(ms *MusicService, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("MusicService").GroupKind(), ms.Name, allErrs)
+}
+
+// validateStreamingCapacity đối chiếu MaxConnections với tài nguyên của pod ứng dụng và read pool
+func (v *MusicServiceValidator) validateStreamingCapacity(ms *MusicService) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+
+	check := func(resources *corev1.ResourceRequirements, path *field.Path) {
+		if resources == nil {
+			return
+		}
+		w, errs := v.checkResources(ms.Spec.Streaming.MaxConnections, resources, path)
+		warnings = append(warnings, w...)
+		allErrs = append(allErrs, errs...)
+	}
+
+	check(ms.Spec.Resources, field.NewPath("spec", "resources"))
+	if ms.Spec.ReadPool != nil && ms.Spec.ReadPool.Enabled {
+		check(ms.Spec.ReadPool.Resources, field.NewPath("spec", "readPool", "resources"))
+	}
+
+	return warnings, allErrs
+}
+
+func (v *MusicServiceValidator) checkResources(connections int32, resources *corev1.ResourceRequirements, path *field.Path) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+
+	if !v.MemoryPerConnection.IsZero() {
+		required := v.MemoryPerConnection.DeepCopy()
+		required.Mul(int64(connections))
+
+		if limit, ok := resources.Limits[corev1.ResourceMemory]; ok && limit.Cmp(required) < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("limits", "memory"), limit.String(), fmt.Sprintf(
+				"maxConnections %d needs at least %s of memory (%s per connection); the pod would be OOM-killed under full load",
+				connections, required.String(), v.MemoryPerConnection.String())))
+		} else if request, ok := resources.Requests[corev1.ResourceMemory]; ok && request.Cmp(required) < 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: memory request %s is below the %s needed for maxConnections %d",
+				path.Child("requests", "memory"), request.String(), required.String(), connections))
+		}
+	}
+
+	if v.ConnectionsPerCPU > 0 {
+		cpu, ok := resources.Limits[corev1.ResourceCPU]
+		if !ok {
+			cpu, ok = resources.Requests[corev1.ResourceCPU]
+		}
+		if ok && int64(connections)*1000 > cpu.MilliValue()*v.ConnectionsPerCPU {
+			warnings = append(warnings, fmt.Sprintf("%s: %s CPU serves about %d connections, fewer than maxConnections %d",
+				path.Child("cpu"), cpu.String(), cpu.MilliValue()*v.ConnectionsPerCPU/1000, connections))
+		}
+	}
+
+	return warnings, allErrs
+}
+
+// ValidateQuantities kiểm tra các kích thước dạng chuỗi trong spec có phải quantity hợp lệ không;
+// controller cũng gọi hàm này để báo lỗi qua status thay vì panic khi webhook bị tắt
+func (r *MusicService) ValidateQuantities() field.ErrorList {
+	var allErrs field.ErrorList
+
+	check := func(value string, path *field.Path) {
+		if value == "" {
+			return
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, value, err.Error()))
+		}
+	}
+
+	checkAutoGrow := func(autoGrow *StorageAutoGrowSpec, path *field.Path) {
+		if autoGrow == nil {
+			return
+		}
+		check(autoGrow.Step, path.Child("step"))
+		check(autoGrow.MaxSize, path.Child("maxSize"))
+	}
+
+	if storage := r.Spec.Storage; storage != nil {
+		check(storage.Size, field.NewPath("spec", "storage", "size"))
+		if storage.Cache != nil {
+			check(storage.Cache.Size, field.NewPath("spec", "storage", "cache", "size"))
+		}
+		checkAutoGrow(storage.AutoGrow, field.NewPath("spec", "storage", "autoGrow"))
+	}
+	if queue := r.Spec.Queue; queue != nil && queue.Storage != nil {
+		check(queue.Storage.Size, field.NewPath("spec", "queue", "storage", "size"))
+	}
+	if search := r.Spec.Search; search != nil && search.Storage != nil {
+		check(search.Storage.Size, field.NewPath("spec", "search", "storage", "size"))
+	}
+	if session := r.Spec.SessionStore; session != nil && session.Storage != nil {
+		check(session.Storage.Size, field.NewPath("spec", "sessionStore", "storage", "size"))
+	}
+	if objectStorage := r.Spec.ObjectStorage; objectStorage != nil {
+		check(objectStorage.Storage.Size, field.NewPath("spec", "objectStorage", "storage", "size"))
+	}
+	if logs := r.Spec.Logs; logs != nil {
+		check(logs.VolumeSize, field.NewPath("spec", "logs", "volumeSize"))
+		check(logs.MaxFileSize, field.NewPath("spec", "logs", "maxFileSize"))
+	}
+	if db := r.Spec.Database; db != nil {
+		if db.Storage != nil {
+			check(db.Storage.Size, field.NewPath("spec", "database", "storage", "size"))
+			checkAutoGrow(db.Storage.AutoGrow, field.NewPath("spec", "database", "storage", "autoGrow"))
+		}
+		if db.AuditLog != nil && db.AuditLog.FileRotateSize != "" {
+			check(db.AuditLog.FileRotateSize, field.NewPath("spec", "database", "auditLog", "fileRotateSize"))
+		}
+		if db.Backup != nil && db.Backup.Destination.PVC != nil {
+			check(db.Backup.Destination.PVC.Size, field.NewPath("spec", "database", "backup", "destination", "pvc", "size"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateBackupSchedule kiểm tra lịch cron và múi giờ của CronJob sao lưu,
+// để lịch sai bị từ chối khi tạo MusicService thay vì làm hỏng CronJob
+func (r *MusicService) validateBackupSchedule() field.ErrorList {
+	if r.Spec.Database == nil || r.Spec.Database.Backup == nil {
+		return nil
+	}
+	backup := r.Spec.Database.Backup
+	path := field.NewPath("spec", "database", "backup")
+
+	var allErrs field.ErrorList
+	if _, err := cron.Parse(backup.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("schedule"), backup.Schedule, err.Error()))
+	}
+	if backup.TimeZone != nil {
+		if _, err := time.LoadLocation(*backup.TimeZone); err != nil || *backup.TimeZone == "" || *backup.TimeZone == "Local" {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeZone"), *backup.TimeZone, "must be an IANA time zone name such as Europe/Berlin"))
+		}
+	}
+	return allErrs
+}
+
+// validateDatabaseLocale kiểm tra collation thuộc character set và múi giờ MariaDB hiểu được,
+// vì giá trị sai làm MariaDB không khởi động được sau khi pod đã bị khởi động lại
+func (r *MusicService) validateDatabaseLocale() field.ErrorList {
+	db := r.Spec.Database
+	if db == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "database")
+
+	var allErrs field.ErrorList
+	if db.CharacterSet != "" && db.Collation != "" && !strings.HasPrefix(db.Collation, db.CharacterSet+"_") {
+		allErrs = append(allErrs, field.Invalid(path.Child("collation"), db.Collation,
+			fmt.Sprintf("must be a collation of character set %s (%s_*)", db.CharacterSet, db.CharacterSet)))
+	}
+	switch tz := db.TimeZone; {
+	case tz == "" || tz == "SYSTEM":
+	case timeZoneOffsetPattern.MatchString(tz):
+		if tz[1:] > "13:00" {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeZone"), tz, "offset must be between -13:00 and +13:00"))
+		}
+	default:
+		if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeZone"), tz,
+				"must be SYSTEM, an offset such as +07:00 or an IANA time zone name such as Asia/Ho_Chi_Minh"))
+		}
+	}
+	return allErrs
+}
+
+// validateDatabaseEngine từ chối các tính năng mà provider của spec.database.engine không hỗ trợ,
+// vì builder chỉ sinh được Galera, sao lưu, audit log và tinh chỉnh replication bằng công cụ của MariaDB/MySQL
+func (r *MusicService) validateDatabaseEngine() field.ErrorList {
+	db := r.Spec.Database
+	if db == nil || db.Engine == "" {
+		return nil
+	}
+	features := database.GetProvider(string(db.Engine)).Features()
+	path := field.NewPath("spec", "database")
+	unsupported := func(p *field.Path) *field.Error {
+		return field.Forbidden(p, fmt.Sprintf("not supported by database engine %s", db.Engine))
+	}
+
+	var allErrs field.ErrorList
+	if !features.Galera && db.HighAvailability != nil && db.HighAvailability.Enabled {
+		allErrs = append(allErrs, unsupported(path.Child("highAvailability", "enabled")))
+	}
+	if !features.AuditLog && db.AuditLog != nil && db.AuditLog.Enabled {
+		allErrs = append(allErrs, unsupported(path.Child("auditLog", "enabled")))
+	}
+	if !features.Backup && db.Backup != nil && db.Backup.Enabled {
+		allErrs = append(allErrs, unsupported(path.Child("backup", "enabled")))
+	}
+	if !features.Backup && db.InitFrom != nil {
+		allErrs = append(allErrs, unsupported(path.Child("initFrom")))
+	}
+	if !features.Locale && db.CharacterSet != "" {
+		allErrs = append(allErrs, unsupported(path.Child("characterSet")))
+	}
+	if !features.Locale && db.Collation != "" {
+		allErrs = append(allErrs, unsupported(path.Child("collation")))
+	}
+	if replication := db.Replication; replication != nil {
+		replicationPath := path.Child("replication")
+		if !features.ParallelApply && replication.ParallelThreads != nil && *replication.ParallelThreads > 0 {
+			allErrs = append(allErrs, unsupported(replicationPath.Child("parallelThreads")))
+		}
+		if !features.ReplicationFilters && replication.Filters != nil {
+			allErrs = append(allErrs, unsupported(replicationPath.Child("filters")))
+		}
+		if !features.CredentialRotation && replication.CredentialRotationInterval != nil {
+			allErrs = append(allErrs, unsupported(replicationPath.Child("credentialRotationInterval")))
+		}
+	}
+	return allErrs
+}
+
+// validatePartitioning kiểm tra bảng phân chia nội dung có thể render được: mỗi thể loại chỉ thuộc một shard,
+// defaultShard là một shard có thật và mỗi shard nhận ít nhất một bucket hash
+func (r *MusicService) validatePartitioning() field.ErrorList {
+	partitioning := r.Spec.Partitioning
+	if partitioning == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "partitioning")
+	shardsPath := field.NewPath("spec", "shards")
+
+	var allErrs field.ErrorList
+	switch partitioning.Strategy {
+	case PartitionStrategyGenre:
+		owners := map[string]string{}
+		for i, shard := range r.Spec.Shards {
+			for j, genre := range shard.Genres {
+				if owner, ok := owners[genre]; ok {
+					allErrs = append(allErrs, field.Duplicate(shardsPath.Index(i).Child("genres").Index(j),
+						fmt.Sprintf("%s (already assigned to shard %s)", genre, owner)))
+					continue
+				}
+				owners[genre] = shard.Name
+			}
+		}
+		if partitioning.DefaultShard != "" {
+			found := false
+			for _, shard := range r.Spec.Shards {
+				found = found || shard.Name == partitioning.DefaultShard
+			}
+			if !found {
+				allErrs = append(allErrs, field.NotFound(path.Child("defaultShard"), partitioning.DefaultShard))
+			}
+		}
+	case PartitionStrategyHashRange:
+		if partitioning.HashBuckets > 0 && int(partitioning.HashBuckets) < len(r.Spec.Shards) {
+			allErrs = append(allErrs, field.Invalid(path.Child("hashBuckets"), partitioning.HashBuckets,
+				fmt.Sprintf("must be at least the number of shards (%d)", len(r.Spec.Shards))))
+		}
+		if partitioning.DefaultShard != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("defaultShard"), "only applies to strategy Genre"))
+		}
+		for i, shard := range r.Spec.Shards {
+			if len(shard.Genres) > 0 {
+				allErrs = append(allErrs, field.Forbidden(shardsPath.Index(i).Child("genres"), "only applies to partitioning strategy Genre"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// timeZoneOffsetPattern khớp độ lệch múi giờ dạng +HH:MM/-HH:MM của default_time_zone
+var timeZoneOffsetPattern = regexp.MustCompile(`^[+-][0-9]{2}:[0-5][0-9]$`)
+
+// validateEphemeralStorage kiểm tra request/limit ephemeral-storage và cache trên đĩa:
+// emptyDir Disk tính vào limit ephemeral-storage của pod nên cache lớn hơn limit sẽ làm pod bị evict
+func (r *MusicService) validateEphemeralStorage() field.ErrorList {
+	var allErrs field.ErrorList
+
+	check := func(resources *corev1.ResourceRequirements, path *field.Path) {
+		if resources == nil {
+			return
+		}
+		request, hasRequest := resources.Requests[corev1.ResourceEphemeralStorage]
+		limit, hasLimit := resources.Limits[corev1.ResourceEphemeralStorage]
+		if hasRequest && hasLimit && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("requests", string(corev1.ResourceEphemeralStorage)), request.String(),
+				fmt.Sprintf("must be less than or equal to the ephemeral-storage limit %s", limit.String())))
+		}
+	}
+	check(r.Spec.Resources, field.NewPath("spec", "resources"))
+	if r.Spec.ReadPool != nil && r.Spec.ReadPool.Enabled {
+		check(r.Spec.ReadPool.Resources, field.NewPath("spec", "readPool", "resources"))
+	}
+
+	if r.Spec.Storage == nil || r.Spec.Storage.Cache == nil || r.Spec.Storage.Cache.Size == "" {
+		return allErrs
+	}
+	cache := r.Spec.Storage.Cache
+	sizePath := field.NewPath("spec", "storage", "cache", "size")
+	// Kích thước không hợp lệ đã được ValidateQuantities báo lỗi
+	size, err := resource.ParseQuantity(cache.Size)
+	if err != nil {
+		return allErrs
+	}
+	if cache.Medium == CacheMediumMemory || r.Spec.Resources == nil {
+		return allErrs
+	}
+	if limit, ok := r.Spec.Resources.Limits[corev1.ResourceEphemeralStorage]; ok && size.Cmp(limit) > 0 {
+		allErrs = append(allErrs, field.Invalid(sizePath, cache.Size, fmt.Sprintf(
+			"the Disk cache counts toward the ephemeral-storage limit %s; the pod would be evicted before the cache fills",
+			limit.String())))
+	}
+
+	return allErrs
+}
+
+func (r *MusicService) validateStorageShrink(oldStorage, newStorage *StorageSpec, path *field.Path) field.ErrorList {
+	if oldStorage == nil || newStorage == nil {
+		return nil
+	}
+
+	oldSize, err := resource.ParseQuantity(oldStorage.Size)
+	if err != nil {
+		return nil
+	}
+	newSize, err := resource.ParseQuantity(newStorage.Size)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path.Child("size"), newStorage.Size, err.Error())}
+	}
+	if newSize.Cmp(oldSize) >= 0 {
+		return nil
+	}
+
+	if newStorage.UpdatePolicy == StorageUpdatePolicyRecreate && r.Annotations[AllowDataLossAnnotation] == "true" {
+		return nil
+	}
+	// Migrate sao lưu và khôi phục dữ liệu nên không cần annotation chấp nhận mất dữ liệu
+	if newStorage.UpdatePolicy == StorageUpdatePolicyMigrate {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(path.Child("size"), fmt.Sprintf(
+		"shrinking storage from %s to %s is not supported; set updatePolicy to Migrate to back up and restore the data (spec.storage only), or to Recreate and annotate with %s=\"true\" to recreate the volumes and lose their data",
+		oldStorage.Size, newStorage.Size, AllowDataLossAnnotation))}
+}
+
+// deprecationWarnings trả về cảnh báo cho các field sắp bị gỡ bỏ để người dùng chuyển sang field thay thế trước
+func (r *MusicService) deprecationWarnings() admission.Warnings {
+	var warnings admission.Warnings
+
+	db := r.Spec.Database
+	if db == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "database")
+
+	if db.RootPassword != "" {
+		warnings = append(warnings, fmt.Sprintf("%s is deprecated and will be removed: it stores the password in plain text; move it to a Secret and set %s instead",
+			path.Child("rootPassword"), path.Child("rootPasswordSecretRef")))
+	}
+
+	return warnings
+}
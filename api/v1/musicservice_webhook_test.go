@@ -0,0 +1,545 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newWebhookTestMusicService(size string) *MusicService {
+	return &MusicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-webhook",
+			Namespace: "default",
+		},
+		Spec: MusicServiceSpec{
+			Replicas: 1,
+			Image:    "nginx:latest",
+			Port:     8080,
+			Storage: &StorageSpec{
+				Size: size,
+			},
+			Streaming: StreamingSpec{
+				Bitrate:        "320k",
+				MaxConnections: 1000,
+			},
+		},
+	}
+}
+
+func TestValidateUpdateStorageShrink(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(ms *MusicService)
+		wantErr bool
+	}{
+		{
+			name:   "growing storage is allowed",
+			mutate: func(ms *MusicService) { ms.Spec.Storage.Size = "20Gi" },
+		},
+		{
+			name:    "shrinking storage is rejected",
+			mutate:  func(ms *MusicService) { ms.Spec.Storage.Size = "5Gi" },
+			wantErr: true,
+		},
+		{
+			name: "shrinking with Recreate but without the annotation is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Storage.Size = "5Gi"
+				ms.Spec.Storage.UpdatePolicy = StorageUpdatePolicyRecreate
+			},
+			wantErr: true,
+		},
+		{
+			name: "shrinking with Recreate and allow-data-loss is allowed",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Storage.Size = "5Gi"
+				ms.Spec.Storage.UpdatePolicy = StorageUpdatePolicyRecreate
+				ms.Annotations = map[string]string{AllowDataLossAnnotation: "true"}
+			},
+		},
+		{
+			name: "shrinking with Migrate is allowed",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Storage.Size = "5Gi"
+				ms.Spec.Storage.UpdatePolicy = StorageUpdatePolicyMigrate
+			},
+		},
+		{
+			name: "shrinking database storage is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Database.Storage.Size = "1Gi"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := newWebhookTestMusicService("10Gi")
+			old.Spec.Database = &DatabaseSpec{Enabled: true, Storage: &StorageSpec{Size: "5Gi"}}
+			updated := old.DeepCopy()
+			tt.mutate(updated)
+
+			_, err := (&MusicServiceValidator{}).ValidateUpdate(context.Background(), old, updated)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreateStreamingCapacity(t *testing.T) {
+	validator := &MusicServiceValidator{
+		MemoryPerConnection: resource.MustParse("64Ki"),
+		ConnectionsPerCPU:   2000,
+	}
+
+	tests := []struct {
+		name         string
+		resources    corev1.ResourceRequirements
+		wantErr      bool
+		wantWarnings int
+	}{
+		{
+			name: "enough memory and CPU",
+			resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+					corev1.ResourceCPU:    resource.MustParse("1"),
+				},
+			},
+		},
+		{
+			name: "memory limit below the per-connection budget is rejected",
+			resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("32Mi")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "low memory request only warns",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("32Mi")},
+			},
+			wantWarnings: 1,
+		},
+		{
+			name: "too little CPU only warns",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Resources = &tt.resources
+
+			warnings, err := validator.ValidateCreate(context.Background(), ms)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("expected %d warnings, got %v", tt.wantWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestValidateCreateEphemeralStorage(t *testing.T) {
+	validator := &MusicServiceValidator{}
+
+	tests := []struct {
+		name      string
+		resources *corev1.ResourceRequirements
+		cache     *CacheVolumeSpec
+		wantErr   bool
+	}{
+		{
+			name: "request within limit",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("4Gi")},
+			},
+			cache: &CacheVolumeSpec{Size: "2Gi"},
+		},
+		{
+			name: "request above limit is rejected",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("8Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("4Gi")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "disk cache above the limit is rejected",
+			resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+			},
+			cache:   &CacheVolumeSpec{Size: "2Gi"},
+			wantErr: true,
+		},
+		{
+			name: "memory cache does not count toward ephemeral-storage",
+			resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+			},
+			cache: &CacheVolumeSpec{Size: "2Gi", Medium: CacheMediumMemory},
+		},
+		{
+			name:    "invalid cache size is rejected",
+			cache:   &CacheVolumeSpec{Size: "lots"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Resources = tt.resources
+			ms.Spec.Storage.Cache = tt.cache
+
+			if _, err := validator.ValidateCreate(context.Background(), ms); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreateQuantities(t *testing.T) {
+	validator := &MusicServiceValidator{}
+
+	tests := []struct {
+		name    string
+		mutate  func(ms *MusicService)
+		wantErr bool
+	}{
+		{
+			name:   "valid sizes",
+			mutate: func(ms *MusicService) {},
+		},
+		{
+			name:    "invalid storage size is rejected",
+			mutate:  func(ms *MusicService) { ms.Spec.Storage.Size = "ten gigs" },
+			wantErr: true,
+		},
+		{
+			name: "invalid database storage size is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Database = &DatabaseSpec{Enabled: true, Storage: &StorageSpec{Size: "1x"}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid backup size is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Database = &DatabaseSpec{
+					Enabled: true,
+					Backup: &DatabaseBackupSpec{
+						Destination: BackupDestinationSpec{PVC: &BackupPVCDestination{Size: "big"}},
+					},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name:   "storage may be omitted",
+			mutate: func(ms *MusicService) { ms.Spec.Storage = nil },
+		},
+		{
+			name: "valid autoGrow sizes",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Storage.AutoGrow = &StorageAutoGrowSpec{ThresholdPercent: 80, Step: "5Gi", MaxSize: "100Gi"}
+			},
+		},
+		{
+			name: "invalid audit log rotate size is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Database = &DatabaseSpec{Enabled: true, AuditLog: &DatabaseAuditLogSpec{Enabled: true, FileRotateSize: "huge"}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid log rotation size is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Logs = &AppLogsSpec{VolumeSize: "2Gi", MaxFileSize: "a lot"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid autoGrow maxSize is rejected",
+			mutate: func(ms *MusicService) {
+				ms.Spec.Storage.AutoGrow = &StorageAutoGrowSpec{ThresholdPercent: 80, Step: "5Gi", MaxSize: "lots"}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			tt.mutate(ms)
+
+			if _, err := validator.ValidateCreate(context.Background(), ms); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreateBackupSchedule(t *testing.T) {
+	validator := &MusicServiceValidator{}
+	tz := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		schedule string
+		timeZone *string
+		wantErr  bool
+	}{
+		{name: "standard schedule", schedule: "0 3 * * *"},
+		{name: "macro with time zone", schedule: "@daily", timeZone: tz("Asia/Ho_Chi_Minh")},
+		{name: "too few fields", schedule: "0 3 * *", wantErr: true},
+		{name: "hour out of range", schedule: "0 25 * * *", wantErr: true},
+		{name: "inline time zone", schedule: "CRON_TZ=UTC 0 3 * * *", wantErr: true},
+		{name: "unknown time zone", schedule: "0 3 * * *", timeZone: tz("Mars/Olympus"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Database = &DatabaseSpec{
+				Enabled: true,
+				Backup: &DatabaseBackupSpec{
+					Enabled:     true,
+					Schedule:    tt.schedule,
+					TimeZone:    tt.timeZone,
+					Destination: BackupDestinationSpec{PVC: &BackupPVCDestination{Size: "20Gi"}},
+				},
+			}
+
+			if _, err := validator.ValidateCreate(context.Background(), ms); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreateDatabaseLocale(t *testing.T) {
+	validator := &MusicServiceValidator{}
+
+	tests := []struct {
+		name         string
+		characterSet string
+		collation    string
+		timeZone     string
+		wantErr      bool
+	}{
+		{name: "utf8mb4 with IANA time zone", characterSet: "utf8mb4", collation: "utf8mb4_unicode_ci", timeZone: "Asia/Ho_Chi_Minh"},
+		{name: "offset time zone", timeZone: "+07:00"},
+		{name: "system time zone", timeZone: "SYSTEM"},
+		{name: "collation of another character set", characterSet: "utf8mb4", collation: "latin1_swedish_ci", wantErr: true},
+		{name: "offset out of range", timeZone: "+14:00", wantErr: true},
+		{name: "unknown time zone", timeZone: "Mars/Olympus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Database = &DatabaseSpec{
+				Enabled:      true,
+				CharacterSet: tt.characterSet,
+				Collation:    tt.collation,
+				TimeZone:     tt.timeZone,
+			}
+
+			if _, err := validator.ValidateCreate(context.Background(), ms); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreateDatabaseEngine(t *testing.T) {
+	validator := &MusicServiceValidator{}
+	threads := int32(4)
+
+	tests := []struct {
+		name    string
+		engine  DatabaseEngine
+		mutate  func(db *DatabaseSpec)
+		wantErr string
+	}{
+		{name: "mariadb supports galera", engine: DatabaseEngineMariaDB, mutate: func(db *DatabaseSpec) {
+			db.HighAvailability = &DatabaseHighAvailabilitySpec{Enabled: true}
+		}},
+		{name: "plain postgresql", engine: DatabaseEnginePostgreSQL, mutate: func(db *DatabaseSpec) {}},
+		{name: "postgresql rejects galera", engine: DatabaseEnginePostgreSQL, mutate: func(db *DatabaseSpec) {
+			db.HighAvailability = &DatabaseHighAvailabilitySpec{Enabled: true}
+		}, wantErr: "spec.database.highAvailability.enabled"},
+		{name: "postgresql rejects backup", engine: DatabaseEnginePostgreSQL, mutate: func(db *DatabaseSpec) {
+			db.Backup = &DatabaseBackupSpec{Enabled: true, Schedule: "0 3 * * *"}
+		}, wantErr: "spec.database.backup.enabled"},
+		{name: "postgresql rejects character set", engine: DatabaseEnginePostgreSQL, mutate: func(db *DatabaseSpec) {
+			db.CharacterSet = "utf8mb4"
+		}, wantErr: "spec.database.characterSet"},
+		{name: "mysql supports replication filters", engine: DatabaseEngineMySQL, mutate: func(db *DatabaseSpec) {
+			db.Replication = &DatabaseReplicationSpec{Filters: &ReplicationFiltersSpec{DoDB: []string{"catalog"}}}
+		}},
+		{name: "mysql rejects parallel threads", engine: DatabaseEngineMySQL, mutate: func(db *DatabaseSpec) {
+			db.Replication = &DatabaseReplicationSpec{ParallelThreads: &threads}
+		}, wantErr: "spec.database.replication.parallelThreads"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Database = &DatabaseSpec{Enabled: true, Engine: tt.engine}
+			tt.mutate(ms.Spec.Database)
+
+			_, err := validator.ValidateCreate(context.Background(), ms)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCreate() error = %v, want error on %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreatePartitioning(t *testing.T) {
+	validator := &MusicServiceValidator{}
+
+	tests := []struct {
+		name         string
+		partitioning PartitioningSpec
+		shards       []AppShardSpec
+		wantErr      bool
+	}{
+		{
+			name:         "genres split across shards",
+			partitioning: PartitioningSpec{Strategy: PartitionStrategyGenre, DefaultShard: "b"},
+			shards:       []AppShardSpec{{Name: "a", Replicas: 1, Genres: []string{"rock", "jazz"}}, {Name: "b", Replicas: 1, Genres: []string{"pop"}}},
+		},
+		{
+			name:         "hash range",
+			partitioning: PartitioningSpec{Strategy: PartitionStrategyHashRange, HashBuckets: 2},
+			shards:       []AppShardSpec{{Name: "a", Replicas: 1}, {Name: "b", Replicas: 1}},
+		},
+		{
+			name:         "genre assigned to two shards",
+			partitioning: PartitioningSpec{Strategy: PartitionStrategyGenre},
+			shards:       []AppShardSpec{{Name: "a", Replicas: 1, Genres: []string{"rock"}}, {Name: "b", Replicas: 1, Genres: []string{"rock"}}},
+			wantErr:      true,
+		},
+		{
+			name:         "unknown default shard",
+			partitioning: PartitioningSpec{Strategy: PartitionStrategyGenre, DefaultShard: "c"},
+			shards:       []AppShardSpec{{Name: "a", Replicas: 1}, {Name: "b", Replicas: 1}},
+			wantErr:      true,
+		},
+		{
+			name:         "fewer buckets than shards",
+			partitioning: PartitioningSpec{Strategy: PartitionStrategyHashRange, HashBuckets: 1},
+			shards:       []AppShardSpec{{Name: "a", Replicas: 1}, {Name: "b", Replicas: 1}},
+			wantErr:      true,
+		},
+		{
+			name:         "genres with hash range",
+			partitioning: PartitioningSpec{Strategy: PartitionStrategyHashRange},
+			shards:       []AppShardSpec{{Name: "a", Replicas: 1, Genres: []string{"rock"}}, {Name: "b", Replicas: 1}},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Shards = tt.shards
+			ms.Spec.Partitioning = &tt.partitioning
+
+			if _, err := validator.ValidateCreate(context.Background(), ms); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDeprecatedFields(t *testing.T) {
+	validator := &MusicServiceValidator{}
+	minReplicas := int32(1)
+	maxReplicas := int32(3)
+
+	tests := []struct {
+		name         string
+		database     *DatabaseSpec
+		wantWarnings []string
+	}{
+		{
+			name:     "secret ref and autoscaling produce no warnings",
+			database: &DatabaseSpec{Enabled: true, RootPasswordSecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "db-root"}, Key: "password"}},
+		},
+		{
+			name:         "plaintext root password",
+			database:     &DatabaseSpec{Enabled: true, RootPassword: "secret"},
+			wantWarnings: []string{"spec.database.rootPassword"},
+		},
+		{
+			name:     "replication bounds are preferred over autoscaling and not deprecated",
+			database: &DatabaseSpec{Enabled: true, Replication: &DatabaseReplicationSpec{MinReplicas: &minReplicas, MaxReplicas: &maxReplicas}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newWebhookTestMusicService("10Gi")
+			ms.Spec.Database = tt.database
+
+			warnings, err := validator.ValidateCreate(context.Background(), ms)
+			if err != nil {
+				t.Fatalf("ValidateCreate() error = %v", err)
+			}
+			if len(warnings) != len(tt.wantWarnings) {
+				t.Fatalf("expected warnings for %v, got %v", tt.wantWarnings, warnings)
+			}
+			for i, want := range tt.wantWarnings {
+				if !strings.HasPrefix(warnings[i], want+" is deprecated") {
+					t.Errorf("expected warning %d about %s, got %q", i, want, warnings[i])
+				}
+			}
+
+			// Updates keep warning until the field is removed
+			warnings, _ = validator.ValidateUpdate(context.Background(), ms, ms.DeepCopy())
+			if len(warnings) != len(tt.wantWarnings) {
+				t.Errorf("expected update warnings for %v, got %v", tt.wantWarnings, warnings)
+			}
+		})
+	}
+}
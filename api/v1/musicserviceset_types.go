@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ cách dựng MusicService cho từng tenant, xem pkg/builder/service_set.go.
+// - Nếu chưa rõ vòng đời các MusicService con, xem internal/controller/musicserviceset_controller.go.
+
+// MusicServiceSetSpec định nghĩa một mẫu MusicService và danh sách tenant; mỗi tenant sinh ra một MusicService
+// tên <tên set>-<tên tenant> cùng namespace với set. MusicService con chỉ được ghi lại khi template hoặc tenant
+// của nó thay đổi, nên chỉnh sửa trực tiếp trên MusicService con được giữ tới lần thay đổi kế tiếp
+type MusicServiceSetSpec struct {
+	// Template là mẫu chung cho mọi MusicService của set
+	Template MusicServiceTemplate `json:"template"`
+
+	// Tenants là danh sách tenant; bỏ một tenant khỏi danh sách sẽ xóa MusicService tương ứng
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	Tenants []MusicServiceSetTenant `json:"tenants,omitempty"`
+}
+
+// MusicServiceTemplate là metadata và spec mẫu của MusicService con
+type MusicServiceTemplate struct {
+	// Labels được gắn vào mọi MusicService con
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations được gắn vào mọi MusicService con
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec là spec MusicService chung trước khi áp dụng overrides của tenant
+	Spec MusicServiceSpec `json:"spec"`
+}
+
+// MusicServiceSetTenant định nghĩa một tenant và phần spec riêng của nó
+type MusicServiceSetTenant struct {
+	// Name là tên tenant, dùng làm hậu tố tên MusicService con
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=30
+	Name string `json:"name"`
+
+	// Labels bổ sung cho MusicService của tenant, ghi đè key trùng của template
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Overrides là JSON merge patch áp dụng lên template.spec (ví dụ: {"replicas": 3, "ingress": {"host": "a.example.com"}});
+	// danh sách được thay thế toàn bộ, giá trị null xóa field khỏi template
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +optional
+	Overrides *runtime.RawExtension `json:"overrides,omitempty"`
+}
+
+// MusicServiceSetStatus định nghĩa trạng thái quan sát được của MusicServiceSet
+type MusicServiceSetStatus struct {
+	// ObservedGeneration là generation của set đã được reconcile
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Total là số tenant của set
+	Total int32 `json:"total"`
+
+	// Ready là số MusicService con đang ở phase Available
+	Ready int32 `json:"ready"`
+
+	// Instances là trạng thái từng MusicService con, sắp xếp theo tên tenant
+	// +optional
+	Instances []MusicServiceSetInstance `json:"instances,omitempty"`
+
+	// Conditions thể hiện các quan sát mới nhất về trạng thái của MusicServiceSet
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// MusicServiceSetInstance là trạng thái rút gọn của một MusicService con
+type MusicServiceSetInstance struct {
+	// Tenant là tên tenant
+	Tenant string `json:"tenant"`
+
+	// Name là tên MusicService con
+	Name string `json:"name"`
+
+	// Phase là status.phase của MusicService con (rỗng khi chưa được reconcile)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Message là lỗi khi dựng hoặc ghi MusicService con (ví dụ: overrides không hợp lệ)
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=mss
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.ready"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.total"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MusicServiceSet là schema cho API musicservicesets: sinh một MusicService cho mỗi tenant từ một mẫu chung,
+// dành cho mô hình SaaS chạy hàng trăm instance gần giống nhau
+type MusicServiceSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MusicServiceSetSpec   `json:"spec,omitempty"`
+	Status MusicServiceSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MusicServiceSetList chứa danh sách MusicServiceSet
+type MusicServiceSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MusicServiceSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MusicServiceSet{}, &MusicServiceSetList{})
+}
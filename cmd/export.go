@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	appv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/manifest"
+)
+
+// runExport xử lý subcommand "export": đọc một MusicService (YAML hoặc JSON) từ -f hoặc stdin
+// và in toàn bộ manifest con ra stdout mà không kết nối tới cluster
+func runExport(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var file string
+	fs.StringVar(&file, "f", "-", "Path to a MusicService manifest, or - to read from stdin. "+
+		"Use the output of kubectl get -o yaml so webhook defaults are present.")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	input := stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		input = f
+	}
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	ms := &appv1.MusicService{}
+	if err := yaml.UnmarshalStrict(data, ms); err != nil {
+		fmt.Fprintf(stderr, "parse MusicService: %v\n", err)
+		return 1
+	}
+	if ms.Name == "" {
+		fmt.Fprintln(stderr, "MusicService must have metadata.name")
+		return 1
+	}
+	if ms.Namespace == "" {
+		ms.Namespace = "default"
+	}
+
+	if err := manifest.WriteYAML(stdout, ms); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
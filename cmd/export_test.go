@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const exportManifest = `apiVersion: music.mixcorp.org/v1
+kind: MusicService
+metadata:
+  name: export
+spec:
+  image: nginx:latest
+  replicas: 1
+  port: 8080
+`
+
+func TestRunExport(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "musicservice.yaml")
+	if err := os.WriteFile(file, []byte(exportManifest), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantCode   int
+		wantStdout []string
+		wantStderr string
+	}{
+		{
+			name:       "reads stdin and defaults the namespace",
+			stdin:      exportManifest,
+			wantStdout: []string{"kind: Service\n", "---\n", "kind: StatefulSet\n", "namespace: default\n"},
+		},
+		{
+			name:       "reads the file given with -f",
+			args:       []string{"-f", file},
+			wantStdout: []string{"kind: StatefulSet\n"},
+		},
+		{
+			name:       "rejects a manifest without metadata.name",
+			stdin:      strings.Replace(exportManifest, "  name: export\n", "  namespace: music\n", 1),
+			wantCode:   1,
+			wantStderr: "MusicService must have metadata.name",
+		},
+		{
+			name:       "rejects unknown fields",
+			stdin:      exportManifest + "  replica: 3\n",
+			wantCode:   1,
+			wantStderr: "parse MusicService",
+		},
+		{
+			name:       "reports a missing file",
+			args:       []string{"-f", filepath.Join(t.TempDir(), "missing.yaml")},
+			wantCode:   1,
+			wantStderr: "no such file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := runExport(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("expected exit code %d, got %d (stderr: %s)", tt.wantCode, code, stderr.String())
+			}
+			for _, want := range tt.wantStdout {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("expected %q in stdout:\n%s", want, stdout.String())
+				}
+			}
+			if tt.wantStderr != "" && !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("expected %q in stderr, got %q", tt.wantStderr, stderr.String())
+			}
+			if tt.wantCode != 0 && stdout.Len() != 0 {
+				t.Errorf("expected no manifests on failure, got:\n%s", stdout.String())
+			}
+		})
+	}
+}
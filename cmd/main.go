@@ -17,18 +17,31 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	// Import tất cả plugin xác thực của Kubernetes client (ví dụ: Azure, GCP, OIDC, ...)
 	// để đảm bảo exec-entrypoint và run có thể sử dụng chúng.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	uzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -36,13 +49,14 @@ import (
 
 	appv1 "github.com/example/managedapp-operator/api/v1"
 	"github.com/example/managedapp-operator/internal/controller"
+	"github.com/example/managedapp-operator/internal/notify"
 	// +kubebuilder:scaffold:imports
 )
 
 // Hướng dẫn đọc nhanh:
 // - Bắt đầu từ cmd/main.go để hiểu cách khởi tạo Manager.
 // - Nếu chưa rõ vòng lặp reconcile, xem internal/controller/musicservice_controller.go.
-// - Nếu chưa rõ logic tạo tài nguyên, xem internal/builder/resource_builder.go.
+// - Nếu chưa rõ logic tạo tài nguyên, xem pkg/builder/resource_builder.go.
 // - Nếu chưa rõ các field trong CRD, xem api/v1/musicservice_types.go.
 
 var (
@@ -58,29 +72,141 @@ func init() {
 }
 
 func main() {
+	// Subcommand export chạy offline, không khởi tạo Manager
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(runExport(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
+	var leaseDuration, renewDeadline, retryPeriod time.Duration
+	var leaderElectionNamespace string
 	var probeAddr string
+	var webhookAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var tenantBudgetCPU, tenantBudgetMemory, tenantBudgetStorage string
+	var managementNamespace string
+	var logEncoding, logLevel string
+	var logSampling bool
+	var grafanaDashboards bool
+	var grafanaDashboardLabel string
+	var memoryPerConnection string
+	var connectionsPerCPU int64
+	var driftResyncInterval, syncPeriod time.Duration
+	var defaultCPURequest, defaultMemoryRequest, defaultEphemeralStorageRequest string
+	var notificationWebhookURL, notificationFormat string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metric endpoint binds to. "+
 		"Use the port :8080. If not set, it will be 0 in order to disable the metrics server")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&webhookAddr, "webhook-bind-address", ":9443", "The address the webhook server binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second,
+		"How long non-leader replicas wait before forcibly acquiring leadership; this bounds failover time.")
+	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second,
+		"How long the leader keeps retrying to renew its lease before giving up leadership.")
+	flag.DurationVar(&retryPeriod, "leader-elect-retry-period", 2*time.Second,
+		"How long candidates wait between attempts to acquire or renew leadership.")
+	flag.StringVar(&leaderElectionNamespace, "leader-elect-namespace", "",
+		"Namespace of the leader election Lease. Defaults to the namespace the operator runs in.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", false,
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&tenantBudgetCPU, "tenant-budget-cpu", "",
+		"Maximum total CPU requests of all MusicServices in a namespace (e.g. \"8\"). Empty means unlimited.")
+	flag.StringVar(&tenantBudgetMemory, "tenant-budget-memory", "",
+		"Maximum total memory requests of all MusicServices in a namespace (e.g. \"16Gi\"). Empty means unlimited.")
+	flag.StringVar(&tenantBudgetStorage, "tenant-budget-storage", "",
+		"Maximum total PVC storage of all MusicServices in a namespace (e.g. \"500Gi\"). Empty means unlimited.")
+	flag.StringVar(&managementNamespace, "management-namespace", "",
+		"Namespace whose MusicServices may use spec.tenancy.mode=Dedicated to provision their own tenant namespace. "+
+			"Empty disables dedicated tenancy.")
+	flag.StringVar(&logEncoding, "log-encoding", "",
+		"Log encoding: \"json\" or \"console\". Empty follows --zap-devel (console in development mode, JSON otherwise).")
+	flag.StringVar(&logLevel, "log-level", "",
+		"Minimum log level: debug, info, warn or error. Empty follows --zap-log-level.")
+	flag.BoolVar(&logSampling, "log-sampling", false,
+		"Sample repeated log entries (first 100 per second, then every 100th). "+
+			"Production mode (--zap-devel=false) always samples above debug level.")
+	flag.BoolVar(&grafanaDashboards, "grafana-dashboards", false,
+		"Generate a Grafana dashboard ConfigMap for every MusicService.")
+	flag.StringVar(&grafanaDashboardLabel, "grafana-dashboard-label", "grafana_dashboard=1",
+		"Label (key=value) the Grafana dashboard sidecar watches for.")
+	flag.StringVar(&memoryPerConnection, "streaming-memory-per-connection", "64Ki",
+		"Memory each streaming connection needs; the webhook rejects memory limits below maxConnections times this value. "+
+			"Empty or 0 disables the check.")
+	flag.Int64Var(&connectionsPerCPU, "streaming-connections-per-cpu", 5000,
+		"Streaming connections one CPU can serve; the webhook warns when maxConnections exceeds the CPU allocation. 0 disables the check.")
+	flag.DurationVar(&driftResyncInterval, "drift-resync-interval", controller.DefaultDriftResyncInterval,
+		"How often an unchanged, healthy MusicService is fully rebuilt and diffed against its children to correct drift. "+
+			"This is also the steady-state requeue interval after a successful reconcile.")
+	flag.DurationVar(&syncPeriod, "sync-period", 0,
+		"Minimum interval at which the informer cache re-lists every watched object and triggers a reconcile. "+
+			"0 keeps the controller-runtime default (about 10 hours).")
+	flag.StringVar(&defaultCPURequest, "default-cpu-request", "100m",
+		"CPU request of app and read pool containers whose MusicService sets no resources, so HPA utilization works. "+
+			"Empty leaves the CPU request unset.")
+	flag.StringVar(&defaultMemoryRequest, "default-memory-request", "128Mi",
+		"Memory request of app and read pool containers whose MusicService sets no resources. "+
+			"Empty leaves the memory request unset.")
+	flag.StringVar(&defaultEphemeralStorageRequest, "default-ephemeral-storage-request", "",
+		"Ephemeral-storage request of app and read pool containers whose MusicService sets no resources (e.g. \"1Gi\"). "+
+			"Empty leaves the ephemeral-storage request unset.")
+	flag.StringVar(&notificationWebhookURL, "notification-webhook-url", os.Getenv("NOTIFICATION_WEBHOOK_URL"),
+		"Webhook that receives a POST when a MusicService turns Failed, a backup Job fails or the database master "+
+			"stops being ready. Defaults to $NOTIFICATION_WEBHOOK_URL so the URL can come from a Secret; empty disables notifications.")
+	flag.StringVar(&notificationFormat, "notification-format", string(notify.FormatJSON),
+		"Payload of --notification-webhook-url: \"json\" (the event as JSON) or \"slack\" (Slack incoming-webhook message).")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	if err := applyLogFlags(&opts, logEncoding, logLevel, logSampling); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	tenantBudget, err := parseResourceFlags("--tenant-budget-%s", map[corev1.ResourceName]string{
+		corev1.ResourceCPU:     tenantBudgetCPU,
+		corev1.ResourceMemory:  tenantBudgetMemory,
+		corev1.ResourceStorage: tenantBudgetStorage,
+	})
+	if err != nil {
+		setupLog.Error(err, "invalid tenant budget")
+		os.Exit(1)
+	}
+
+	defaultRequests, err := parseResourceFlags("--default-%s-request", map[corev1.ResourceName]string{
+		corev1.ResourceCPU:              defaultCPURequest,
+		corev1.ResourceMemory:           defaultMemoryRequest,
+		corev1.ResourceEphemeralStorage: defaultEphemeralStorageRequest,
+	})
+	if err != nil {
+		setupLog.Error(err, "invalid default resource requests")
+		os.Exit(1)
+	}
+
+	notifier, err := notify.NewNotifier(notificationWebhookURL, notify.Format(notificationFormat))
+	if err != nil {
+		setupLog.Error(err, "invalid --notification-format")
+		os.Exit(1)
+	}
+
+	var dashboardLabelKey, dashboardLabelValue string
+	if grafanaDashboards {
+		dashboardLabelKey, dashboardLabelValue, err = parseLabel(grafanaDashboardLabel)
+		if err != nil {
+			setupLog.Error(err, "invalid grafana dashboard label")
+			os.Exit(1)
+		}
+	}
+
 	// nếu cờ enable-http2 là false (mặc định) thì cần tắt http/2
 	// do có lỗ hổng bảo mật. Cụ thể, tắt http/2 sẽ
 	// tránh các lỗ hổng HTTP/2 Stream Cancellation và Rapid Reset.
@@ -97,21 +223,45 @@ func main() {
 		tlsOpts = append(tlsOpts, disableHTTP2)
 	}
 
+	// RenewDeadline phải nhỏ hơn LeaseDuration và lớn hơn RetryPeriod, nếu không leader sẽ liên tục mất quyền
+	if enableLeaderElection && (renewDeadline >= leaseDuration || retryPeriod >= renewDeadline) {
+		setupLog.Error(fmt.Errorf("need retry-period < renew-deadline < lease-duration"), "invalid --leader-elect-* durations")
+		os.Exit(1)
+	}
+
+	webhookHost, webhookPort, err := parseBindAddress(webhookAddr)
+	if err != nil {
+		setupLog.Error(err, "invalid --webhook-bind-address")
+		os.Exit(1)
+	}
 	webhookServer := webhook.NewServer(webhook.Options{
+		Host:    webhookHost,
+		Port:    webhookPort,
 		TLSOpts: tlsOpts,
 	})
 
+	// SyncPeriod chỉ được đặt khi có cờ --sync-period, nếu không dùng mặc định của controller-runtime
+	cacheOpts := cache.Options{}
+	if syncPeriod > 0 {
+		cacheOpts.SyncPeriod = &syncPeriod
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
+		Cache:  cacheOpts,
 		Metrics: metricsserver.Options{
 			BindAddress:   metricsAddr,
 			SecureServing: secureMetrics,
 			TLSOpts:       tlsOpts,
 		},
-		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "f358b7ec.dev.example.com",
+		WebhookServer:           webhookServer,
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "f358b7ec.dev.example.com",
+		LeaseDuration:           &leaseDuration,
+		RenewDeadline:           &renewDeadline,
+		RetryPeriod:             &retryPeriod,
+		LeaderElectionNamespace: leaderElectionNamespace,
 		// LeaderElectionReleaseOnCancel xác định leader có tự nguyện nhường quyền không
 		// khi Manager kết thúc. Điều này yêu cầu binary kết thúc ngay khi
 		// Manager dừng lại, nếu không thì cấu hình này không an toàn. Bật tùy chọn này
@@ -130,12 +280,55 @@ func main() {
 	}
 
 	if err = (&controller.MusicServiceReconciler{
+		Client:              mgr.GetClient(),
+		Scheme:              mgr.GetScheme(),
+		TenantBudget:        tenantBudget,
+		ManagementNamespace: managementNamespace,
+		DashboardLabelKey:   dashboardLabelKey,
+		DashboardLabelValue: dashboardLabelValue,
+		DriftResyncInterval: driftResyncInterval,
+		DefaultResources:    corev1.ResourceRequirements{Requests: defaultRequests},
+		Notifier:            notifier,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MusicService")
+		os.Exit(1)
+	}
+	if err = (&controller.MusicLibraryReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "MusicService")
+		setupLog.Error(err, "unable to create controller", "controller", "MusicLibrary")
 		os.Exit(1)
 	}
+	if err = (&controller.MusicServiceSetReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MusicServiceSet")
+		os.Exit(1)
+	}
+	if err = (&controller.StatefulSetAdoptionReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StatefulSetAdoption")
+		os.Exit(1)
+	}
+	// Đặt ENABLE_WEBHOOKS=false khi chạy operator ngoài cluster (make run) vì không có chứng chỉ webhook
+	enableWebhooks := os.Getenv("ENABLE_WEBHOOKS") != "false"
+	if enableWebhooks {
+		validator := &appv1.MusicServiceValidator{ConnectionsPerCPU: connectionsPerCPU}
+		if memoryPerConnection != "" {
+			if validator.MemoryPerConnection, err = resource.ParseQuantity(memoryPerConnection); err != nil {
+				setupLog.Error(err, "invalid --streaming-memory-per-connection")
+				os.Exit(1)
+			}
+		}
+		if err = (&appv1.MusicService{}).SetupWebhookWithManager(mgr, validator); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "MusicService")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -146,6 +339,17 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	// Chỉ nhận traffic khi informer cache đã đồng bộ và webhook server đã lắng nghe
+	if err := mgr.AddReadyzCheck("cache-sync", cacheSyncChecker(mgr.GetCache())); err != nil {
+		setupLog.Error(err, "unable to set up cache sync check")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err := mgr.AddReadyzCheck("webhook", webhookServer.StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -153,3 +357,91 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// parseBindAddress tách địa chỉ dạng host:port; host rỗng nghĩa là lắng nghe trên mọi interface
+func parseBindAddress(addr string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port %q", portStr)
+	}
+	return host, port, nil
+}
+
+// cacheSyncChecker báo chưa sẵn sàng cho tới khi informer cache đồng bộ xong
+func cacheSyncChecker(c cache.Cache) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), time.Second)
+		defer cancel()
+		if !c.WaitForCacheSync(ctx) {
+			return fmt.Errorf("informer cache not synced")
+		}
+		return nil
+	}
+}
+
+// parseResourceFlags chuyển các cờ tài nguyên (--tenant-budget-*, --default-*-request) thành ResourceList, bỏ qua giá trị rỗng
+func parseResourceFlags(flagPattern string, values map[corev1.ResourceName]string) (corev1.ResourceList, error) {
+	list := corev1.ResourceList{}
+	for name, value := range values {
+		if value == "" {
+			continue
+		}
+		qty, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fmt.Sprintf(flagPattern, name), err)
+		}
+		list[name] = qty
+	}
+	return list, nil
+}
+
+// parseLabel tách nhãn dạng key=value; thiếu "=value" thì giá trị mặc định là "1"
+func parseLabel(label string) (string, string, error) {
+	key, value, found := strings.Cut(label, "=")
+	if !found {
+		value = "1"
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return "", "", fmt.Errorf("--grafana-dashboard-label: %s", strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return "", "", fmt.Errorf("--grafana-dashboard-label: %s", strings.Join(errs, "; "))
+	}
+	return key, value, nil
+}
+
+// applyLogFlags áp dụng các cờ --log-* lên tùy chọn zap, ghi đè giá trị từ các cờ --zap-*
+func applyLogFlags(opts *zap.Options, encoding, level string, sampling bool) error {
+	switch encoding {
+	case "":
+	case "json":
+		zap.JSONEncoder()(opts)
+	case "console":
+		zap.ConsoleEncoder()(opts)
+	default:
+		return fmt.Errorf("--log-encoding: unsupported encoding %q", encoding)
+	}
+
+	if level != "" {
+		parsed, err := zapcore.ParseLevel(level)
+		if err != nil {
+			return fmt.Errorf("--log-level: %w", err)
+		}
+		atomic := uzap.NewAtomicLevelAt(parsed)
+		opts.Level = &atomic
+	}
+
+	// Ở chế độ production controller-runtime đã tự lấy mẫu, chỉ cần thêm sampler cho chế độ development.
+	// Sampler của zap không hỗ trợ mức verbosity thấp hơn debug (-2 trở xuống)
+	if sampling && opts.Development && (opts.Level == nil || !opts.Level.Enabled(zapcore.Level(-2))) {
+		opts.ZapOpts = append(opts.ZapOpts, uzap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewSamplerWithOptions(core, time.Second, 100, 100)
+		}))
+	}
+
+	return nil
+}
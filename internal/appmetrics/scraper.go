@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmetrics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Scraper reads a metric from the Prometheus text endpoint of app pods through the API server pod proxy
+// (GET /api/v1/namespaces/<ns>/pods/<pod>:<port>/proxy/<path>), which needs get on pods/proxy.
+// A nil Scraper reports no value
+type Scraper struct {
+	rest rest.Interface
+}
+
+// NewScraper creates a Scraper from the operator's REST config
+func NewScraper(cfg *rest.Config) (*Scraper, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewScraperForClient(clientset.CoreV1().RESTClient()), nil
+}
+
+// NewScraperForClient creates a Scraper that reads pod proxy endpoints through a core/v1 REST client
+func NewScraperForClient(client rest.Interface) *Scraper {
+	return &Scraper{rest: client}
+}
+
+// Scrape returns the sum of every series of the metric exposed by the pod; found is false when
+// the endpoint does not expose the metric
+func (s *Scraper) Scrape(ctx context.Context, namespace, pod string, port int32, path, metric string) (float64, bool, error) {
+	if s == nil {
+		return 0, false, nil
+	}
+	data, err := s.rest.Get().Namespace(namespace).Resource("pods").Name(fmt.Sprintf("%s:%d", pod, port)).
+		SubResource("proxy").Suffix(path).DoRaw(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	return parseMetric(data, metric)
+}
+
+// parseMetric sums the samples of a metric in the Prometheus text exposition format
+func parseMetric(data []byte, metric string) (float64, bool, error) {
+	var sum float64
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || !strings.HasPrefix(line, metric) {
+			continue
+		}
+		rest := line[len(metric):]
+		switch {
+		case strings.HasPrefix(rest, "{"):
+			end := labelsEnd(rest)
+			if end < 0 {
+				return 0, false, fmt.Errorf("unterminated labels in %q", line)
+			}
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, " "), strings.HasPrefix(rest, "\t"):
+		default:
+			// Another metric sharing the prefix, e.g. <metric>_total
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return 0, false, fmt.Errorf("missing value in %q", line)
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid value in %q: %w", line, err)
+		}
+		sum += value
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, false, err
+	}
+	return sum, found, nil
+}
+
+// labelsEnd returns the index of the brace closing the label set, skipping braces inside quoted values
+func labelsEnd(s string) int {
+	quoted := false
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quoted && s[i] == '\\':
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case !quoted && s[i] == '}':
+			return i
+		}
+	}
+	return -1
+}
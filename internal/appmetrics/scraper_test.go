@@ -0,0 +1,47 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmetrics
+
+import "testing"
+
+func TestParseMetric(t *testing.T) {
+	data := []byte(`# HELP music_streaming_active_connections Open listener connections.
+# TYPE music_streaming_active_connections gauge
+music_streaming_active_connections{codec="mp3",station="jazz {live}"} 12
+music_streaming_active_connections{codec="aac"} 5 1760000000000
+music_streaming_active_connections_total 900
+process_open_fds 40
+`)
+
+	got, found, err := parseMetric(data, "music_streaming_active_connections")
+	if err != nil {
+		t.Fatalf("parseMetric: %v", err)
+	}
+	if !found || got != 17 {
+		t.Errorf("expected the sum of both series (17), got %v (found %v)", got, found)
+	}
+
+	if _, found, _ := parseMetric(data, "music_streaming_listeners"); found {
+		t.Error("expected a missing metric not to be found")
+	}
+	if _, _, err := parseMetric([]byte("music_streaming_active_connections many"), "music_streaming_active_connections"); err == nil {
+		t.Error("expected an error for a malformed value")
+	}
+	if got, found, _ := parseMetric([]byte("music_streaming_active_connections 3"), "music_streaming_active_connections"); !found || got != 3 {
+		t.Errorf("expected 3 for an unlabelled sample, got %v", got)
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// adoptedVolumeAnnotation records on the MariaDB StatefulSet which PersistentVolume is being moved,
+// since the volume name is lost once the old claim is deleted
+const adoptedVolumeAnnotation = "music.mixcorp.org/adopted-volume"
+
+// StatefulSetAdoptionReconciler turns a hand-rolled StatefulSet annotated with music.mixcorp.org/adopt
+// into an equivalent MusicService and hands its pods, Service and MariaDB data over to the operator
+type StatefulSetAdoptionReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	resourceBuilder *builder.ResourceBuilder
+}
+
+// +kubebuilder:rbac:groups=core,resources=persistentvolumes,verbs=get;list;watch;update;patch
+
+// Reconcile adopts an annotated StatefulSet without restarting its pods at once:
+//  1. the pods get the labels of the operator's StatefulSet so the new one claims them;
+//  2. a MariaDB StatefulSet named in music.mixcorp.org/adopt-database is stopped and its volume is
+//     rebound to the claim of the operator's db-master (the only downtime of the adoption);
+//  3. the MusicService is created and the Service selects the relabelled pods;
+//  4. the old StatefulSet is deleted with orphaned pods, which the operator's StatefulSet then adopts
+//     and rolls to its own pod template one at a time
+func (r *StatefulSetAdoptionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, req.NamespacedName, sts); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	mode := sts.Annotations[builder.AdoptAnnotation]
+	if (mode != "true" && mode != builder.AdoptForce) || sts.DeletionTimestamp != nil || metav1.GetControllerOf(sts) != nil {
+		return ctrl.Result{}, nil
+	}
+
+	// A MusicService with that name may only come from an earlier pass of this adoption
+	adoptedFrom := "StatefulSet/" + sts.Name
+	ms := &musicv1.MusicService{}
+	err := r.Get(ctx, req.NamespacedName, ms)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	created := err == nil
+	if created && ms.Annotations[builder.AdoptedFromAnnotation] != adoptedFrom {
+		r.Recorder.Eventf(sts, corev1.EventTypeWarning, "AdoptionConflict", "MusicService %s already exists and was not created from this StatefulSet", sts.Name)
+		return ctrl.Result{}, nil
+	}
+
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, req.NamespacedName, svc); errors.IsNotFound(err) {
+		svc = nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var db *appsv1.StatefulSet
+	if name := sts.Annotations[builder.AdoptDatabaseAnnotation]; name != "" {
+		db = &appsv1.StatefulSet{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: sts.Namespace, Name: name}, db)
+		switch {
+		case errors.IsNotFound(err) && created:
+			// Removed by an earlier pass once its volume was handed over
+			db = nil
+		case errors.IsNotFound(err):
+			r.Recorder.Eventf(sts, corev1.EventTypeWarning, "AdoptionFailed", "database StatefulSet %s not found", name)
+			return ctrl.Result{}, nil
+		case err != nil:
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !created {
+		adoption, err := r.resourceBuilder.BuildAdoption(sts, svc, db)
+		if err != nil {
+			r.Recorder.Event(sts, corev1.EventTypeWarning, "AdoptionFailed", err.Error())
+			return ctrl.Result{}, nil
+		}
+		if len(adoption.Unmapped) > 0 && mode != builder.AdoptForce {
+			r.Recorder.Eventf(sts, corev1.EventTypeWarning, "AdoptionBlocked",
+				"MusicService cannot express %s; remove them or set %s=%s to adopt anyway",
+				strings.Join(adoption.Unmapped, ", "), builder.AdoptAnnotation, builder.AdoptForce)
+			return ctrl.Result{}, nil
+		}
+		ms = adoption.MusicService
+	}
+
+	if err := r.relabelPods(ctx, sts, ms); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if db != nil {
+		done, err := r.handOverDatabase(ctx, db, ms)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !done {
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+	}
+
+	if !created {
+		log.Info("Creating MusicService from StatefulSet", "MusicService", ms.Name)
+		if err := r.Create(ctx, ms); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(sts, corev1.EventTypeNormal, "Adopting", "Created MusicService %s", ms.Name)
+	}
+
+	if svc != nil {
+		if err := r.handOverService(ctx, svc, ms); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The operator's StatefulSet uses the same name and claims the orphaned pods and music-data PVCs
+	log.Info("Deleting adopted StatefulSet, keeping its pods", "StatefulSet", sts.Name)
+	if err := r.Delete(ctx, sts, client.PropagationPolicy(metav1.DeletePropagationOrphan)); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Eventf(ms, corev1.EventTypeNormal, "Adopted", "Adopted StatefulSet %s", sts.Name)
+	return ctrl.Result{}, nil
+}
+
+// relabelPods adds the pod labels of the operator's StatefulSet to the pods of the adopted one
+func (r *StatefulSetAdoptionReconciler) relabelPods(ctx context.Context, sts *appsv1.StatefulSet, ms *musicv1.MusicService) error {
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(sts.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return err
+	}
+
+	podLabels := r.resourceBuilder.BuildAppStatefulSet(ms).Spec.Template.Labels
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		maps.Copy(pod.Labels, podLabels)
+		if err := r.Patch(ctx, pod, patch); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handOverService points the existing Service at the relabelled pods and puts it under the MusicService
+func (r *StatefulSetAdoptionReconciler) handOverService(ctx context.Context, svc *corev1.Service, ms *musicv1.MusicService) error {
+	desired := r.resourceBuilder.BuildAppService(ms)
+	patch := client.MergeFrom(svc.DeepCopy())
+	svc.Spec.Selector = desired.Spec.Selector
+	if svc.Labels == nil {
+		svc.Labels = map[string]string{}
+	}
+	maps.Copy(svc.Labels, desired.Labels)
+	if err := controllerutil.SetControllerReference(ms, svc, r.Scheme); err != nil {
+		return err
+	}
+	return r.Patch(ctx, svc, patch)
+}
+
+// handOverDatabase moves the volume of a single-instance MariaDB StatefulSet to the claim the operator's
+// db-master StatefulSet will use. It stops MariaDB, keeps the PersistentVolume through a Retain policy,
+// replaces the claim and finally removes the StatefulSet and points its Services at the new master.
+// It returns false while a step is still in progress
+func (r *StatefulSetAdoptionReconciler) handOverDatabase(ctx context.Context, db *appsv1.StatefulSet, ms *musicv1.MusicService) (bool, error) {
+	log := log.FromContext(ctx)
+	master := r.resourceBuilder.BuildDatabaseMasterStatefulSet(ms)
+	template := master.Spec.VolumeClaimTemplates[0]
+	target := types.NamespacedName{Namespace: db.Namespace, Name: fmt.Sprintf("%s-%s-0", template.Name, master.Name)}
+
+	err := r.Get(ctx, target, &corev1.PersistentVolumeClaim{})
+	if err != nil && !errors.IsNotFound(err) {
+		return false, err
+	}
+	if errors.IsNotFound(err) {
+		if db.Spec.Replicas == nil || *db.Spec.Replicas != 0 {
+			log.Info("Stopping MariaDB to move its volume", "StatefulSet", db.Name)
+			patch := client.MergeFrom(db.DeepCopy())
+			db.Spec.Replicas = new(int32)
+			return false, r.Patch(ctx, db, patch)
+		}
+		if db.Status.Replicas > 0 {
+			return false, nil
+		}
+
+		source := types.NamespacedName{Namespace: db.Namespace, Name: fmt.Sprintf("%s-%s-0", db.Spec.VolumeClaimTemplates[0].Name, db.Name)}
+		volumeName := db.Annotations[adoptedVolumeAnnotation]
+		if volumeName == "" {
+			claim := &corev1.PersistentVolumeClaim{}
+			if err := r.Get(ctx, source, claim); err != nil {
+				return false, err
+			}
+			if claim.Spec.VolumeName == "" {
+				return false, fmt.Errorf("PersistentVolumeClaim %s is not bound", source.Name)
+			}
+			patch := client.MergeFrom(db.DeepCopy())
+			metav1.SetMetaDataAnnotation(&db.ObjectMeta, adoptedVolumeAnnotation, claim.Spec.VolumeName)
+			return false, r.Patch(ctx, db, patch)
+		}
+
+		pv := &corev1.PersistentVolume{}
+		if err := r.Get(ctx, types.NamespacedName{Name: volumeName}, pv); err != nil {
+			return false, err
+		}
+		if pv.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+			patch := client.MergeFrom(pv.DeepCopy())
+			pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+			return false, r.Patch(ctx, pv, patch)
+		}
+
+		// The old claim must be gone before the volume can be bound again
+		claim := &corev1.PersistentVolumeClaim{}
+		if err := r.Get(ctx, source, claim); err == nil {
+			log.Info("Releasing MariaDB volume", "PersistentVolumeClaim", source.Name, "PersistentVolume", volumeName)
+			return false, client.IgnoreNotFound(r.Delete(ctx, claim))
+		} else if !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		// Reserve the volume for the new claim so no other claim binds it in between
+		patch := client.MergeFrom(pv.DeepCopy())
+		pv.Spec.ClaimRef = &corev1.ObjectReference{Kind: "PersistentVolumeClaim", APIVersion: "v1", Namespace: target.Namespace, Name: target.Name}
+		if err := r.Patch(ctx, pv, patch); err != nil {
+			return false, err
+		}
+
+		claim = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        target.Name,
+				Namespace:   target.Namespace,
+				Labels:      template.Labels,
+				Annotations: template.Annotations,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      pv.Spec.AccessModes,
+				StorageClassName: &pv.Spec.StorageClassName,
+				VolumeMode:       pv.Spec.VolumeMode,
+				VolumeName:       volumeName,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: pv.Spec.Capacity[corev1.ResourceStorage]},
+				},
+			},
+		}
+		log.Info("Binding MariaDB volume to the db-master claim", "PersistentVolumeClaim", target.Name, "PersistentVolume", volumeName)
+		if err := r.Create(ctx, claim); err != nil {
+			return false, err
+		}
+	}
+
+	// Keep the old database host names working by routing them to the new master
+	masterLabels := master.Spec.Template.Labels
+	for _, name := range []string{db.Name, db.Spec.ServiceName} {
+		if name == "" {
+			continue
+		}
+		svc := &corev1.Service{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: db.Namespace, Name: name}, svc); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		patch := client.MergeFrom(svc.DeepCopy())
+		svc.Spec.Selector = masterLabels
+		if err := r.Patch(ctx, svc, patch); err != nil {
+			return false, err
+		}
+	}
+
+	log.Info("Deleting adopted MariaDB StatefulSet", "StatefulSet", db.Name)
+	return true, client.IgnoreNotFound(r.Delete(ctx, db))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StatefulSetAdoptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("adoption-controller")
+	r.resourceBuilder = builder.NewResourceBuilder(r.Scheme)
+
+	annotated := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetAnnotations()[builder.AdoptAnnotation]
+		return ok
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("statefulset-adoption").
+		For(&appsv1.StatefulSet{}, ctrlbuilder.WithPredicates(annotated)).
+		Complete(r)
+}
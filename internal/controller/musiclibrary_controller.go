@@ -0,0 +1,286 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+const (
+	musicLibraryFinalizerName = "music.mixcorp.org/library-finalizer"
+)
+
+// MusicLibraryReconciler reconciles a MusicLibrary object
+type MusicLibraryReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	resourceBuilder *builder.ResourceBuilder
+}
+
+// +kubebuilder:rbac:groups=music.mixcorp.org,resources=musiclibraries,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=music.mixcorp.org,resources=musiclibraries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=music.mixcorp.org,resources=musiclibraries/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=persistentvolumes,verbs=get;list;watch;create;delete
+
+// Reconcile provisions the shared library volume and keeps it alive while MusicServices mount it
+func (r *MusicLibraryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	library := &musicv1.MusicLibrary{}
+	if err := r.Get(ctx, req.NamespacedName, library); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "failed to get MusicLibrary")
+		return ctrl.Result{}, err
+	}
+
+	consumers, err := r.findConsumers(ctx, library)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Handle deletion: the volume is kept until no MusicService mounts it anymore
+	if library.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(library, musicLibraryFinalizerName) {
+			return ctrl.Result{}, nil
+		}
+		if len(consumers) > 0 {
+			r.Recorder.Eventf(library, corev1.EventTypeWarning, "InUse", "MusicLibrary is still mounted by %v", consumers)
+			library.Status.Phase = "Terminating"
+			library.Status.Consumers = consumers
+			if err := r.Status().Update(ctx, library); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+
+		if library.Spec.Bucket != nil {
+			pv := &corev1.PersistentVolume{}
+			pvName := types.NamespacedName{Name: builder.LibraryPersistentVolumeName(library)}
+			if err := r.Get(ctx, pvName, pv); err == nil {
+				log.Info("Deleting library PersistentVolume", "PersistentVolume", pvName.Name)
+				if err := r.Delete(ctx, pv); client.IgnoreNotFound(err) != nil {
+					return ctrl.Result{}, err
+				}
+			} else if !errors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+		}
+
+		controllerutil.RemoveFinalizer(library, musicLibraryFinalizerName)
+		return ctrl.Result{}, r.Update(ctx, library)
+	}
+
+	if !controllerutil.ContainsFinalizer(library, musicLibraryFinalizerName) {
+		controllerutil.AddFinalizer(library, musicLibraryFinalizerName)
+		if err := r.Update(ctx, library); err != nil {
+			log.Error(err, "failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := validateLibrarySize(library); err != nil {
+		return ctrl.Result{}, r.updateError(ctx, library, "InvalidSpec", err)
+	}
+
+	if library.Spec.Bucket != nil {
+		if err := r.reconcilePersistentVolume(ctx, library); err != nil {
+			return ctrl.Result{}, r.updateError(ctx, library, "PersistentVolumeFailed", err)
+		}
+	}
+
+	pvc, err := r.reconcilePVC(ctx, library)
+	if err != nil {
+		return ctrl.Result{}, r.updateError(ctx, library, "PVCFailed", err)
+	}
+
+	library.Status.ClaimName = pvc.Name
+	library.Status.Consumers = consumers
+	if pvc.Status.Phase == corev1.ClaimBound {
+		library.Status.Phase = "Bound"
+		meta.SetStatusCondition(&library.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: library.Generation,
+			Reason:             "VolumeBound",
+			Message:            "Library volume is bound",
+		})
+	} else {
+		library.Status.Phase = "Pending"
+		meta.SetStatusCondition(&library.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: library.Generation,
+			Reason:             "VolumePending",
+			Message:            "Waiting for the library PVC to be bound",
+		})
+	}
+
+	if err := r.Status().Update(ctx, library); err != nil {
+		log.Error(err, "failed to update MusicLibrary status")
+		return ctrl.Result{}, err
+	}
+
+	if library.Status.Phase != "Bound" {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *MusicLibraryReconciler) reconcilePersistentVolume(ctx context.Context, library *musicv1.MusicLibrary) error {
+	desired := r.resourceBuilder.BuildLibraryPersistentVolume(library)
+	pv := &corev1.PersistentVolume{}
+	err := r.Get(ctx, client.ObjectKeyFromObject(desired), pv)
+	if errors.IsNotFound(err) {
+		log.FromContext(ctx).Info("Creating library PersistentVolume", "PersistentVolume", desired.Name)
+		return r.Create(ctx, desired)
+	}
+	// PersistentVolume source is immutable once created
+	return err
+}
+
+func (r *MusicLibraryReconciler) reconcilePVC(ctx context.Context, library *musicv1.MusicLibrary) (*corev1.PersistentVolumeClaim, error) {
+	desired := r.resourceBuilder.BuildLibraryPVC(library)
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, client.ObjectKeyFromObject(desired), pvc)
+	if errors.IsNotFound(err) {
+		log.FromContext(ctx).Info("Creating library PVC", "PVC", desired.Name)
+		return desired, r.Create(ctx, desired)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Only expansion is supported, mirroring the data PVC behaviour
+	if library.Spec.PVC != nil {
+		desiredSize := desired.Spec.Resources.Requests[corev1.ResourceStorage]
+		currentSize, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if ok && currentSize.Cmp(desiredSize) < 0 {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desiredSize
+			return pvc, r.Update(ctx, pvc)
+		}
+	}
+
+	return pvc, nil
+}
+
+// findConsumers returns the MusicServices whose workloads mount this library
+func (r *MusicLibraryReconciler) findConsumers(ctx context.Context, library *musicv1.MusicLibrary) ([]string, error) {
+	list := &musicv1.MusicServiceList{}
+	if err := r.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	consumers := []string{}
+	for i := range list.Items {
+		ms := &list.Items[i]
+		if builder.WorkloadNamespace(ms) != library.Namespace {
+			continue
+		}
+		for _, mount := range ms.Spec.Libraries {
+			if mount.Name == library.Name {
+				consumers = append(consumers, ms.Namespace+"/"+ms.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(consumers)
+	return consumers, nil
+}
+
+// validateLibrarySize rejects an unparsable size before it reaches the PV or PVC spec
+func validateLibrarySize(library *musicv1.MusicLibrary) error {
+	var path, value string
+	switch {
+	case library.Spec.PVC != nil:
+		path, value = "spec.pvc.size", library.Spec.PVC.Size
+	case library.Spec.Bucket != nil && library.Spec.Bucket.Capacity != "":
+		path, value = "spec.bucket.capacity", library.Spec.Bucket.Capacity
+	default:
+		return nil
+	}
+	if _, err := resource.ParseQuantity(value); err != nil {
+		return fmt.Errorf("%s: invalid quantity %q: %w", path, value, err)
+	}
+	return nil
+}
+
+func (r *MusicLibraryReconciler) updateError(ctx context.Context, library *musicv1.MusicLibrary, reason string, err error) error {
+	library.Status.Phase = "Failed"
+	meta.SetStatusCondition(&library.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: library.Generation,
+		Reason:             reason,
+		Message:            err.Error(),
+	})
+	if updateErr := r.Status().Update(ctx, library); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MusicLibraryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("musiclibrary-controller")
+	r.resourceBuilder = builder.NewResourceBuilder(r.Scheme)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&musicv1.MusicLibrary{}).
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Watches(&musicv1.MusicService{}, handler.EnqueueRequestsFromMapFunc(libraryRequests)).
+		Complete(r)
+}
+
+// libraryRequests re-evaluates the libraries a MusicService mounts so consumers stay current
+func libraryRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	ms, ok := obj.(*musicv1.MusicService)
+	if !ok {
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(ms.Spec.Libraries))
+	for _, mount := range ms.Spec.Libraries {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: builder.WorkloadNamespace(ms), Name: mount.Name},
+		})
+	}
+	return requests
+}
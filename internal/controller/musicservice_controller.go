@@ -21,21 +21,30 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
-	"github.com/example/managedapp-operator/internal/builder"
+	"github.com/example/managedapp-operator/internal/appmetrics"
+	"github.com/example/managedapp-operator/internal/metrics"
+	"github.com/example/managedapp-operator/internal/notify"
 	"github.com/example/managedapp-operator/internal/reconciler"
 	"github.com/example/managedapp-operator/internal/status"
 	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/internal/volumestats"
+	"github.com/example/managedapp-operator/pkg/builder"
 )
 
 const (
@@ -48,12 +57,44 @@ type MusicServiceReconciler struct {
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
 
+	// TenantBudget giới hạn tổng footprint của các MusicService trong một namespace (rỗng = không giới hạn)
+	TenantBudget corev1.ResourceList
+
+	// ManagementNamespace là namespace duy nhất được phép tạo MusicService với tenancy Dedicated (rỗng = tắt)
+	ManagementNamespace string
+
+	// DashboardLabelKey/DashboardLabelValue là nhãn để sidecar Grafana nạp ConfigMap dashboard (key rỗng = không sinh dashboard)
+	DashboardLabelKey   string
+	DashboardLabelValue string
+
+	// DriftResyncInterval là chu kỳ dựng lại và so sánh toàn bộ tài nguyên con để bắt drift
+	// khi spec không đổi và không có sự kiện từ tài nguyên con (0 = 30 giây)
+	DriftResyncInterval time.Duration
+
+	// DefaultResources là tài nguyên của container ứng dụng và read pool khi MusicService không khai báo resources
+	DefaultResources corev1.ResourceRequirements
+
+	// Notifier gửi webhook khi MusicService chuyển sang Failed, Job sao lưu thất bại hoặc master mất sẵn sàng (nil = tắt)
+	Notifier *notify.Notifier
+
 	// Dependencies are injected by the manager
-	resourceBuilder    *builder.ResourceBuilder
-	statusManager      *status.Manager
-	appReconciler      *reconciler.AppReconciler
-	databaseReconciler *reconciler.DatabaseReconciler
-	messageFormatter   *tone.Formatter
+	resourceBuilder            *builder.ResourceBuilder
+	statusManager              *status.Manager
+	appReconciler              *reconciler.AppReconciler
+	databaseReconciler         *reconciler.DatabaseReconciler
+	storageMigrationReconciler *reconciler.StorageMigrationReconciler
+	backupReconciler           *reconciler.BackupReconciler
+	footprintReconciler        *reconciler.FootprintReconciler
+	storageUsageReconciler     *reconciler.StorageUsageReconciler
+	connectionsReconciler      *reconciler.ConnectionsReconciler
+	componentsReconciler       *reconciler.ComponentsReconciler
+	tenancyReconciler          *reconciler.TenancyReconciler
+	seedReconciler             *reconciler.SeedReconciler
+	dashboardReconciler        *reconciler.DashboardReconciler
+	monitoringReconciler       *reconciler.MonitoringReconciler
+	appliedReconciler          *reconciler.AppliedSpecReconciler
+	messageFormatter           *tone.Formatter
+	childEvents                *childEventTracker
 }
 
 // +kubebuilder:rbac:groups=music.mixcorp.org,resources=musicservices,verbs=get;list;watch;create;update;patch;delete
@@ -61,12 +102,26 @@ type MusicServiceReconciler struct {
 // +kubebuilder:rbac:groups=music.mixcorp.org,resources=musicservices/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=statefulsets/status,verbs=get
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=nodes/proxy,verbs=get
+// +kubebuilder:rbac:groups=core,resources=pods/proxy,verbs=get
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=resourcequotas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile implements the reconciliation loop for MusicService
 func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -77,21 +132,53 @@ func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	if err := r.Get(ctx, req.NamespacedName, musicService); err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("MusicService resource not found, ignoring since object must be deleted")
+			metrics.Forget(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "failed to get MusicService")
 		return ctrl.Result{}, err
 	}
+	log = r.messageFormatter.Logger(ctx, musicService, "controller")
+
+	// Export the phase and replica counts reached by this reconcile
+	defer metrics.Record(musicService)
+
+	// Delete ephemeral instances once spec.ttlSecondsAfterCreation or spec.ttlSecondsAfterLastUse expires
+	var ttlRemaining time.Duration
+	if musicService.DeletionTimestamp == nil {
+		expired, remaining, err := r.reconcileTTL(ctx, musicService)
+		if err != nil {
+			log.Error(err, "failed to delete expired MusicService")
+			return ctrl.Result{}, err
+		}
+		if expired {
+			log.Info(r.messageFormatter.Format(musicService, "TTL expired, MusicService deleted"))
+			return ctrl.Result{}, nil
+		}
+		ttlRemaining = remaining
+	}
 
-	log.Info(r.messageFormatter.Format(musicService, "Reconciling MusicService"), "MusicService", musicService.Name)
+	// Nothing changed since the last full reconcile; wait for the next drift resync
+	if remaining, ok := r.canSkipReconcile(musicService); ok {
+		log.V(1).Info(r.messageFormatter.Format(musicService, "Generation already observed, skipping until drift resync"), "resyncIn", remaining)
+		return ctrl.Result{RequeueAfter: requeueBefore(remaining, ttlRemaining)}, nil
+	}
+
+	log.Info(r.messageFormatter.Format(musicService, "Reconciling MusicService"))
 	r.Recorder.Event(musicService, corev1.EventTypeNormal, "Reconciling", r.messageFormatter.Format(musicService, "Starting reconciliation"))
 
 	// Handle deletion with finalizer
 	if musicService.ObjectMeta.DeletionTimestamp != nil {
 		if controllerutil.ContainsFinalizer(musicService, musicServiceFinalizerName) {
-			log.Info(r.messageFormatter.Format(musicService, "Deleting associated resources"), "MusicService", musicService.Name)
+			log.Info(r.messageFormatter.Format(musicService, "Deleting associated resources"))
 			r.Recorder.Event(musicService, corev1.EventTypeNormal, "Deleting", r.messageFormatter.Format(musicService, "Cleaning up resources"))
 
+			// Tenant namespaces are cluster-scoped and cannot be garbage collected via owner references
+			if err := r.tenancyReconciler.Cleanup(ctx, musicService); err != nil {
+				log.Error(err, "failed to delete tenant namespace")
+				return ctrl.Result{}, err
+			}
+
 			controllerutil.RemoveFinalizer(musicService, musicServiceFinalizerName)
 			if err := r.Update(ctx, musicService); err != nil {
 				log.Error(err, "failed to remove finalizer")
@@ -110,31 +197,156 @@ func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	// Reject unparsable sizes here as well, since the webhook may be disabled
+	if errs := musicService.ValidateQuantities(); len(errs) > 0 {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "InvalidSpec", errs.ToAggregate().Error())
+	}
+
 	// Initialize status
 	musicService.Status.ObservedGeneration = musicService.Generation
 	musicService.Status.DesiredReplicas = musicService.Spec.Replicas
 
+	// Compute the resource footprint and enforce the tenant budget before touching any resources
+	if err := r.footprintReconciler.Reconcile(ctx, musicService); err != nil {
+		if reconciler.IsBudgetExceeded(err) {
+			r.Recorder.Event(musicService, corev1.EventTypeWarning, "BudgetExceeded", r.messageFormatter.Format(musicService, err.Error()))
+			return ctrl.Result{RequeueAfter: time.Minute}, r.statusManager.UpdateError(ctx, musicService, "BudgetExceeded", err.Error())
+		}
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "FootprintFailed", err.Error())
+	}
+
+	// Provision the tenant namespace before creating child resources in it
+	if err := r.tenancyReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "TenancyFailed", err.Error())
+	}
+
+	// Pods reference the registry Secret as imagePullSecrets, so it must exist before any workload
+	if err := r.appReconciler.ReconcileRegistrySecret(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "RegistryCredentialsFailed", err.Error())
+	}
+
 	// Reconcile application service
 	if err := r.appReconciler.ReconcileService(ctx, musicService); err != nil {
 		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ServiceFailed", err.Error())
 	}
 
-	// Reconcile application StatefulSet
-	if err := r.appReconciler.ReconcileStatefulSet(ctx, musicService); err != nil {
+	// Verify referenced music libraries before mounting them
+	if err := r.appReconciler.ReconcileLibraries(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "LibraryFailed", err.Error())
+	}
+
+	// The shared music-data PVC must exist before data is copied into it or pods mount it
+	if err := r.appReconciler.ReconcileSharedStorage(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "SharedStorageFailed", err.Error())
+	}
+
+	// The queue connection Secret must exist before app pods reference it through QUEUE_* env
+	if err := r.appReconciler.ReconcileQueue(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "QueueFailed", err.Error())
+	}
+
+	// Same for the search connection Secret and SEARCH_* env
+	if err := r.appReconciler.ReconcileSearch(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "SearchFailed", err.Error())
+	}
+
+	// And for the session store connection Secret and SESSION_STORE_* env
+	if err := r.appReconciler.ReconcileSessionStore(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "SessionStoreFailed", err.Error())
+	}
+
+	// MinIO credentials are read by app env, seed Jobs and the backup upload container
+	if err := r.appReconciler.ReconcileObjectStorage(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ObjectStorageFailed", err.Error())
+	}
+
+	// Shrink music-data through backup and restore, or copy it when spec.storage.accessMode changes;
+	// the app StatefulSet stays down until it completes
+	migrating, err := r.storageMigrationReconciler.Reconcile(ctx, musicService)
+	if err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "StorageMigrationFailed", err.Error())
+	}
+	if migrating {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, r.statusManager.UpdateStorageMigration(ctx, musicService)
+	}
+
+	// Drain connections from pods about to be removed; the StatefulSet keeps its replicas until they are drained
+	// while every other step still runs, and the reconcile is requeued to check the drain again
+	draining, err := r.appReconciler.ReconcileDrain(ctx, musicService)
+	if err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DrainFailed", err.Error())
+	}
+
+	// Reconcile the application StatefulSet, Deployment or shard StatefulSets; workloads of the other modes are removed
+	if err := r.appReconciler.ReconcileStatefulSet(ctx, musicService, draining); err != nil {
 		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "StatefulSetFailed", err.Error())
 	}
+	if err := r.appReconciler.ReconcileDeployment(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DeploymentFailed", err.Error())
+	}
+	if err := r.appReconciler.ReconcileShards(ctx, musicService, draining); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ShardsFailed", err.Error())
+	}
+	if err := r.appReconciler.ReconcilePartitioning(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "PartitioningFailed", err.Error())
+	}
+
+	// Load starter content into the music-data volumes
+	if err := r.seedReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "SeedFailed", err.Error())
+	}
 
 	// Reconcile autoscaler if configured
 	if err := r.appReconciler.ReconcileAutoscaler(ctx, musicService); err != nil {
 		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "AutoscalerFailed", err.Error())
 	}
 
+	// Reconcile the read-only catalog pool (removed when disabled)
+	if err := r.appReconciler.ReconcileReadPool(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ReadPoolFailed", err.Error())
+	}
+
+	// Signal running pods to reload changed reloadable config keys without a restart
+	if err := r.appReconciler.ReconcileConfigReload(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ConfigReloadFailed", err.Error())
+	}
+
+	// Reconcile the Ingress exposing the app Service (removed when unset)
+	if err := r.appReconciler.ReconcileIngress(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "IngressFailed", err.Error())
+	}
+
+	// Reconcile the per-instance Grafana dashboard (removed when disabled)
+	if err := r.dashboardReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DashboardFailed", err.Error())
+	}
+
+	// Reconcile the PodMonitor for the app metrics (removed when disabled)
+	if err := r.monitoringReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "MonitoringFailed", err.Error())
+	}
+
 	// Reconcile database if enabled
+	credentialsRotating := false
 	if databaseEnabled(musicService) {
 		if musicService.Status.Database == nil {
 			musicService.Status.Database = &musicv1.DatabaseStatus{}
 		}
 
+		// The connection Secret must exist before the database pods reference it
+		if err := r.databaseReconciler.ReconcileConnectionSecret(ctx, musicService); err != nil {
+			return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBConnectionSecretFailed", err.Error())
+		}
+
+		initStage, err := r.backupReconciler.ReconcileInitRestore(ctx, musicService)
+		if err != nil {
+			return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBInitRestoreFailed", err.Error())
+		}
+		if initStage == reconciler.InitRestoreBlocksPrimary {
+			return ctrl.Result{RequeueAfter: 10 * time.Second},
+				r.statusManager.UpdateDatabaseInitializing(ctx, musicService, "Restoring physical backup before starting the database")
+		}
+
 		if databaseHAEnabled(musicService) {
 			// Chế độ Galera Cluster: tất cả node ngang hàng, không gián đoạn khi master chết
 			if err := r.databaseReconciler.ReconcileGalera(ctx, musicService); err != nil {
@@ -149,10 +361,33 @@ func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 				return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBMasterFailed", err.Error())
 			}
 
+			if initStage == reconciler.InitRestoreBlocksReplicas {
+				if err := r.databaseReconciler.ReconcileServices(ctx, musicService); err != nil {
+					return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBServicesFailed", err.Error())
+				}
+				return ctrl.Result{RequeueAfter: 10 * time.Second},
+					r.statusManager.UpdateDatabaseInitializing(ctx, musicService, "Restoring logical backup before setting up replication")
+			}
+
+			// Remove surplus replicas one at a time after stopping their replication
+			removing, err := r.databaseReconciler.DecommissionReplicas(ctx, musicService)
+			if err != nil {
+				return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBReplicaDecommissionFailed", err.Error())
+			}
+			if removing {
+				return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+			}
+
 			if err := r.databaseReconciler.ReconcileReplicas(ctx, musicService); err != nil {
 				return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBReplicasFailed", err.Error())
 			}
 
+			// Rotate the replication user (grant, rolling restart of replicas, retire) when requested
+			credentialsRotating, err = r.databaseReconciler.ReconcileCredentialRotation(ctx, musicService)
+			if err != nil {
+				return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBCredentialRotationFailed", err.Error())
+			}
+
 			if err := r.databaseReconciler.ReconcileServices(ctx, musicService); err != nil {
 				return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBServicesFailed", err.Error())
 			}
@@ -163,15 +398,47 @@ func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
-	// Sync status from StatefulSet
-	appSts := &appsv1.StatefulSet{}
-	appStsName := types.NamespacedName{Name: musicService.Name, Namespace: musicService.Namespace}
-	if err := r.Get(ctx, appStsName, appSts); err == nil {
-		if err := r.statusManager.UpdateFromAppStatefulSet(ctx, musicService, appSts); err != nil {
-			log.Error(err, "failed to update app statefulset status")
+	// Reconcile database backup (removes the CronJob when backup is disabled)
+	if err := r.backupReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "DBBackupFailed", err.Error())
+	}
+
+	// Record PVC usage and expand PVCs whose usage crossed spec.*.storage.autoGrow.thresholdPercent
+	if err := r.storageUsageReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "StorageUsageFailed", err.Error())
+	}
+
+	// Record open streaming connections from the app pods' metrics endpoint
+	if err := r.connectionsReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ConnectionMetricsFailed", err.Error())
+	}
+
+	// Sync status from the app StatefulSet, Deployment or shard StatefulSets
+	appName := types.NamespacedName{Name: musicService.Name, Namespace: builder.WorkloadNamespace(musicService)}
+	if builder.ShardsEnabled(musicService) {
+		if err := r.statusManager.UpdateFromAppShards(ctx, musicService); err != nil {
+			log.Error(err, "failed to update app shard status")
 			return ctrl.Result{}, err
 		}
 		r.Recorder.Event(musicService, corev1.EventTypeNormal, "Ready", r.messageFormatter.Format(musicService, "Service is ready"))
+	} else if builder.AppUsesDeployment(musicService) {
+		appDeployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, appName, appDeployment); err == nil {
+			if err := r.statusManager.UpdateFromAppDeployment(ctx, musicService, appDeployment); err != nil {
+				log.Error(err, "failed to update app deployment status")
+				return ctrl.Result{}, err
+			}
+			r.Recorder.Event(musicService, corev1.EventTypeNormal, "Ready", r.messageFormatter.Format(musicService, "Service is ready"))
+		}
+	} else {
+		appSts := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, appName, appSts); err == nil {
+			if err := r.statusManager.UpdateFromAppStatefulSet(ctx, musicService, appSts); err != nil {
+				log.Error(err, "failed to update app statefulset status")
+				return ctrl.Result{}, err
+			}
+			r.Recorder.Event(musicService, corev1.EventTypeNormal, "Ready", r.messageFormatter.Format(musicService, "Service is ready"))
+		}
 	}
 
 	// Update database status if enabled
@@ -182,6 +449,24 @@ func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	// Report PVC expansion progress; keep polling while a resize is still running
+	storageResizing, err := r.statusManager.UpdateStorageResizing(ctx, musicService)
+	if err != nil {
+		log.Error(err, "failed to check storage resize progress")
+		return ctrl.Result{}, err
+	}
+	r.statusManager.UpdateStorageUsage(musicService)
+
+	// Summarize every child resource in status.components
+	if err := r.componentsReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "ComponentsFailed", err.Error())
+	}
+
+	// Record the applied spec on every child now that all steps succeeded
+	if err := r.appliedReconciler.Reconcile(ctx, musicService); err != nil {
+		return ctrl.Result{}, r.statusManager.UpdateError(ctx, musicService, "AppliedSpecFailed", err.Error())
+	}
+
 	// Mark reconciliation as complete
 	if err := r.statusManager.UpdateReconciled(ctx, musicService); err != nil {
 		log.Error(err, "failed to update MusicService status")
@@ -192,8 +477,11 @@ func (r *MusicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	if musicService.Status.ReadyReplicas < musicService.Spec.Replicas {
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
+	if storageResizing || credentialsRotating || draining {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
 
-	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	return ctrl.Result{RequeueAfter: requeueBefore(r.driftResyncInterval(), ttlRemaining)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -203,18 +491,83 @@ func (r *MusicServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	// Initialize dependencies
 	r.resourceBuilder = builder.NewResourceBuilder(r.Scheme)
-	r.statusManager = status.NewManager(r.Client)
+	r.resourceBuilder.SetDefaultResources(r.DefaultResources)
+	scraper, err := appmetrics.NewScraper(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	r.statusManager = status.NewManager(r.Client, r.Notifier, r.Recorder, scraper)
 	r.messageFormatter = tone.NewFormatter()
+	r.childEvents = newChildEventTracker()
 	r.appReconciler = reconciler.NewAppReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
-	r.databaseReconciler = reconciler.NewDatabaseReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
+	r.databaseReconciler = reconciler.NewDatabaseReconciler(r.Client, r.resourceBuilder, r.messageFormatter, r.Recorder)
+	r.storageMigrationReconciler = reconciler.NewStorageMigrationReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
+	r.backupReconciler = reconciler.NewBackupReconciler(r.Client, r.resourceBuilder, r.messageFormatter, r.Notifier)
+	r.footprintReconciler = reconciler.NewFootprintReconciler(r.Client, r.resourceBuilder, r.messageFormatter, r.TenantBudget)
+	r.seedReconciler = reconciler.NewSeedReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
+	r.tenancyReconciler = reconciler.NewTenancyReconciler(r.Client, r.resourceBuilder, r.messageFormatter, r.ManagementNamespace)
+	r.appliedReconciler = reconciler.NewAppliedSpecReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
+	r.componentsReconciler = reconciler.NewComponentsReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
+	r.monitoringReconciler = reconciler.NewMonitoringReconciler(r.Client, r.resourceBuilder, r.messageFormatter)
+	r.dashboardReconciler = reconciler.NewDashboardReconciler(r.Client, r.resourceBuilder, r.messageFormatter, r.DashboardLabelKey, r.DashboardLabelValue)
+
+	collector, err := volumestats.NewCollector(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	r.storageUsageReconciler = reconciler.NewStorageUsageReconciler(r.Client, r.messageFormatter, collector, r.Recorder)
+
+	r.connectionsReconciler = reconciler.NewConnectionsReconciler(r.Client, r.messageFormatter, scraper)
+
+	// Every child event marks its owner so the next reconcile does a full rebuild
+	childEvents := ctrlbuilder.WithPredicates(r.childEvents.predicate())
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&musicv1.MusicService{}).
-		Owns(&appsv1.StatefulSet{}).
-		Owns(&corev1.Service{}).
+		Owns(&appsv1.StatefulSet{}, childEvents).
+		Owns(&appsv1.Deployment{}, childEvents).
+		Owns(&corev1.Service{}, childEvents).
+		Owns(&networkingv1.Ingress{}, childEvents).
+		Owns(&batchv1.CronJob{}, childEvents).
+		Owns(&batchv1.Job{}, childEvents).
+		Watches(&appsv1.StatefulSet{}, handler.EnqueueRequestsFromMapFunc(tenantOwnerRequests), childEvents).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.configMapRequests)).
 		Complete(r)
 }
 
+// configMapRequests maps an app config ConfigMap to the MusicServices mounting it. The ConfigMap is
+// user-owned, so the MusicServices are marked here to keep canSkipReconcile from ignoring the change
+func (r *MusicServiceReconciler) configMapRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &musicv1.MusicServiceList{}
+	if err := r.List(ctx, list); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list MusicServices for ConfigMap", "ConfigMap", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range list.Items {
+		ms := &list.Items[i]
+		if builder.AppConfigMapName(ms) != obj.GetName() || builder.WorkloadNamespace(ms) != obj.GetNamespace() {
+			continue
+		}
+		key := client.ObjectKeyFromObject(ms)
+		r.childEvents.markKey(key)
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
+
+// tenantOwnerRequests maps child resources in a tenant namespace back to their MusicService,
+// since owner references cannot cross namespaces
+func tenantOwnerRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	namespace, name := labels[builder.TenantOwnerNamespaceLabel], labels[builder.TenantOwnerNameLabel]
+	if namespace == "" || name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
+
 func databaseEnabled(ms *musicv1.MusicService) bool {
 	return ms.Spec.Database != nil && ms.Spec.Database.Enabled
 }
@@ -17,11 +17,29 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	restfake "k8s.io/client-go/rest/fake"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/appmetrics"
+	"github.com/example/managedapp-operator/internal/reconciler"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
 )
 
 func TestMusicServiceController(t *testing.T) {
@@ -36,7 +54,7 @@ func TestMusicServiceController(t *testing.T) {
 				Replicas: 2,
 				Image:    "nginx:latest",
 				Port:     8080,
-				Storage: musicv1.StorageSpec{
+				Storage: &musicv1.StorageSpec{
 					Size: "10Gi",
 				},
 				Streaming: musicv1.StreamingSpec{
@@ -47,11 +65,11 @@ func TestMusicServiceController(t *testing.T) {
 					Enabled:  true,
 					Replicas: 2,
 					Image:    "mariadb:10.11",
-					Replication: &musicv1.ReplicationSpec{
+					Replication: &musicv1.DatabaseReplicationSpec{
 						Enabled:     boolPtr(true),
 						GTID:        boolPtr(true),
-						MinReplicas: intPtr(1),
-						MaxReplicas: intPtr(5),
+						MinReplicas: int32Ptr(1),
+						MaxReplicas: int32Ptr(5),
 					},
 				},
 			},
@@ -88,7 +106,7 @@ func TestMusicServiceController(t *testing.T) {
 				Replicas: 2,
 				Image:    "nginx:latest",
 				Port:     8080,
-				Storage: musicv1.StorageSpec{
+				Storage: &musicv1.StorageSpec{
 					Size: "10Gi",
 				},
 				Streaming: musicv1.StreamingSpec{
@@ -96,10 +114,10 @@ func TestMusicServiceController(t *testing.T) {
 					MaxConnections: 100,
 				},
 				Autoscaling: &musicv1.AutoscalingSpec{
-					Enabled:     boolPtr(true),
-					MinReplicas: intPtr(2),
-					MaxReplicas: intPtr(10),
-					TargetCPU:   intPtr(80),
+					Enabled:                        boolPtr(true),
+					MinReplicas:                    2,
+					MaxReplicas:                    10,
+					TargetCPUUtilizationPercentage: 80,
 				},
 			},
 		}
@@ -111,13 +129,13 @@ func TestMusicServiceController(t *testing.T) {
 		if !*ms.Spec.Autoscaling.Enabled {
 			t.Error("Autoscaling should be enabled")
 		}
-		if *ms.Spec.Autoscaling.MinReplicas != 2 {
+		if ms.Spec.Autoscaling.MinReplicas != 2 {
 			t.Error("MinReplicas should be 2")
 		}
-		if *ms.Spec.Autoscaling.MaxReplicas != 10 {
+		if ms.Spec.Autoscaling.MaxReplicas != 10 {
 			t.Error("MaxReplicas should be 10")
 		}
-		if *ms.Spec.Autoscaling.TargetCPU != 80 {
+		if ms.Spec.Autoscaling.TargetCPUUtilizationPercentage != 80 {
 			t.Error("TargetCPU should be 80")
 		}
 	})
@@ -132,7 +150,7 @@ func TestMusicServiceController(t *testing.T) {
 				Replicas: 3,
 				Image:    "nginx:latest",
 				Port:     8080,
-				Storage: musicv1.StorageSpec{
+				Storage: &musicv1.StorageSpec{
 					Size: "5Gi",
 				},
 				Streaming: musicv1.StreamingSpec{
@@ -172,7 +190,7 @@ func TestMusicServiceController(t *testing.T) {
 				Replicas: 1,
 				Image:    "nginx:latest",
 				Port:     8080,
-				Storage: musicv1.StorageSpec{
+				Storage: &musicv1.StorageSpec{
 					Size: "1Gi",
 				},
 				Streaming: musicv1.StreamingSpec{
@@ -237,7 +255,7 @@ func TestMusicServiceController(t *testing.T) {
 						Replicas: tt.replicas,
 						Image:    tt.image,
 						Port:     tt.port,
-						Storage: musicv1.StorageSpec{
+						Storage: &musicv1.StorageSpec{
 							Size: tt.storage,
 						},
 						Streaming: musicv1.StreamingSpec{
@@ -268,7 +286,7 @@ func TestMusicServiceController(t *testing.T) {
 				Replicas: 2,
 				Image:    "nginx:latest",
 				Port:     8080,
-				Storage: musicv1.StorageSpec{
+				Storage: &musicv1.StorageSpec{
 					Size: "10Gi",
 				},
 				Streaming: musicv1.StreamingSpec{
@@ -280,20 +298,20 @@ func TestMusicServiceController(t *testing.T) {
 					Replicas:     2,
 					Image:        "mariadb:10.11",
 					RootPassword: "secure-password",
-					Storage: musicv1.StorageSpec{
+					Storage: &musicv1.StorageSpec{
 						Size: "20Gi",
 					},
-					Replication: &musicv1.ReplicationSpec{
+					Replication: &musicv1.DatabaseReplicationSpec{
 						Enabled:     boolPtr(true),
 						GTID:        boolPtr(true),
-						MinReplicas: intPtr(1),
-						MaxReplicas: intPtr(5),
+						MinReplicas: int32Ptr(1),
+						MaxReplicas: int32Ptr(5),
 					},
 					Autoscaling: &musicv1.AutoscalingSpec{
-						Enabled:     boolPtr(true),
-						MinReplicas: intPtr(1),
-						MaxReplicas: intPtr(5),
-						TargetCPU:   intPtr(70),
+						Enabled:                        boolPtr(true),
+						MinReplicas:                    1,
+						MaxReplicas:                    5,
+						TargetCPUUtilizationPercentage: 70,
 					},
 				},
 			},
@@ -319,6 +337,445 @@ func TestMusicServiceController(t *testing.T) {
 			t.Error("Autoscaling should be enabled")
 		}
 	})
+
+	t.Run("SkipReconcileUntilDriftResync", func(t *testing.T) {
+		r := &MusicServiceReconciler{DriftResyncInterval: 5 * time.Minute, childEvents: newChildEventTracker()}
+		ms := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-resync",
+				Namespace:  "default",
+				Generation: 3,
+				UID:        "test-uid",
+			},
+			Status: musicv1.MusicServiceStatus{
+				Phase:              "Available",
+				ObservedGeneration: 3,
+				LastReconcileTime:  &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		}
+
+		remaining, ok := r.canSkipReconcile(ms)
+		if !ok {
+			t.Fatal("Reconcile should be skipped when the generation is observed and no child changed")
+		}
+		if remaining <= 0 || remaining > 4*time.Minute {
+			t.Errorf("Expected the next drift resync within 4m, got %s", remaining)
+		}
+
+		// A child event forces a single full reconcile
+		child := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-resync",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: musicv1.GroupVersion.String(),
+				Kind:       "MusicService",
+				Name:       "test-resync",
+				UID:        "test-uid",
+				Controller: boolPtr(true),
+			}},
+		}}
+		r.childEvents.mark(child)
+		if _, ok := r.canSkipReconcile(ms); ok {
+			t.Error("Reconcile should not be skipped after a child event")
+		}
+		if _, ok := r.canSkipReconcile(ms); !ok {
+			t.Error("Child event should be consumed by the full reconcile")
+		}
+
+		ms.Generation = 4
+		if _, ok := r.canSkipReconcile(ms); ok {
+			t.Error("Reconcile should not be skipped when the spec changed")
+		}
+
+		ms.Generation = 3
+		ms.Annotations = map[string]string{musicv1.RotateReplicationCredentialsAnnotation: "2026-10-01"}
+		if _, ok := r.canSkipReconcile(ms); ok {
+			t.Error("Reconcile should not be skipped when the rotate annotation has not been handled")
+		}
+		ms.Status.Database = &musicv1.DatabaseStatus{
+			ReplicationCredentials: &musicv1.ReplicationCredentialsStatus{Phase: "Rolling", LastTrigger: "2026-10-01"},
+		}
+		if _, ok := r.canSkipReconcile(ms); ok {
+			t.Error("Reconcile should not be skipped while a credential rotation is in progress")
+		}
+		ms.Status.Database.ReplicationCredentials.Phase = "Completed"
+		if _, ok := r.canSkipReconcile(ms); !ok {
+			t.Error("Reconcile should be skipped once the rotate annotation was handled")
+		}
+
+		ms.Status.LastReconcileTime = &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}
+		if _, ok := r.canSkipReconcile(ms); ok {
+			t.Error("Reconcile should not be skipped once the drift resync is due")
+		}
+	})
+
+	t.Run("TTLExpiration", func(t *testing.T) {
+		created := time.Date(2026, time.March, 1, 8, 0, 0, 0, time.UTC)
+		ttl := func(seconds int64) *int64 { return &seconds }
+		ms := &musicv1.MusicService{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}}}
+
+		if _, ok := expirationTime(ms); ok {
+			t.Error("MusicService without a TTL should never expire")
+		}
+
+		ms.Spec.TTLSecondsAfterCreation = ttl(7200)
+		if expiry, _ := expirationTime(ms); !expiry.Equal(created.Add(2 * time.Hour)) {
+			t.Errorf("Expected expiry 2h after creation, got %s", expiry)
+		}
+
+		// Idle TTL counts from the last connection and the earlier expiry wins
+		ms.Spec.TTLSecondsAfterLastUse = ttl(1800)
+		ms.Status.LastUsedTime = &metav1.Time{Time: created.Add(time.Hour)}
+		if expiry, _ := expirationTime(ms); !expiry.Equal(created.Add(90 * time.Minute)) {
+			t.Errorf("Expected expiry 30m after last use, got %s", expiry)
+		}
+		ms.Status.LastUsedTime = &metav1.Time{Time: created.Add(3 * time.Hour)}
+		if expiry, _ := expirationTime(ms); !expiry.Equal(created.Add(2 * time.Hour)) {
+			t.Errorf("Expected the creation TTL to win, got %s", expiry)
+		}
+
+		if got := requeueBefore(5*time.Minute, time.Minute); got != time.Minute {
+			t.Errorf("Expected requeue at TTL expiry, got %s", got)
+		}
+		if got := requeueBefore(5*time.Minute, 0); got != 5*time.Minute {
+			t.Errorf("Expected the drift resync without a TTL, got %s", got)
+		}
+	})
+
+	t.Run("TTLScrapesBeforeIdleExpiry", func(t *testing.T) {
+		idle := int64(60)
+		ms := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "busy",
+				Namespace:         "default",
+				CreationTimestamp: metav1.Time{Time: time.Now().Add(-time.Hour)},
+			},
+			Spec: musicv1.MusicServiceSpec{
+				Port:                   8080,
+				TTLSecondsAfterLastUse: &idle,
+				ConnectionMetrics:      &musicv1.ConnectionMetricsSpec{Port: 9090},
+			},
+			// The last full reconcile saw a listener 10 minutes ago; skipped reconciles never refreshed it
+			Status: musicv1.MusicServiceStatus{LastUsedTime: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}},
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "busy-0",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "busy", "component": "music-service"},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+		scheme := builder.DefaultScheme()
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ms.DeepCopy(), pod).Build()
+
+		var scraped string
+		metricsClient := &restfake.RESTClient{
+			NegotiatedSerializer: clientgoscheme.Codecs.WithoutConversion(),
+			GroupVersion:         corev1.SchemeGroupVersion,
+			VersionedAPIPath:     "/api/v1",
+			Client: restfake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				scraped = req.URL.Path
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("music_streaming_active_connections 3\n")),
+				}, nil
+			}),
+		}
+		formatter := tone.NewFormatter()
+		r := &MusicServiceReconciler{
+			Client:           c,
+			Recorder:         record.NewFakeRecorder(10),
+			messageFormatter: formatter,
+			connectionsReconciler: reconciler.NewConnectionsReconciler(c, formatter,
+				appmetrics.NewScraperForClient(metricsClient)),
+		}
+
+		expired, remaining, err := r.reconcileTTL(context.Background(), ms)
+		if err != nil {
+			t.Fatalf("reconcileTTL: %v", err)
+		}
+		if expired {
+			t.Fatal("MusicService with live listeners must not be deleted by the idle TTL")
+		}
+		if !strings.Contains(scraped, "busy-0:9090") {
+			t.Errorf("Expected the pod metrics to be scraped, got request %q", scraped)
+		}
+		if remaining <= 0 || remaining > time.Minute {
+			t.Errorf("Expected the idle TTL to restart from the fresh scrape, got %s", remaining)
+		}
+		if err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "busy"}, &musicv1.MusicService{}); err != nil {
+			t.Errorf("Expected the MusicService to still exist: %v", err)
+		}
+	})
+
+	t.Run("TenantChildEventOwner", func(t *testing.T) {
+		tracker := newChildEventTracker()
+		tracker.mark(&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+			Name:      "tenant-app",
+			Namespace: "music-tenant",
+			Labels: map[string]string{
+				builder.TenantOwnerNamespaceLabel: "default",
+				builder.TenantOwnerNameLabel:      "tenant-app",
+			},
+		}})
+		if !tracker.take(types.NamespacedName{Namespace: "default", Name: "tenant-app"}) {
+			t.Error("Tenant child event should mark the owning MusicService")
+		}
+	})
+
+	t.Run("ShardDrainSetsServingCondition", func(t *testing.T) {
+		ms := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{Name: "radio", Namespace: "default"},
+			Spec: musicv1.MusicServiceSpec{
+				Port:      8080,
+				Streaming: musicv1.StreamingSpec{Drain: &musicv1.DrainSpec{TimeoutSeconds: 60}},
+				Shards:    []musicv1.AppShardSpec{{Name: "a-m", Replicas: 1}, {Name: "n-z", Replicas: 2}},
+			},
+		}
+		scheme := builder.DefaultScheme()
+		rb := builder.NewResourceBuilder(scheme)
+		objects := []client.Object{}
+		for i, shard := range ms.Spec.Shards {
+			sts := rb.BuildAppShardStatefulSet(ms, i)
+			objects = append(objects, sts)
+			// Every shard currently runs two pods; a-m is being scaled down to one
+			for _, ordinal := range []string{"0", "1"} {
+				objects = append(objects, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      builder.ShardName(ms, shard.Name) + "-" + ordinal,
+						Namespace: "default",
+						Labels:    sts.Spec.Selector.MatchLabels,
+					},
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+				})
+			}
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&corev1.Pod{}).Build()
+		ar := reconciler.NewAppReconciler(c, rb, tone.NewFormatter())
+
+		draining, err := ar.ReconcileDrain(context.Background(), ms)
+		if err != nil {
+			t.Fatalf("ReconcileDrain: %v", err)
+		}
+		if !draining {
+			t.Error("Expected the pod removed from shard a-m to be draining")
+		}
+
+		want := map[string]corev1.ConditionStatus{
+			"radio-a-m-0": corev1.ConditionTrue,
+			"radio-a-m-1": corev1.ConditionFalse,
+			"radio-n-z-0": corev1.ConditionTrue,
+			"radio-n-z-1": corev1.ConditionTrue,
+		}
+		for name, status := range want {
+			pod := &corev1.Pod{}
+			if err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: name}, pod); err != nil {
+				t.Fatal(err)
+			}
+			got := corev1.ConditionUnknown
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == builder.ServingConditionType {
+					got = condition.Status
+				}
+			}
+			if got != status {
+				t.Errorf("Expected serving condition %s on %s, got %s", status, name, got)
+			}
+		}
+	})
+
+	t.Run("CrossNamespaceCloneCopiesRootPassword", func(t *testing.T) {
+		source := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "prod",
+				Namespace:   "production",
+				Annotations: map[string]string{musicv1.CloneAllowedNamespacesAnnotation: "team-qa"},
+			},
+			Spec: musicv1.MusicServiceSpec{
+				Database: &musicv1.DatabaseSpec{
+					Enabled: true,
+					Backup: &musicv1.DatabaseBackupSpec{
+						Enabled:     true,
+						Schedule:    "0 3 * * *",
+						Destination: musicv1.BackupDestinationSpec{PVC: &musicv1.BackupPVCDestination{Size: "30Gi"}},
+					},
+				},
+			},
+		}
+		ms := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: "team-qa"},
+			Spec: musicv1.MusicServiceSpec{
+				Database: &musicv1.DatabaseSpec{
+					Enabled: true,
+					RootPasswordSecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "staging-root"},
+						Key:                  "password",
+					},
+					InitFrom: &musicv1.DatabaseInitFromSpec{MusicService: "prod", Namespace: "production"},
+				},
+			},
+		}
+		rootSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "staging-root", Namespace: "team-qa"},
+			Data:       map[string][]byte{"password": []byte("s3cret")},
+		}
+		scheme := builder.DefaultScheme()
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, ms.DeepCopy(), rootSecret).Build()
+		br := reconciler.NewBackupReconciler(c, builder.NewResourceBuilder(scheme), tone.NewFormatter(), nil)
+		ctx := context.Background()
+
+		if _, err := br.ReconcileInitRestore(ctx, ms); err != nil {
+			t.Fatalf("ReconcileInitRestore: %v", err)
+		}
+		copied := &corev1.Secret{}
+		copyName := types.NamespacedName{Namespace: "production", Name: builder.CloneRootPasswordSecretName(ms)}
+		if err := c.Get(ctx, copyName, copied); err != nil {
+			t.Fatalf("Expected the root password to be copied into the clone Job namespace: %v", err)
+		}
+		if string(copied.Data["password"]) != "s3cret" {
+			t.Errorf("Expected the copied root password, got %v", copied.Data)
+		}
+
+		job := &batchv1.Job{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "production", Name: "team-qa-staging-db-clone"}, job); err != nil {
+			t.Fatal(err)
+		}
+		job.Status.Succeeded = 1
+		if err := c.Status().Update(ctx, job); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := br.ReconcileInitRestore(ctx, ms); err != nil {
+			t.Fatalf("ReconcileInitRestore after completion: %v", err)
+		}
+		if err := c.Get(ctx, copyName, &corev1.Secret{}); err == nil {
+			t.Error("Expected the copied root password to be deleted once the clone completed")
+		}
+	})
+
+	t.Run("PostgreSQLBuildsNoMySQLJobs", func(t *testing.T) {
+		// The webhook rejects these features for PostgreSQL; the reconcilers must not build MySQL Jobs either
+		ms := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pg",
+				Namespace:   "default",
+				Annotations: map[string]string{musicv1.RotateReplicationCredentialsAnnotation: "2026-10-01"},
+			},
+			Spec: musicv1.MusicServiceSpec{
+				Database: &musicv1.DatabaseSpec{
+					Enabled:  true,
+					Engine:   musicv1.DatabaseEnginePostgreSQL,
+					Replicas: 1,
+					Backup: &musicv1.DatabaseBackupSpec{
+						Enabled:     true,
+						Schedule:    "0 3 * * *",
+						Destination: musicv1.BackupDestinationSpec{PVC: &musicv1.BackupPVCDestination{Size: "10Gi"}},
+					},
+					InitFrom: &musicv1.DatabaseInitFromSpec{MusicService: "pg-prod"},
+				},
+			},
+		}
+		source := &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{Name: "pg-prod", Namespace: "default"},
+			Spec:       *ms.Spec.DeepCopy(),
+		}
+		source.Spec.Database.InitFrom = nil
+		replicas := int32(2)
+		replicaSts := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: builder.DatabaseReplicaName(ms), Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+		}
+		replicationSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pg-db-replication", Namespace: "default"},
+			Data:       map[string][]byte{"username": []byte("replicator"), "password": []byte("secret")},
+		}
+		scheme := builder.DefaultScheme()
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ms.DeepCopy(), source, replicaSts, replicationSecret).Build()
+		rb := builder.NewResourceBuilder(scheme)
+		formatter := tone.NewFormatter()
+		br := reconciler.NewBackupReconciler(c, rb, formatter, nil)
+		dr := reconciler.NewDatabaseReconciler(c, rb, formatter, record.NewFakeRecorder(10))
+		ctx := context.Background()
+
+		if err := br.Reconcile(ctx, ms); err != nil {
+			t.Fatalf("backup Reconcile: %v", err)
+		}
+		if _, err := br.ReconcileInitRestore(ctx, ms); err == nil || !strings.Contains(err.Error(), "postgresql") {
+			t.Errorf("Expected initFrom to be refused for PostgreSQL, got %v", err)
+		}
+		if _, err := dr.ReconcileCredentialRotation(ctx, ms); err != nil {
+			t.Fatalf("ReconcileCredentialRotation: %v", err)
+		}
+		if _, err := dr.DecommissionReplicas(ctx, ms); err != nil {
+			t.Fatalf("DecommissionReplicas: %v", err)
+		}
+
+		jobs := &batchv1.JobList{}
+		if err := c.List(ctx, jobs); err != nil {
+			t.Fatal(err)
+		}
+		cronJobs := &batchv1.CronJobList{}
+		if err := c.List(ctx, cronJobs); err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs.Items) != 0 || len(cronJobs.Items) != 0 {
+			t.Errorf("Expected no MySQL Jobs for PostgreSQL, got %d Jobs and %d CronJobs", len(jobs.Items), len(cronJobs.Items))
+		}
+	})
+
+	t.Run("ServiceSetMemberKeepsForeignMetadata", func(t *testing.T) {
+		scheme := builder.DefaultScheme()
+		set := &musicv1.MusicServiceSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "radio", Namespace: "saas", UID: "set-uid"},
+			Spec: musicv1.MusicServiceSetSpec{
+				Template: musicv1.MusicServiceTemplate{
+					Labels:      map[string]string{"plan": "standard", "tier": "gold"},
+					Annotations: map[string]string{"owner": "platform"},
+					Spec:        musicv1.MusicServiceSpec{Replicas: 1, Image: "music:1.0", Port: 8080},
+				},
+				Tenants: []musicv1.MusicServiceSetTenant{{Name: "acme"}},
+			},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		r := &MusicServiceSetReconciler{Client: c, Scheme: scheme, resourceBuilder: builder.NewResourceBuilder(scheme)}
+		ctx := context.Background()
+
+		member, err := r.syncMember(ctx, set, &set.Spec.Tenants[0], nil)
+		if err != nil {
+			t.Fatalf("syncMember create: %v", err)
+		}
+
+		// A user triggers a credential rotation and tags the member by hand
+		member.Annotations[musicv1.RotateReplicationCredentialsAnnotation] = "2026-10-01"
+		member.Labels["team"] = "radio"
+		if err := c.Update(ctx, member); err != nil {
+			t.Fatal(err)
+		}
+
+		set.Spec.Template.Labels = map[string]string{"plan": "premium"}
+		set.Spec.Template.Spec.Replicas = 2
+		member, err = r.syncMember(ctx, set, &set.Spec.Tenants[0], member)
+		if err != nil {
+			t.Fatalf("syncMember update: %v", err)
+		}
+
+		got := &musicv1.MusicService{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "saas", Name: "radio-acme"}, got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Spec.Replicas != 2 || got.Labels["plan"] != "premium" || got.Annotations["owner"] != "platform" {
+			t.Errorf("Expected the template changes on the member, got labels %v and replicas %d", got.Labels, got.Spec.Replicas)
+		}
+		if _, ok := got.Labels["tier"]; ok {
+			t.Errorf("Expected the label removed from the template to be removed, got %v", got.Labels)
+		}
+		if got.Annotations[musicv1.RotateReplicationCredentialsAnnotation] != "2026-10-01" || got.Labels["team"] != "radio" {
+			t.Errorf("Expected user metadata to be kept, got labels %v and annotations %v", got.Labels, got.Annotations)
+		}
+	})
 }
 
 // Helper functions
@@ -326,6 +783,6 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
-func intPtr(i int) *int {
+func int32Ptr(i int32) *int32 {
 	return &i
 }
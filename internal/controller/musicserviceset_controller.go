@@ -0,0 +1,206 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// MusicServiceSetReconciler reconciles a MusicServiceSet object
+type MusicServiceSetReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	resourceBuilder *builder.ResourceBuilder
+}
+
+// +kubebuilder:rbac:groups=music.mixcorp.org,resources=musicservicesets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=music.mixcorp.org,resources=musicservicesets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=music.mixcorp.org,resources=musicservicesets/finalizers,verbs=update
+
+// Reconcile stamps one MusicService per tenant and aggregates their phases into the set status.
+// A tenant whose overrides cannot be applied is reported in status without blocking the other tenants
+func (r *MusicServiceSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	set := &musicv1.MusicServiceSet{}
+	if err := r.Get(ctx, req.NamespacedName, set); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "failed to get MusicServiceSet")
+		return ctrl.Result{}, err
+	}
+	// Members are garbage collected through their owner reference
+	if set.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	existing := &musicv1.MusicServiceList{}
+	if err := r.List(ctx, existing, client.InNamespace(set.Namespace), client.MatchingLabels{builder.ServiceSetLabel: set.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+	current := make(map[string]*musicv1.MusicService, len(existing.Items))
+	for i := range existing.Items {
+		current[existing.Items[i].Name] = &existing.Items[i]
+	}
+
+	instances := make([]musicv1.MusicServiceSetInstance, 0, len(set.Spec.Tenants))
+	wanted := make(map[string]bool, len(set.Spec.Tenants))
+	var failed int
+	for i := range set.Spec.Tenants {
+		tenant := &set.Spec.Tenants[i]
+		name := builder.ServiceSetMemberName(set, tenant.Name)
+		wanted[name] = true
+		instance := musicv1.MusicServiceSetInstance{Tenant: tenant.Name, Name: name}
+
+		member, err := r.syncMember(ctx, set, tenant, current[name])
+		if err != nil {
+			log.Error(err, "failed to sync MusicService", "tenant", tenant.Name)
+			instance.Message = err.Error()
+			failed++
+		}
+		if member != nil {
+			instance.Phase = member.Status.Phase
+		}
+		instances = append(instances, instance)
+	}
+
+	for name, ms := range current {
+		if wanted[name] || !metav1.IsControlledBy(ms, set) {
+			continue
+		}
+		log.Info("Deleting MusicService of removed tenant", "MusicService", name)
+		if err := r.Delete(ctx, ms); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	sort.Slice(instances, func(i, j int) bool { return instances[i].Tenant < instances[j].Tenant })
+	set.Status.ObservedGeneration = set.Generation
+	set.Status.Total = int32(len(instances))
+	set.Status.Ready = 0
+	for _, instance := range instances {
+		if instance.Phase == "Available" {
+			set.Status.Ready++
+		}
+	}
+	set.Status.Instances = instances
+
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: set.Generation,
+		Reason:             "AllAvailable",
+		Message:            fmt.Sprintf("%d/%d MusicServices available", set.Status.Ready, set.Status.Total),
+	}
+	switch {
+	case failed > 0:
+		condition.Status, condition.Reason = metav1.ConditionFalse, "MemberSyncFailed"
+		condition.Message = fmt.Sprintf("%d tenants could not be synced; see status.instances", failed)
+	case set.Status.Ready < set.Status.Total:
+		condition.Status, condition.Reason = metav1.ConditionFalse, "MembersProgressing"
+	}
+	meta.SetStatusCondition(&set.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, set); err != nil {
+		log.Error(err, "failed to update MusicServiceSet status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// syncMember creates or updates the MusicService of one tenant. The member is only rewritten when the hash
+// of its desired spec changes, so fields defaulted by the API server do not cause an update on every pass
+func (r *MusicServiceSetReconciler) syncMember(ctx context.Context, set *musicv1.MusicServiceSet,
+	tenant *musicv1.MusicServiceSetTenant, current *musicv1.MusicService) (*musicv1.MusicService, error) {
+	desired, err := r.resourceBuilder.BuildServiceSetMember(set, tenant)
+	if err != nil {
+		return current, err
+	}
+
+	if current == nil {
+		// The member may exist without the set label, e.g. a MusicService created by hand with the same name
+		current = &musicv1.MusicService{}
+		err := r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, current)
+		if errors.IsNotFound(err) {
+			if err := controllerutil.SetControllerReference(set, desired, r.Scheme); err != nil {
+				return nil, err
+			}
+			log.FromContext(ctx).Info("Creating MusicService for tenant", "MusicService", desired.Name, "tenant", tenant.Name)
+			return desired, r.Create(ctx, desired)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	if !metav1.IsControlledBy(current, set) {
+		return current, fmt.Errorf("MusicService %s already exists and is not managed by this set", current.Name)
+	}
+	if current.Annotations[builder.ServiceSetHashAnnotation] == desired.Annotations[builder.ServiceSetHashAnnotation] {
+		return current, nil
+	}
+
+	log.FromContext(ctx).Info("Updating MusicService for tenant", "MusicService", desired.Name, "tenant", tenant.Name)
+	ownedLabels, ownedAnnotations := builder.ServiceSetOwnedKeys(current)
+	current.Labels = syncOwnedKeys(current.Labels, desired.Labels, ownedLabels)
+	current.Annotations = syncOwnedKeys(current.Annotations, desired.Annotations, ownedAnnotations)
+	current.Spec = desired.Spec
+	return current, r.Update(ctx, current)
+}
+
+// syncOwnedKeys writes the desired keys over current and removes the keys the set wrote before but no longer
+// wants; keys added by users or other controllers, such as the rotate-replication-credentials trigger, are kept
+func syncOwnedKeys(current, desired map[string]string, owned []string) map[string]string {
+	if current == nil {
+		current = map[string]string{}
+	}
+	for _, key := range owned {
+		if _, wanted := desired[key]; !wanted {
+			delete(current, key)
+		}
+	}
+	for key, value := range desired {
+		current[key] = value
+	}
+	return current
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MusicServiceSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.resourceBuilder = builder.NewResourceBuilder(r.Scheme)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&musicv1.MusicServiceSet{}).
+		Owns(&musicv1.MusicService{}).
+		Complete(r)
+}
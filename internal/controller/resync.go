@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// DefaultDriftResyncInterval is the --drift-resync-interval default and is used when DriftResyncInterval is not set
+const DefaultDriftResyncInterval = 5 * time.Minute
+
+// childEventTracker remembers which MusicServices had a child resource event since their last full reconcile
+type childEventTracker struct {
+	mu    sync.Mutex
+	dirty map[types.NamespacedName]struct{}
+}
+
+func newChildEventTracker() *childEventTracker {
+	return &childEventTracker{dirty: map[types.NamespacedName]struct{}{}}
+}
+
+// mark records an event for the MusicServices owning obj
+func (t *childEventTracker) mark(obj client.Object) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, key := range childOwnerKeys(obj) {
+		t.dirty[key] = struct{}{}
+	}
+}
+
+// markKey records an event for a MusicService found by a watch that does not go through owners
+func (t *childEventTracker) markKey(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.dirty[key] = struct{}{}
+}
+
+// take reports whether key had a child event and clears it
+func (t *childEventTracker) take(key types.NamespacedName) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.dirty[key]
+	delete(t.dirty, key)
+	return ok
+}
+
+// predicate marks the owner of every child event and lets the event through
+func (t *childEventTracker) predicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			t.mark(e.Object)
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			t.mark(e.ObjectNew)
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			t.mark(e.Object)
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			t.mark(e.Object)
+			return true
+		},
+	}
+}
+
+// childOwnerKeys resolves the MusicService behind a child through its controller reference,
+// or through the tenant owner labels for children in a tenant namespace
+func childOwnerKeys(obj client.Object) []types.NamespacedName {
+	if ref := metav1.GetControllerOf(obj); ref != nil && ref.Kind == "MusicService" && ref.APIVersion == musicv1.GroupVersion.String() {
+		return []types.NamespacedName{{Namespace: obj.GetNamespace(), Name: ref.Name}}
+	}
+
+	requests := tenantOwnerRequests(context.Background(), obj)
+	keys := make([]types.NamespacedName, 0, len(requests))
+	for _, req := range requests {
+		keys = append(keys, req.NamespacedName)
+	}
+	return keys
+}
+
+// canSkipReconcile reports whether the last full reconcile still stands: the spec was already
+// observed, the instance was healthy, no child changed since, no credential rotation is pending
+// and the drift resync is not due yet. It returns how long to wait until the next drift resync
+func (r *MusicServiceReconciler) canSkipReconcile(ms *musicv1.MusicService) (time.Duration, bool) {
+	if ms.DeletionTimestamp != nil || ms.Status.ObservedGeneration != ms.Generation ||
+		ms.Status.Phase != "Available" || ms.Status.LastReconcileTime == nil ||
+		meta.IsStatusConditionTrue(ms.Status.Conditions, "StorageResizing") ||
+		replicationRotationPending(ms) {
+		return 0, false
+	}
+
+	remaining := r.driftResyncInterval() - time.Since(ms.Status.LastReconcileTime.Time)
+	if remaining <= 0 {
+		return 0, false
+	}
+	if r.childEvents.take(client.ObjectKeyFromObject(ms)) {
+		return 0, false
+	}
+	return remaining, true
+}
+
+// replicationRotationPending reports a replication credential rotation that is still running, or a
+// rotate-replication-credentials annotation that has not been handled yet; annotations do not bump
+// the generation, so the trigger would otherwise wait for the next drift resync
+func replicationRotationPending(ms *musicv1.MusicService) bool {
+	var rotation musicv1.ReplicationCredentialsStatus
+	if ms.Status.Database != nil && ms.Status.Database.ReplicationCredentials != nil {
+		rotation = *ms.Status.Database.ReplicationCredentials
+	}
+	if rotation.Phase != "" && rotation.Phase != "Completed" {
+		return true
+	}
+	trigger := ms.Annotations[musicv1.RotateReplicationCredentialsAnnotation]
+	return trigger != "" && trigger != rotation.LastTrigger
+}
+
+func (r *MusicServiceReconciler) driftResyncInterval() time.Duration {
+	if r.DriftResyncInterval > 0 {
+		return r.DriftResyncInterval
+	}
+	return DefaultDriftResyncInterval
+}
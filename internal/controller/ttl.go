@@ -0,0 +1,92 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// expirationTime returns when the MusicService expires under spec.ttlSecondsAfterCreation or
+// spec.ttlSecondsAfterLastUse, whichever comes first; ok is false when neither is set
+func expirationTime(ms *musicv1.MusicService) (time.Time, bool) {
+	var expiry time.Time
+	ok := false
+	consider := func(from time.Time, ttl *int64) {
+		if ttl == nil {
+			return
+		}
+		t := from.Add(time.Duration(*ttl) * time.Second)
+		if !ok || t.Before(expiry) {
+			expiry, ok = t, true
+		}
+	}
+
+	created := ms.CreationTimestamp.Time
+	consider(created, ms.Spec.TTLSecondsAfterCreation)
+	lastUsed := created
+	if ms.Status.LastUsedTime != nil && ms.Status.LastUsedTime.After(created) {
+		lastUsed = ms.Status.LastUsedTime.Time
+	}
+	consider(lastUsed, ms.Spec.TTLSecondsAfterLastUse)
+	return expiry, ok
+}
+
+// reconcileTTL deletes the MusicService once it has expired. Otherwise it records the expiration
+// in status and returns the time left, or 0 when no TTL is set.
+// status.lastUsedTime is only refreshed by full reconciles, which canSkipReconcile may skip for a whole
+// drift resync interval, so connections are scraped again before an idle TTL is allowed to delete
+func (r *MusicServiceReconciler) reconcileTTL(ctx context.Context, ms *musicv1.MusicService) (bool, time.Duration, error) {
+	expiry, ok := expirationTime(ms)
+	if !ok {
+		ms.Status.ExpirationTime = nil
+		return false, 0, nil
+	}
+
+	remaining := time.Until(expiry)
+	if remaining <= 0 && ms.Spec.TTLSecondsAfterLastUse != nil {
+		if err := r.connectionsReconciler.Reconcile(ctx, ms); err != nil {
+			return false, 0, err
+		}
+		expiry, _ = expirationTime(ms)
+		remaining = time.Until(expiry)
+	}
+	if remaining > 0 {
+		ms.Status.ExpirationTime = &metav1.Time{Time: expiry}
+		return false, remaining, nil
+	}
+
+	r.Recorder.Event(ms, corev1.EventTypeNormal, "Expired", r.messageFormatter.Format(ms, "TTL expired, deleting MusicService"))
+	if err := r.Delete(ctx, ms); err != nil && !errors.IsNotFound(err) {
+		return false, 0, err
+	}
+	return true, 0, nil
+}
+
+// requeueBefore shortens a requeue delay so the next reconcile happens when the TTL expires
+func requeueBefore(after, ttlRemaining time.Duration) time.Duration {
+	if ttlRemaining > 0 && ttlRemaining < after {
+		return ttlRemaining
+	}
+	return after
+}
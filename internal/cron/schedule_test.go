@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{spec: "0 2 * * *"},
+		{spec: "*/15 0-6,22-23 * jan-jun MON-FRI"},
+		{spec: "30 3 1 */2 7"},
+		{spec: "@daily"},
+		{spec: "0 2 * *", wantErr: true},
+		{spec: "60 2 * * *", wantErr: true},
+		{spec: "0 2 * * 8", wantErr: true},
+		{spec: "0 2 10-5 * *", wantErr: true},
+		{spec: "*/0 * * * *", wantErr: true},
+		{spec: "0 2 * foo *", wantErr: true},
+		{spec: "CRON_TZ=UTC 0 2 * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			if _, err := Parse(tt.spec); (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	from := time.Date(2026, time.March, 14, 10, 30, 0, 0, time.UTC) // Saturday
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "0 2 * * *", want: time.Date(2026, time.March, 15, 2, 0, 0, 0, time.UTC)},
+		{spec: "30 10 * * *", want: time.Date(2026, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{spec: "*/20 * * * *", want: time.Date(2026, time.March, 14, 10, 40, 0, 0, time.UTC)},
+		{spec: "0 0 * * mon", want: time.Date(2026, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{spec: "@monthly", want: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		// Day-of-month and day-of-week both restricted: either one matches
+		{spec: "0 0 20 * 0", want: time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.spec, err)
+			}
+			if got := schedule.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
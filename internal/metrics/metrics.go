@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exports per-MusicService gauges on the controller-runtime metrics endpoint,
+// so Prometheus can monitor the fleet without listing MusicServices from the API server
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// Database modes reported in the db_mode label of musicservice_info
+const (
+	DatabaseModeNone        = "none"
+	DatabaseModeReplication = "replication"
+	DatabaseModeGalera      = "galera"
+)
+
+var (
+	info = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "musicservice_info",
+		Help: "Information about a MusicService; always 1",
+	}, []string{"name", "namespace", "phase", "image", "db_mode", "ha"})
+
+	readyReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "musicservice_ready_replicas",
+		Help: "Number of ready app replicas of a MusicService",
+	}, []string{"name", "namespace"})
+
+	desiredReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "musicservice_desired_replicas",
+		Help: "Number of desired app replicas of a MusicService",
+	}, []string{"name", "namespace"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(info, readyReplicas, desiredReplicas)
+}
+
+// Record sets the gauges of a MusicService from its spec and status.
+// The previous info series is dropped first since its phase or image label may have changed
+func Record(ms *musicv1.MusicService) {
+	Forget(ms.Namespace, ms.Name)
+
+	info.WithLabelValues(ms.Name, ms.Namespace, ms.Status.Phase, ms.Spec.Image,
+		databaseMode(ms), strconv.FormatBool(databaseMode(ms) == DatabaseModeGalera)).Set(1)
+	readyReplicas.WithLabelValues(ms.Name, ms.Namespace).Set(float64(ms.Status.ReadyReplicas))
+	desiredReplicas.WithLabelValues(ms.Name, ms.Namespace).Set(float64(ms.Spec.Replicas))
+}
+
+// Forget removes every series of a deleted MusicService
+func Forget(namespace, name string) {
+	labels := prometheus.Labels{"name": name, "namespace": namespace}
+	info.DeletePartialMatch(labels)
+	readyReplicas.Delete(labels)
+	desiredReplicas.Delete(labels)
+}
+
+func databaseMode(ms *musicv1.MusicService) string {
+	db := ms.Spec.Database
+	switch {
+	case db == nil || !db.Enabled:
+		return DatabaseModeNone
+	case db.HighAvailability != nil && db.HighAvailability.Enabled:
+		return DatabaseModeGalera
+	default:
+		return DatabaseModeReplication
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+func TestRecord(t *testing.T) {
+	ms := &musicv1.MusicService{
+		ObjectMeta: metav1.ObjectMeta{Name: "radio", Namespace: "music"},
+		Spec: musicv1.MusicServiceSpec{
+			Replicas: 3,
+			Image:    "music:1.0",
+			Database: &musicv1.DatabaseSpec{
+				Enabled:          true,
+				HighAvailability: &musicv1.DatabaseHighAvailabilitySpec{Enabled: true},
+			},
+		},
+		Status: musicv1.MusicServiceStatus{Phase: "Progressing", ReadyReplicas: 1},
+	}
+
+	Record(ms)
+	ms.Status.Phase = "Available"
+	ms.Status.ReadyReplicas = 3
+	Record(ms)
+
+	want := `
+# HELP musicservice_info Information about a MusicService; always 1
+# TYPE musicservice_info gauge
+musicservice_info{db_mode="galera",ha="true",image="music:1.0",name="radio",namespace="music",phase="Available"} 1
+# HELP musicservice_ready_replicas Number of ready app replicas of a MusicService
+# TYPE musicservice_ready_replicas gauge
+musicservice_ready_replicas{name="radio",namespace="music"} 3
+# HELP musicservice_desired_replicas Number of desired app replicas of a MusicService
+# TYPE musicservice_desired_replicas gauge
+musicservice_desired_replicas{name="radio",namespace="music"} 3
+`
+	if err := testutil.CollectAndCompare(info, strings.NewReader(want), "musicservice_info"); err != nil {
+		t.Error(err)
+	}
+	if err := testutil.CollectAndCompare(readyReplicas, strings.NewReader(want), "musicservice_ready_replicas"); err != nil {
+		t.Error(err)
+	}
+	if err := testutil.CollectAndCompare(desiredReplicas, strings.NewReader(want), "musicservice_desired_replicas"); err != nil {
+		t.Error(err)
+	}
+
+	Forget("music", "radio")
+	if n := testutil.CollectAndCount(info) + testutil.CollectAndCount(readyReplicas) + testutil.CollectAndCount(desiredReplicas); n != 0 {
+		t.Errorf("expected no series after Forget, got %d", n)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Format selects the payload posted to the webhook
+type Format string
+
+const (
+	// FormatJSON posts the Event as a JSON object
+	FormatJSON Format = "json"
+	// FormatSlack posts a Slack incoming-webhook message ({"text": ...})
+	FormatSlack Format = "slack"
+)
+
+// Kind identifies what happened to the MusicService
+type Kind string
+
+const (
+	// KindFailed is sent when status.phase transitions to Failed
+	KindFailed Kind = "Failed"
+	// KindBackupFailed is sent once for every database backup Job that fails
+	KindBackupFailed Kind = "BackupFailed"
+	// KindPrimaryDown is sent when the database master stops being ready.
+	// The operator does not promote replicas, so this is the failover signal on-call acts on
+	KindPrimaryDown Kind = "DatabasePrimaryDown"
+)
+
+// Event is a single notification about a MusicService
+type Event struct {
+	Kind      Kind      `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Time      time.Time `json:"time"`
+}
+
+// Notifier posts Events to an operator-level webhook.
+// A nil Notifier is valid and drops every Event
+type Notifier struct {
+	url    string
+	format Format
+	client *http.Client
+}
+
+// NewNotifier creates a Notifier that posts to url; an empty url disables notifications
+func NewNotifier(url string, format Format) (*Notifier, error) {
+	if url == "" {
+		return nil, nil
+	}
+	if format != FormatJSON && format != FormatSlack {
+		return nil, fmt.Errorf("unsupported notification format %q: must be %q or %q", format, FormatJSON, FormatSlack)
+	}
+	return &Notifier{url: url, format: format, client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+// Notify sends the Event in the background so a slow webhook never blocks reconciliation.
+// Delivery errors are only logged
+func (n *Notifier) Notify(ctx context.Context, event Event) {
+	if n == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	logger := log.FromContext(ctx)
+	go func() {
+		if err := n.Send(context.Background(), event); err != nil {
+			logger.Error(err, "failed to send notification", "kind", event.Kind)
+		}
+	}()
+}
+
+// Send posts the Event and waits for the webhook to answer
+func (n *Notifier) Send(ctx context.Context, event Event) error {
+	if n == nil {
+		return nil
+	}
+	body, err := n.payload(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// payload renders the Event in the configured format
+func (n *Notifier) payload(event Event) ([]byte, error) {
+	if n.format == FormatSlack {
+		text := fmt.Sprintf(":rotating_light: *%s* MusicService %s/%s: %s - %s",
+			event.Kind, event.Namespace, event.Name, event.Reason, event.Message)
+		return json.Marshal(map[string]string{"text": text})
+	}
+	return json.Marshal(event)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSend(t *testing.T) {
+	event := Event{Kind: KindBackupFailed, Namespace: "music", Name: "demo", Reason: "JobFailed", Message: "backup job demo-db-backup-1 failed"}
+
+	tests := []struct {
+		format Format
+		check  func(t *testing.T, body map[string]interface{})
+	}{
+		{
+			format: FormatJSON,
+			check: func(t *testing.T, body map[string]interface{}) {
+				if body["kind"] != string(KindBackupFailed) || body["namespace"] != "music" || body["name"] != "demo" {
+					t.Errorf("unexpected JSON payload %v", body)
+				}
+			},
+		},
+		{
+			format: FormatSlack,
+			check: func(t *testing.T, body map[string]interface{}) {
+				text, _ := body["text"].(string)
+				if !strings.Contains(text, "BackupFailed") || !strings.Contains(text, "music/demo") {
+					t.Errorf("unexpected Slack text %q", text)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				raw, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(raw, &body); err != nil {
+					t.Errorf("payload is not JSON: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			n, err := NewNotifier(server.URL, tt.format)
+			if err != nil {
+				t.Fatalf("NewNotifier: %v", err)
+			}
+			if err := n.Send(context.Background(), event); err != nil {
+				t.Fatalf("Send: %v", err)
+			}
+			tt.check(t, body)
+		})
+	}
+}
+
+func TestSendErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n, err := NewNotifier(server.URL, FormatJSON)
+	if err != nil {
+		t.Fatalf("NewNotifier: %v", err)
+	}
+	if err := n.Send(context.Background(), Event{Kind: KindFailed}); err == nil {
+		t.Error("expected an error for a non-2xx response")
+	}
+
+	if _, err := NewNotifier(server.URL, "teams"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+
+	disabled, err := NewNotifier("", FormatJSON)
+	if err != nil || disabled != nil {
+		t.Fatalf("empty url should disable notifications, got %v, %v", disabled, err)
+	}
+	if err := disabled.Send(context.Background(), Event{Kind: KindFailed}); err != nil {
+		t.Errorf("nil Notifier should drop events, got %v", err)
+	}
+}
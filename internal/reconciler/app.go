@@ -18,24 +18,25 @@ package reconciler
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
-	"github.com/example/managedapp-operator/internal/builder"
 	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
 )
 
 // Hướng dẫn đọc nhanh:
 // - Nếu chưa rõ các field autoscaling/replicas/resources, xem api/v1/musicservice_types.go.
-// - Nếu chưa rõ cách tạo tài nguyên, xem internal/builder/resource_builder.go.
+// - Nếu chưa rõ cách tạo tài nguyên, xem pkg/builder/resource_builder.go.
 // - Nếu chưa rõ xử lý thay đổi dung lượng, xem internal/reconciler/storage.go.
 // - Nếu chưa rõ luồng gọi, xem internal/controller/musicservice_controller.go.
 
@@ -57,31 +58,80 @@ func NewAppReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Forma
 
 // ReconcileService đồng bộ Service của ứng dụng
 func (ar *AppReconciler) ReconcileService(ctx context.Context, ms *musicv1.MusicService) error {
-	log := log.FromContext(ctx)
+	log := ar.formatter.Logger(ctx, ms, "app")
 
 	service := &corev1.Service{}
-	serviceName := types.NamespacedName{Name: ms.Name, Namespace: ms.Namespace}
+	serviceName := types.NamespacedName{Name: ms.Name, Namespace: builder.WorkloadNamespace(ms)}
 
 	err := ar.client.Get(ctx, serviceName, service)
 	if err != nil && errors.IsNotFound(err) {
 		service = ar.builder.BuildAppService(ms)
-		log.Info("Creating new Service", "Service", ms.Name)
+		stampDesiredHash(service, builder.DesiredHash(service))
+		log.Info(ar.formatter.Format(ms, "Creating new Service"), "Service", ms.Name)
 		return ar.client.Create(ctx, service)
+	} else if err != nil {
+		return err
+	}
+
+	desired := ar.builder.BuildAppService(ms)
+	desiredHash := builder.DesiredHash(desired)
+	if recordDrift(ms, "Service", service, desiredHash, serviceDiff(service, desired)) {
+		log.Info(ar.formatter.Format(ms, "Service modified outside the operator, keeping it as driftPolicy is Report"), "Service", ms.Name)
+		return nil
+	}
+	routingChanged := syncServiceRouting(service, desired)
+	portsChanged := syncServicePorts(service, desired)
+	policyChanged := syncServiceTrafficPolicy(service, desired)
+	if policyChanged || routingChanged || portsChanged {
+		log.Info(ar.formatter.Format(ms, "Updating Service routing"), "Service", ms.Name)
+	}
+	if stampDesiredHash(service, desiredHash) || policyChanged || routingChanged || portsChanged {
+		return ar.client.Update(ctx, service)
 	}
 
-	return err
+	return nil
 }
 
-// ReconcileStatefulSet đồng bộ StatefulSet của ứng dụng
-func (ar *AppReconciler) ReconcileStatefulSet(ctx context.Context, ms *musicv1.MusicService) error {
-	log := log.FromContext(ctx)
+// ReconcileLibraries kiểm tra các MusicLibrary được tham chiếu đã tồn tại trước khi mount vào pod ứng dụng
+func (ar *AppReconciler) ReconcileLibraries(ctx context.Context, ms *musicv1.MusicService) error {
+	for _, mount := range ms.Spec.Libraries {
+		library := &musicv1.MusicLibrary{}
+		libraryName := types.NamespacedName{Name: mount.Name, Namespace: builder.WorkloadNamespace(ms)}
+		if err := ar.client.Get(ctx, libraryName, library); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("MusicLibrary %s not found in namespace %s", libraryName.Name, libraryName.Namespace)
+			}
+			return err
+		}
+		if library.DeletionTimestamp != nil {
+			return fmt.Errorf("MusicLibrary %s is being deleted", libraryName.Name)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileStatefulSet đồng bộ StatefulSet của ứng dụng; draining giữ số replica hiện tại khi
+// ReconcileDrain còn pod đang rút kết nối, các thay đổi khác vẫn được áp dụng
+func (ar *AppReconciler) ReconcileStatefulSet(ctx context.Context, ms *musicv1.MusicService, draining bool) error {
+	log := ar.formatter.Logger(ctx, ms, "app")
 
 	sts := &appsv1.StatefulSet{}
-	stsName := types.NamespacedName{Name: ms.Name, Namespace: ms.Namespace}
+	stsName := types.NamespacedName{Name: ms.Name, Namespace: builder.WorkloadNamespace(ms)}
+
+	// Chế độ Deployment hoặc shards: xóa StatefulSet nhưng giữ PVC music-data để có thể chuyển lại StatefulSet
+	if builder.AppUsesDeployment(ms) || builder.ShardsEnabled(ms) {
+		forgetDrift(ms, "StatefulSet", stsName.Name)
+		return deleteObjectIfExists(ctx, ar.client, stsName, &appsv1.StatefulSet{})
+	}
 
 	err := ar.client.Get(ctx, stsName, sts)
 	if err != nil && errors.IsNotFound(err) {
 		sts = ar.builder.BuildAppStatefulSet(ms)
+		if err := ar.applyConfigChecksum(ctx, ms, &sts.Spec.Template); err != nil {
+			return err
+		}
+		stampDesiredHash(sts, builder.DesiredHash(sts))
 		log.Info(ar.formatter.Format(ms, "Creating new StatefulSet"), "StatefulSet", ms.Name)
 		return ar.client.Create(ctx, sts)
 	} else if err != nil {
@@ -90,13 +140,21 @@ func (ar *AppReconciler) ReconcileStatefulSet(ctx context.Context, ms *musicv1.M
 
 	// Cập nhật nếu spec thay đổi
 	desiredSts := ar.builder.BuildAppStatefulSet(ms)
+	if err := ar.applyConfigChecksum(ctx, ms, &desiredSts.Spec.Template); err != nil {
+		return err
+	}
+	desiredHash := builder.DesiredHash(desiredSts)
+	// Khi có HPA, số replica do HPA quyết định; khi đang rút kết nối thì chưa giảm replica
+	if builder.AutoscalingEnabled(ms.Spec.Autoscaling) || (draining && *desiredSts.Spec.Replicas < *sts.Spec.Replicas) {
+		desiredSts.Spec.Replicas = sts.Spec.Replicas
+	}
 
 	storageChanged := storageSizeChanged(sts, desiredSts)
 	if storageChanged {
-		policy := storageUpdatePolicy(ms.Spec.Storage)
+		policy := storageUpdatePolicy(appStorageSpec(ms))
 		if policy == musicv1.StorageUpdatePolicyRecreate {
-			log.Info("Recreating StatefulSet and PVCs due to storage size change", "StatefulSet", ms.Name)
-			return recreateStatefulSetStorage(ctx, ar.client, sts, "music-data", ms.Name)
+			log.Info(ar.formatter.Format(ms, "Recreating StatefulSet and PVCs due to storage size change"), "StatefulSet", ms.Name)
+			return recreateStorage(ctx, ar.client, ar.builder, ms, sts, "music-data", ms.Name, ms.Spec.Storage)
 		}
 
 		if err := resizePVCs(ctx, ar.client, "music-data", ms.Name, desiredSts); err != nil {
@@ -104,29 +162,93 @@ func (ar *AppReconciler) ReconcileStatefulSet(ctx context.Context, ms *musicv1.M
 		}
 	}
 
-	if statefulSetNeedsUpdate(sts, desiredSts) {
-		log.Info("Updating StatefulSet", "StatefulSet", ms.Name)
-		sts.Spec = desiredSts.Spec
+	if err := syncPVCMetadata(ctx, ar.client, "music-data", ms.Name, desiredSts); err != nil {
+		return err
+	}
+
+	// podManagementPolicy là field bất biến: xóa StatefulSet nhưng giữ pod và PVC, lần reconcile sau sẽ tạo lại và nhận lại pod
+	if sts.Spec.PodManagementPolicy != desiredSts.Spec.PodManagementPolicy {
+		log.Info(ar.formatter.Format(ms, "Recreating StatefulSet to change podManagementPolicy"), "StatefulSet", ms.Name,
+			"from", sts.Spec.PodManagementPolicy, "to", desiredSts.Spec.PodManagementPolicy)
+		return client.IgnoreNotFound(ar.client.Delete(ctx, sts, client.PropagationPolicy(metav1.DeletePropagationOrphan)))
+	}
+
+	if recordDrift(ms, "StatefulSet", sts, desiredHash, statefulSetDiff(sts, desiredSts)) {
+		log.Info(ar.formatter.Format(ms, "StatefulSet modified outside the operator, keeping it as driftPolicy is Report"), "StatefulSet", ms.Name)
+		return nil
+	}
+
+	needsUpdate := statefulSetNeedsUpdate(sts, desiredSts)
+	if needsUpdate {
+		log.Info(ar.formatter.Format(ms, "Updating StatefulSet"), "StatefulSet", ms.Name)
+		updateStatefulSetSpec(sts, desiredSts)
+	}
+	if stampDesiredHash(sts, desiredHash) || needsUpdate {
 		return ar.client.Update(ctx, sts)
 	}
 
 	return nil
 }
 
+// ReconcileSharedStorage tạo, mở rộng và gắn metadata cho PVC music-data dùng chung khi accessMode là ReadWriteMany
+// PVC không bị xóa khi chuyển về PVC riêng từng pod để dữ liệu vẫn còn nếu cần chuyển lại
+func (ar *AppReconciler) ReconcileSharedStorage(ctx context.Context, ms *musicv1.MusicService) error {
+	if !builder.AppSharedStorage(ms) {
+		return nil
+	}
+	log := ar.formatter.Logger(ctx, ms, "app")
+
+	desired := ar.builder.BuildAppSharedPVC(ms)
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := ar.client.Get(ctx, client.ObjectKeyFromObject(desired), pvc)
+	if errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating shared music-data PVC"), "PVC", desired.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	labels, labelsChanged := mergeMetadata(pvc.Labels, desired.Labels)
+	annotations, annotationsChanged := mergeMetadata(pvc.Annotations, desired.Annotations)
+	changed := labelsChanged || annotationsChanged
+	pvc.Labels = labels
+	pvc.Annotations = annotations
+
+	desiredSize := desired.Spec.Resources.Requests[corev1.ResourceStorage]
+	if currentSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; currentSize.Cmp(desiredSize) < 0 {
+		// The API server rejects the update anyway; the status reports ExpansionNotSupported instead
+		allowed, err := storageClassAllowsExpansion(ctx, ar.client, pvc)
+		if err != nil {
+			return err
+		}
+		if allowed {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desiredSize
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	log.Info(ar.formatter.Format(ms, "Updating shared music-data PVC"), "PVC", pvc.Name)
+	return ar.client.Update(ctx, pvc)
+}
+
 // ReconcileAutoscaler đồng bộ HorizontalPodAutoscaler
 func (ar *AppReconciler) ReconcileAutoscaler(ctx context.Context, ms *musicv1.MusicService) error {
-	log := log.FromContext(ctx)
-	if ms.Spec.Autoscaling == nil {
+	log := ar.formatter.Logger(ctx, ms, "app")
+	// Tắt autoscaling thì xóa HPA nhưng giữ nguyên cấu hình trong spec để bật lại sau
+	if !builder.AutoscalingEnabled(ms.Spec.Autoscaling) {
 		return ar.deleteAutoscalerIfExists(ctx, ms)
 	}
 
 	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
-	hpaName := types.NamespacedName{Name: ms.Name + "-autoscaler", Namespace: ms.Namespace}
+	hpaName := types.NamespacedName{Name: ms.Name + "-autoscaler", Namespace: builder.WorkloadNamespace(ms)}
 
 	err := ar.client.Get(ctx, hpaName, hpa)
 	if err != nil && errors.IsNotFound(err) {
 		hpa = ar.builder.BuildAutoscaler(ms)
-		log.Info("Creating new HorizontalPodAutoscaler", "HPA", hpaName.Name)
+		log.Info(ar.formatter.Format(ms, "Creating new HorizontalPodAutoscaler"), "HPA", hpaName.Name)
 		return ar.client.Create(ctx, hpa)
 	} else if err != nil {
 		return err
@@ -134,7 +256,7 @@ func (ar *AppReconciler) ReconcileAutoscaler(ctx context.Context, ms *musicv1.Mu
 
 	desiredHpa := ar.builder.BuildAutoscaler(ms)
 	if autoscalerNeedsUpdate(hpa, desiredHpa) {
-		log.Info("Updating HorizontalPodAutoscaler", "HPA", hpaName.Name)
+		log.Info(ar.formatter.Format(ms, "Updating HorizontalPodAutoscaler"), "HPA", hpaName.Name)
 		hpa.Spec = desiredHpa.Spec
 		return ar.client.Update(ctx, hpa)
 	}
@@ -142,54 +264,154 @@ func (ar *AppReconciler) ReconcileAutoscaler(ctx context.Context, ms *musicv1.Mu
 	return nil
 }
 
+// appStorageSpec trả về spec.storage, hoặc StorageSpec rỗng khi ứng dụng không có PVC music-data
+func appStorageSpec(ms *musicv1.MusicService) musicv1.StorageSpec {
+	if ms.Spec.Storage != nil {
+		return *ms.Spec.Storage
+	}
+	return musicv1.StorageSpec{}
+}
+
 // statefulSetNeedsUpdate kiểm tra xem spec của StatefulSet có cần cập nhật không
 func statefulSetNeedsUpdate(current, desired *appsv1.StatefulSet) bool {
+	return len(statefulSetDiff(current, desired)) > 0
+}
+
+// statefulSetDiff trả về đường dẫn các field StatefulSet do operator quản lý mà current khác desired
+func statefulSetDiff(current, desired *appsv1.StatefulSet) []string {
+	var fields []string
 	if *current.Spec.Replicas != *desired.Spec.Replicas {
-		return true
+		fields = append(fields, "spec.replicas")
 	}
 
-	if !reflect.DeepEqual(current.Spec.Template.Spec.InitContainers, desired.Spec.Template.Spec.InitContainers) {
-		return true
+	if current.Spec.MinReadySeconds != desired.Spec.MinReadySeconds {
+		fields = append(fields, "spec.minReadySeconds")
 	}
 
-	if !reflect.DeepEqual(current.Spec.Template.Spec.Volumes, desired.Spec.Template.Spec.Volumes) {
-		return true
+	return append(fields, podTemplateDiff(&current.Spec.Template, &desired.Spec.Template)...)
+}
+
+// podTemplateDiff trả về đường dẫn các field của pod template do operator quản lý mà current khác desired
+func podTemplateDiff(current, desired *corev1.PodTemplateSpec) []string {
+	var fields []string
+	if templateAnnotationsChanged(current, desired) {
+		fields = append(fields, "spec.template.metadata.annotations")
 	}
 
-	if len(current.Spec.Template.Spec.Containers) != len(desired.Spec.Template.Spec.Containers) {
-		return true
+	for _, field := range podSpecDiff(&current.Spec, &desired.Spec) {
+		fields = append(fields, "spec.template.spec."+field)
 	}
+	return fields
+}
 
-	for i := range current.Spec.Template.Spec.Containers {
-		currentContainer := current.Spec.Template.Spec.Containers[i]
-		desiredContainer := desired.Spec.Template.Spec.Containers[i]
-		if currentContainer.Image != desiredContainer.Image {
+// templateAnnotationsChanged so sánh annotation safe-to-evict và checksum cấu hình của pod template;
+// các annotation khác do bước khác (ví dụ xoay vòng user replication) quản lý nên không so sánh
+func templateAnnotationsChanged(current, desired *corev1.PodTemplateSpec) bool {
+	for _, key := range []string{builder.SafeToEvictAnnotation, builder.ConfigChecksumAnnotation} {
+		currentValue, currentSet := current.Annotations[key]
+		desiredValue, desiredSet := desired.Annotations[key]
+		if currentSet != desiredSet || currentValue != desiredValue {
 			return true
 		}
+	}
+	return false
+}
+
+// podSpecNeedsUpdate so sánh các field của pod template mà operator quản lý
+func podSpecNeedsUpdate(current, desired *corev1.PodSpec) bool {
+	return len(podSpecDiff(current, desired)) > 0
+}
+
+// podSpecDiff trả về đường dẫn (tính từ pod spec) các field operator quản lý mà current khác desired;
+// field của container được ghi theo tên container, ví dụ containers[music-service].image
+func podSpecDiff(current, desired *corev1.PodSpec) []string {
+	var fields []string
+	if !reflect.DeepEqual(current.InitContainers, desired.InitContainers) {
+		fields = append(fields, "initContainers")
+	}
+
+	if !reflect.DeepEqual(current.Volumes, desired.Volumes) {
+		fields = append(fields, "volumes")
+	}
+
+	if !reflect.DeepEqual(current.ImagePullSecrets, desired.ImagePullSecrets) {
+		fields = append(fields, "imagePullSecrets")
+	}
+
+	if !reflect.DeepEqual(current.ReadinessGates, desired.ReadinessGates) {
+		fields = append(fields, "readinessGates")
+	}
+
+	if !reflect.DeepEqual(current.RuntimeClassName, desired.RuntimeClassName) {
+		fields = append(fields, "runtimeClassName")
+	}
+
+	if !reflect.DeepEqual(current.NodeSelector, desired.NodeSelector) {
+		fields = append(fields, "nodeSelector")
+	}
+	if !reflect.DeepEqual(current.Tolerations, desired.Tolerations) {
+		fields = append(fields, "tolerations")
+	}
+
+	// A nil grace period is defaulted by the API server, so only an explicit one is compared
+	if desired.TerminationGracePeriodSeconds != nil && !reflect.DeepEqual(current.TerminationGracePeriodSeconds, desired.TerminationGracePeriodSeconds) {
+		fields = append(fields, "terminationGracePeriodSeconds")
+	}
+
+	if len(current.Containers) != len(desired.Containers) {
+		return append(fields, "containers")
+	}
+
+	for i := range current.Containers {
+		currentContainer := current.Containers[i]
+		desiredContainer := desired.Containers[i]
+		prefix := fmt.Sprintf("containers[%s].", desiredContainer.Name)
+		if currentContainer.Image != desiredContainer.Image {
+			fields = append(fields, prefix+"image")
+		}
+		if !reflect.DeepEqual(currentContainer.Command, desiredContainer.Command) {
+			fields = append(fields, prefix+"command")
+		}
+		if !reflect.DeepEqual(currentContainer.Args, desiredContainer.Args) {
+			fields = append(fields, prefix+"args")
+		}
+		// An empty policy is defaulted by the API server, so only an explicit one is compared
+		if desiredContainer.ImagePullPolicy != "" && currentContainer.ImagePullPolicy != desiredContainer.ImagePullPolicy {
+			fields = append(fields, prefix+"imagePullPolicy")
+		}
 		if !reflect.DeepEqual(currentContainer.Resources, desiredContainer.Resources) {
-			return true
+			fields = append(fields, prefix+"resources")
 		}
 		if !reflect.DeepEqual(currentContainer.Env, desiredContainer.Env) {
-			return true
+			fields = append(fields, prefix+"env")
 		}
 		if !reflect.DeepEqual(currentContainer.VolumeMounts, desiredContainer.VolumeMounts) {
-			return true
+			fields = append(fields, prefix+"volumeMounts")
 		}
 		if !reflect.DeepEqual(currentContainer.Ports, desiredContainer.Ports) {
-			return true
+			fields = append(fields, prefix+"ports")
 		}
 		if !reflect.DeepEqual(currentContainer.ReadinessProbe, desiredContainer.ReadinessProbe) {
-			return true
+			fields = append(fields, prefix+"readinessProbe")
 		}
 		if !reflect.DeepEqual(currentContainer.LivenessProbe, desiredContainer.LivenessProbe) {
-			return true
+			fields = append(fields, prefix+"livenessProbe")
+		}
+		if !reflect.DeepEqual(currentContainer.StartupProbe, desiredContainer.StartupProbe) {
+			fields = append(fields, prefix+"startupProbe")
+		}
+		if !reflect.DeepEqual(currentContainer.Lifecycle, desiredContainer.Lifecycle) {
+			fields = append(fields, prefix+"lifecycle")
 		}
 	}
 
-	return false
+	return fields
 }
 
 func autoscalerNeedsUpdate(current, desired *autoscalingv2.HorizontalPodAutoscaler) bool {
+	if current.Spec.ScaleTargetRef != desired.Spec.ScaleTargetRef {
+		return true
+	}
 	if current.Spec.MaxReplicas != desired.Spec.MaxReplicas {
 		return true
 	}
@@ -228,7 +450,7 @@ func autoscalerNeedsUpdate(current, desired *autoscalingv2.HorizontalPodAutoscal
 
 func (ar *AppReconciler) deleteAutoscalerIfExists(ctx context.Context, ms *musicv1.MusicService) error {
 	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
-	hpaName := types.NamespacedName{Name: ms.Name + "-autoscaler", Namespace: ms.Namespace}
+	hpaName := types.NamespacedName{Name: ms.Name + "-autoscaler", Namespace: builder.WorkloadNamespace(ms)}
 
 	err := ar.client.Get(ctx, hpaName, hpa)
 	if err != nil && errors.IsNotFound(err) {
@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// AppliedSpecReconciler đánh dấu các tài nguyên con bằng mã băm, generation và thời điểm
+// của spec vừa reconcile thành công, giúp đối chiếu thay đổi của tài nguyên con với CR khi điều tra sự cố,
+// đồng thời gắn spec.labels/spec.annotations lên các tài nguyên con đã tồn tại
+type AppliedSpecReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+}
+
+// NewAppliedSpecReconciler tạo một reconciler mới cho annotation applied-spec
+func NewAppliedSpecReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter) *AppliedSpecReconciler {
+	return &AppliedSpecReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+	}
+}
+
+// Reconcile chỉ được gọi sau khi mọi bước reconcile đã thành công.
+// Tài nguyên đã mang đúng mã băm và generation thì giữ nguyên để thời điểm applied-at không bị ghi đè
+func (ar *AppliedSpecReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	hash := builder.SpecHash(ms)
+	generation := strconv.FormatInt(ms.Generation, 10)
+	appliedAt := time.Now().UTC().Format(time.RFC3339)
+
+	lists := []client.ObjectList{
+		&appsv1.StatefulSetList{},
+		&appsv1.DeploymentList{},
+		&corev1.ServiceList{},
+		&corev1.ConfigMapList{},
+		&corev1.SecretList{},
+		&corev1.PersistentVolumeClaimList{},
+		&autoscalingv2.HorizontalPodAutoscalerList{},
+		&batchv1.CronJobList{},
+		&batchv1.JobList{},
+		&networkingv1.IngressList{},
+	}
+
+	for _, list := range lists {
+		if err := ar.client.List(ctx, list,
+			client.InNamespace(builder.WorkloadNamespace(ms)),
+			client.MatchingLabels(builder.InstanceSelector(ms))); err != nil {
+			return err
+		}
+
+		objects, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+		for _, item := range objects {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+			// Tài nguyên con có sẵn không được cập nhật metadata khi sửa spec, nên spec.labels/spec.annotations
+			// được gắn lại ở đây; chỉ thêm hoặc ghi đè key, không xóa key do công cụ khác gắn
+			labels, labelsChanged := mergeMetadata(obj.GetLabels(), ms.Spec.Labels)
+			annotations, annotationsChanged := mergeMetadata(obj.GetAnnotations(), ms.Spec.Annotations)
+			applied := annotations[builder.AppliedSpecHashAnnotation] == hash && annotations[builder.AppliedGenerationAnnotation] == generation
+			if applied && !labelsChanged && !annotationsChanged {
+				continue
+			}
+
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			if !applied {
+				annotations[builder.AppliedSpecHashAnnotation] = hash
+				annotations[builder.AppliedGenerationAnnotation] = generation
+				annotations[builder.AppliedAtAnnotation] = appliedAt
+			}
+			obj.SetLabels(labels)
+			obj.SetAnnotations(annotations)
+			if err := ar.client.Patch(ctx, obj, patch); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/cron"
+	"github.com/example/managedapp-operator/internal/notify"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ các field backup, xem api/v1/musicservice_types.go (DatabaseBackupSpec).
+// - Nếu chưa rõ CronJob/PVC sao lưu được dựng thế nào, xem pkg/builder/backup.go.
+
+// InitRestoreStage cho biết việc khôi phục dữ liệu khởi tạo (spec.database.initFrom) đang chặn bước nào
+type InitRestoreStage int
+
+const (
+	// InitRestoreComplete: không cấu hình initFrom hoặc đã khôi phục xong
+	InitRestoreComplete InitRestoreStage = iota
+	// InitRestoreBlocksPrimary: khôi phục physical đang ghi vào PVC, chưa được tạo primary
+	InitRestoreBlocksPrimary
+	// InitRestoreBlocksReplicas: khôi phục logical đang chạy trên primary, chưa được tạo replica
+	InitRestoreBlocksReplicas
+)
+
+// BackupReconciler xử lý việc đồng bộ PVC và CronJob sao lưu cơ sở dữ liệu
+type BackupReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+	notifier  *notify.Notifier
+}
+
+// NewBackupReconciler tạo một reconciler mới cho sao lưu; n là nil khi không gửi thông báo Job thất bại
+func NewBackupReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter, n *notify.Notifier) *BackupReconciler {
+	return &BackupReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+		notifier:  n,
+	}
+}
+
+// Reconcile đồng bộ PVC và CronJob sao lưu; khi tắt backup thì xóa CronJob nhưng giữ PVC để không mất dữ liệu
+func (br *BackupReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	if !backupEnabled(br.builder, ms) {
+		if ms.Status.Database != nil && ms.Status.Database.Backup != nil {
+			ms.Status.Database.Backup.NextScheduledBackup = nil
+		}
+		return br.deleteCronJobIfExists(ctx, ms)
+	}
+
+	if ms.Spec.Database.Backup.Destination.PVC == nil {
+		return fmt.Errorf("backup destination is required: set spec.database.backup.destination.pvc")
+	}
+
+	if err := br.reconcilePVC(ctx, ms); err != nil {
+		return err
+	}
+
+	if err := br.reconcileCronJob(ctx, ms); err != nil {
+		return err
+	}
+
+	return br.updateBackupStatus(ctx, ms)
+}
+
+// updateBackupStatus ghi bản sao lưu thành công gần nhất và lần chạy kế tiếp vào status.database.backup.
+// Tên và kích thước file lấy từ termination message của pod sao lưu; khi pod đã bị dọn chỉ còn thời điểm
+func (br *BackupReconciler) updateBackupStatus(ctx context.Context, ms *musicv1.MusicService) error {
+	if ms.Status.Database == nil {
+		ms.Status.Database = &musicv1.DatabaseStatus{}
+	}
+	if ms.Status.Database.Backup == nil {
+		ms.Status.Database.Backup = &musicv1.BackupStatus{}
+	}
+	status := ms.Status.Database.Backup
+	namespace := builder.WorkloadNamespace(ms)
+
+	// CronJob không có timeZone chạy theo múi giờ của kube-controller-manager, thường là UTC
+	status.NextScheduledBackup = nil
+	location := time.UTC
+	if tz := ms.Spec.Database.Backup.TimeZone; tz != nil {
+		if loc, err := time.LoadLocation(*tz); err == nil {
+			location = loc
+		}
+	}
+	if schedule, err := cron.Parse(ms.Spec.Database.Backup.Schedule); err == nil {
+		if next := schedule.Next(time.Now().In(location)); !next.IsZero() {
+			status.NextScheduledBackup = &metav1.Time{Time: next}
+		}
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := br.client.List(ctx, jobs, client.InNamespace(namespace),
+		client.MatchingLabels{"app": ms.Name, "component": "db-backup"}); err != nil {
+		return err
+	}
+	var latest, latestFailed *batchv1.Job
+	var failedAt metav1.Time
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if t, failed := jobFailedAt(job); failed {
+			if latestFailed == nil || t.After(failedAt.Time) {
+				latestFailed, failedAt = job, t
+			}
+			continue
+		}
+		if job.Status.Succeeded == 0 || job.Status.CompletionTime == nil {
+			continue
+		}
+		if latest == nil || job.Status.CompletionTime.After(latest.Status.CompletionTime.Time) {
+			latest = job
+		}
+	}
+	// Mỗi Job thất bại chỉ được thông báo một lần, mốc so sánh được lưu trong status
+	if latestFailed != nil && (status.LastFailedBackupTime == nil || failedAt.After(status.LastFailedBackupTime.Time)) {
+		status.LastFailedBackupTime = failedAt.DeepCopy()
+		br.notifier.Notify(ctx, notify.Event{
+			Kind:      notify.KindBackupFailed,
+			Namespace: ms.Namespace,
+			Name:      ms.Name,
+			Reason:    "BackupJobFailed",
+			Message:   fmt.Sprintf("Backup Job %s/%s failed", namespace, latestFailed.Name),
+		})
+	}
+	if latest == nil || (status.LastBackupTime != nil && !latest.Status.CompletionTime.After(status.LastBackupTime.Time)) {
+		return nil
+	}
+	status.LastBackupTime = latest.Status.CompletionTime.DeepCopy()
+	status.LastBackupSizeBytes = 0
+	status.LastBackupLocation = ""
+
+	pods := &corev1.PodList{}
+	if err := br.client.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{"job-name": latest.Name}); err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		// Khi có destination.objectStorage, backup chạy dưới dạng init container
+		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			terminated := cs.State.Terminated
+			if cs.Name != "backup" || terminated == nil || terminated.ExitCode != 0 || terminated.Message == "" {
+				continue
+			}
+			result := builder.BackupResult{}
+			if err := json.Unmarshal([]byte(terminated.Message), &result); err != nil {
+				continue
+			}
+			status.LastBackupSizeBytes = result.SizeBytes
+			status.LastBackupLocation = fmt.Sprintf("pvc://%s/%s", builder.BackupName(ms), result.File)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// jobFailedAt trả về thời điểm Job chuyển sang Failed
+func jobFailedAt(job *batchv1.Job) (metav1.Time, bool) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return cond.LastTransitionTime, true
+		}
+	}
+	return metav1.Time{}, false
+}
+
+// ReconcileInitRestore chạy Job khôi phục một lần từ bản sao lưu của MusicService nguồn
+// và trả về bước đang bị chặn cho tới khi Job hoàn tất
+func (br *BackupReconciler) ReconcileInitRestore(ctx context.Context, ms *musicv1.MusicService) (InitRestoreStage, error) {
+	if ms.Spec.Database == nil || ms.Spec.Database.InitFrom == nil {
+		return InitRestoreComplete, nil
+	}
+	if ms.Status.Database != nil && ms.Status.Database.InitializedFrom != "" {
+		return InitRestoreComplete, nil
+	}
+
+	log := br.formatter.Logger(ctx, ms, "backup")
+	initFrom := ms.Spec.Database.InitFrom
+	if provider := br.builder.DatabaseProvider(ms); !provider.Features().Backup {
+		return InitRestoreBlocksPrimary, fmt.Errorf("initFrom is not supported by database engine %s", provider.Name())
+	}
+
+	sourceNamespace := ms.Namespace
+	if initFrom.Namespace != "" {
+		sourceNamespace = initFrom.Namespace
+	}
+
+	source := &musicv1.MusicService{}
+	if err := br.client.Get(ctx, types.NamespacedName{Name: initFrom.MusicService, Namespace: sourceNamespace}, source); err != nil {
+		return InitRestoreBlocksPrimary, fmt.Errorf("failed to get initFrom source %s/%s: %w", sourceNamespace, initFrom.MusicService, err)
+	}
+	if !backupEnabled(br.builder, source) || source.Spec.Database.Backup.Destination.PVC == nil {
+		return InitRestoreBlocksPrimary, fmt.Errorf("initFrom source %s/%s has no PVC backup configured", sourceNamespace, initFrom.MusicService)
+	}
+
+	blocked := InitRestoreBlocksReplicas
+	if builder.BackupMethodOf(source) == musicv1.BackupMethodPhysical {
+		blocked = InitRestoreBlocksPrimary
+	}
+
+	if sourceNamespace != ms.Namespace {
+		if !cloneAllowed(source, ms.Namespace) {
+			return blocked, fmt.Errorf("MusicService %s/%s does not allow clones into namespace %s (annotation %s)",
+				sourceNamespace, source.Name, ms.Namespace, musicv1.CloneAllowedNamespacesAnnotation)
+		}
+	}
+	if builder.WorkloadNamespace(source) != builder.WorkloadNamespace(ms) && blocked == InitRestoreBlocksPrimary {
+		return blocked, fmt.Errorf("cross-namespace clones require a Logical backup on %s/%s", sourceNamespace, source.Name)
+	}
+
+	desiredJob := br.builder.BuildDatabaseInitRestoreJob(ms, source, initFrom.Archive)
+	job := &batchv1.Job{}
+	err := br.client.Get(ctx, client.ObjectKeyFromObject(desiredJob), job)
+	if err != nil && !errors.IsNotFound(err) {
+		return blocked, err
+	}
+
+	if errors.IsNotFound(err) {
+		if blocked == InitRestoreBlocksPrimary {
+			if err := br.preparePrimaryDataPVC(ctx, ms); err != nil {
+				return blocked, err
+			}
+		}
+		if err := br.copySourceSecrets(ctx, ms, source); err != nil {
+			return blocked, err
+		}
+		if err := br.copyCloneRootPassword(ctx, ms, desiredJob.Namespace); err != nil {
+			return blocked, err
+		}
+
+		log.Info(br.formatter.Format(ms, "Creating init restore Job"), "Job", desiredJob.Name, "jobNamespace", desiredJob.Namespace, "source", source.Name)
+		return blocked, br.client.Create(ctx, desiredJob)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return blocked, fmt.Errorf("init restore from %s/%s failed: %s", sourceNamespace, source.Name, cond.Message)
+		}
+	}
+
+	if job.Status.Succeeded > 0 {
+		if err := br.deleteCloneRootPassword(ctx, ms, job.Namespace); err != nil {
+			return blocked, err
+		}
+		if ms.Status.Database == nil {
+			ms.Status.Database = &musicv1.DatabaseStatus{}
+		}
+		ms.Status.Database.InitializedFrom = sourceNamespace + "/" + source.Name
+		log.Info(br.formatter.Format(ms, "Init restore completed"), "source", ms.Status.Database.InitializedFrom)
+		return InitRestoreComplete, nil
+	}
+
+	return blocked, nil
+}
+
+// copySourceSecrets sao chép thông tin đăng nhập replication của nguồn sang instance đích
+// Bản sao lưu chứa bảng user của nguồn nên replica của bản clone phải dùng đúng mật khẩu đó
+func (br *BackupReconciler) copySourceSecrets(ctx context.Context, ms, source *musicv1.MusicService) error {
+	sourceSecret := &corev1.Secret{}
+	sourceName := types.NamespacedName{Name: source.Name + "-db-replication", Namespace: builder.WorkloadNamespace(source)}
+	if err := br.client.Get(ctx, sourceName, sourceSecret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	targetName := types.NamespacedName{Name: ms.Name + "-db-replication", Namespace: builder.WorkloadNamespace(ms)}
+	target := &corev1.Secret{}
+	err := br.client.Get(ctx, targetName, target)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if errors.IsNotFound(err) {
+		target = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            targetName.Name,
+				Namespace:       targetName.Namespace,
+				OwnerReferences: br.builder.OwnerReferences(ms),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: sourceSecret.Data,
+		}
+		return br.client.Create(ctx, target)
+	}
+
+	target.Data = sourceSecret.Data
+	return br.client.Update(ctx, target)
+}
+
+// copyCloneRootPassword sao chép key rootPasswordSecretRef của ms sang namespace của Job clone khác namespace,
+// nơi Secret gốc không tồn tại; Secret mang nhãn clone-target-namespace như Job và bị xóa khi clone hoàn tất
+func (br *BackupReconciler) copyCloneRootPassword(ctx context.Context, ms *musicv1.MusicService, jobNamespace string) error {
+	ref := ms.Spec.Database.RootPasswordSecretRef
+	if ref == nil || jobNamespace == builder.WorkloadNamespace(ms) {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := br.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: builder.WorkloadNamespace(ms)}, secret); err != nil {
+		return fmt.Errorf("failed to get root password Secret %s: %w", ref.Name, err)
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok {
+		return fmt.Errorf("root password Secret %s has no key %s", ref.Name, ref.Key)
+	}
+
+	targetName := types.NamespacedName{Name: builder.CloneRootPasswordSecretName(ms), Namespace: jobNamespace}
+	target := &corev1.Secret{}
+	err := br.client.Get(ctx, targetName, target)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if errors.IsNotFound(err) {
+		target = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      targetName.Name,
+				Namespace: targetName.Namespace,
+				Labels:    map[string]string{"music.mixcorp.org/clone-target-namespace": builder.WorkloadNamespace(ms)},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{ref.Key: password},
+		}
+		return br.client.Create(ctx, target)
+	}
+
+	target.Data = map[string][]byte{ref.Key: password}
+	return br.client.Update(ctx, target)
+}
+
+// deleteCloneRootPassword xóa bản sao mật khẩu root trong namespace của source khi Job clone đã xong
+func (br *BackupReconciler) deleteCloneRootPassword(ctx context.Context, ms *musicv1.MusicService, jobNamespace string) error {
+	if jobNamespace == builder.WorkloadNamespace(ms) {
+		return nil
+	}
+	name := types.NamespacedName{Name: builder.CloneRootPasswordSecretName(ms), Namespace: jobNamespace}
+	return deleteObjectIfExists(ctx, br.client, name, &corev1.Secret{})
+}
+
+func cloneAllowed(source *musicv1.MusicService, namespace string) bool {
+	allowed, ok := source.Annotations[musicv1.CloneAllowedNamespacesAnnotation]
+	if !ok {
+		return false
+	}
+	for _, ns := range strings.Split(allowed, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns == "*" || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// preparePrimaryDataPVC tạo trước PVC dữ liệu của primary; khôi phục physical chỉ hợp lệ với instance mới
+func (br *BackupReconciler) preparePrimaryDataPVC(ctx context.Context, ms *musicv1.MusicService) error {
+	stsName := ms.Name + "-db-master"
+	if ms.Spec.Database.HighAvailability != nil && ms.Spec.Database.HighAvailability.Enabled {
+		stsName = ms.Name + "-db-galera"
+	}
+	sts := &appsv1.StatefulSet{}
+	err := br.client.Get(ctx, types.NamespacedName{Name: stsName, Namespace: builder.WorkloadNamespace(ms)}, sts)
+	if err == nil {
+		return fmt.Errorf("physical init restore requires a fresh instance but StatefulSet %s already exists", stsName)
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	desired := br.builder.BuildDatabasePrimaryDataPVC(ms)
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err = br.client.Get(ctx, client.ObjectKeyFromObject(desired), pvc)
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	return br.client.Create(ctx, desired)
+}
+
+func (br *BackupReconciler) reconcilePVC(ctx context.Context, ms *musicv1.MusicService) error {
+	log := br.formatter.Logger(ctx, ms, "backup")
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	pvcName := types.NamespacedName{Name: builder.BackupName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	err := br.client.Get(ctx, pvcName, pvc)
+	if err != nil && errors.IsNotFound(err) {
+		pvc = br.builder.BuildDatabaseBackupPVC(ms)
+		log.Info(br.formatter.Format(ms, "Creating backup PVC"), "PVC", pvcName.Name)
+		return br.client.Create(ctx, pvc)
+	} else if err != nil {
+		return err
+	}
+
+	// Chỉ hỗ trợ mở rộng, không thu nhỏ PVC sao lưu
+	desired := br.builder.BuildDatabaseBackupPVC(ms)
+	desiredSize := desired.Spec.Resources.Requests[corev1.ResourceStorage]
+	currentSize, hasCurrent := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if hasCurrent && currentSize.Cmp(desiredSize) < 0 {
+		log.Info(br.formatter.Format(ms, "Expanding backup PVC"), "PVC", pvcName.Name, "size", desiredSize.String())
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desiredSize
+		return br.client.Update(ctx, pvc)
+	}
+
+	return nil
+}
+
+func (br *BackupReconciler) reconcileCronJob(ctx context.Context, ms *musicv1.MusicService) error {
+	log := br.formatter.Logger(ctx, ms, "backup")
+
+	cronJob := &batchv1.CronJob{}
+	cronJobName := types.NamespacedName{Name: builder.BackupName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	err := br.client.Get(ctx, cronJobName, cronJob)
+	if err != nil && errors.IsNotFound(err) {
+		cronJob = br.builder.BuildDatabaseBackupCronJob(ms)
+		log.Info(br.formatter.Format(ms, "Creating backup CronJob"), "CronJob", cronJobName.Name)
+		return br.client.Create(ctx, cronJob)
+	} else if err != nil {
+		return err
+	}
+
+	desired := br.builder.BuildDatabaseBackupCronJob(ms)
+	if cronJobNeedsUpdate(cronJob, desired) {
+		log.Info(br.formatter.Format(ms, "Updating backup CronJob"), "CronJob", cronJobName.Name)
+		cronJob.Spec = desired.Spec
+		return br.client.Update(ctx, cronJob)
+	}
+
+	return nil
+}
+
+func (br *BackupReconciler) deleteCronJobIfExists(ctx context.Context, ms *musicv1.MusicService) error {
+	cronJob := &batchv1.CronJob{}
+	cronJobName := types.NamespacedName{Name: builder.BackupName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	err := br.client.Get(ctx, cronJobName, cronJob)
+	if err != nil && errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return br.client.Delete(ctx, cronJob, client.PropagationPolicy("Background"))
+}
+
+// cronJobNeedsUpdate kiểm tra xem lịch hoặc pod template của CronJob có thay đổi không
+func cronJobNeedsUpdate(current, desired *batchv1.CronJob) bool {
+	if current.Spec.Schedule != desired.Spec.Schedule {
+		return true
+	}
+	if !reflect.DeepEqual(current.Spec.TimeZone, desired.Spec.TimeZone) {
+		return true
+	}
+
+	currentPod := current.Spec.JobTemplate.Spec.Template.Spec
+	desiredPod := desired.Spec.JobTemplate.Spec.Template.Spec
+	if !reflect.DeepEqual(currentPod.Volumes, desiredPod.Volumes) {
+		return true
+	}
+	if len(currentPod.InitContainers) != len(desiredPod.InitContainers) || len(currentPod.Containers) != len(desiredPod.Containers) {
+		return true
+	}
+	currentContainers := append(currentPod.InitContainers, currentPod.Containers...)
+	desiredContainers := append(desiredPod.InitContainers, desiredPod.Containers...)
+	for i := range currentContainers {
+		if currentContainers[i].Image != desiredContainers[i].Image {
+			return true
+		}
+		if !reflect.DeepEqual(currentContainers[i].Command, desiredContainers[i].Command) {
+			return true
+		}
+		if !reflect.DeepEqual(currentContainers[i].Env, desiredContainers[i].Env) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backupEnabled cho biết ms bật sao lưu và engine của ms hỗ trợ sao lưu; Job sao lưu/khôi phục dùng
+// mysqldump/mariabackup nên không được dựng cho engine khác dù spec lọt qua webhook
+func backupEnabled(b *builder.ResourceBuilder, ms *musicv1.MusicService) bool {
+	return ms.Spec.Database != nil &&
+		ms.Spec.Database.Enabled &&
+		ms.Spec.Database.Backup != nil &&
+		ms.Spec.Database.Backup.Enabled &&
+		b.DatabaseProvider(ms).Features().Backup
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ComponentsReconciler tổng hợp trạng thái các tài nguyên con vào status.components.
+// Danh sách thành phần dựng từ builder theo spec hiện tại; mỗi tài nguyên được đọc lại từ cluster
+// và đánh giá theo kind nên thêm thành phần mới chỉ cần thêm một dòng vào expectedComponents
+type ComponentsReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+}
+
+// NewComponentsReconciler tạo một reconciler mới cho status.components
+func NewComponentsReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter) *ComponentsReconciler {
+	return &ComponentsReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+	}
+}
+
+// Reconcile ghi status.components; thành phần chưa được tạo được báo Ready=false thay vì trả lỗi
+func (cr *ComponentsReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	components := map[string]musicv1.ComponentStatus{}
+	for name, desired := range cr.expectedComponents(ms) {
+		kind := desired.GetObjectKind().GroupVersionKind().Kind
+		status := musicv1.ComponentStatus{Kind: kind, Name: desired.GetName()}
+
+		current := reflect.New(reflect.TypeOf(desired).Elem()).Interface().(client.Object)
+		err := cr.client.Get(ctx, client.ObjectKeyFromObject(desired), current)
+		switch {
+		case errors.IsNotFound(err):
+			status.Message = "not created yet"
+		case err != nil:
+			return err
+		default:
+			status.Ready, status.Message = componentReadiness(current)
+		}
+		components[name] = status
+	}
+
+	ms.Status.Components = components
+	return nil
+}
+
+// expectedComponents trả về các tài nguyên con mà spec hiện tại yêu cầu, với kind đã được gán
+func (cr *ComponentsReconciler) expectedComponents(ms *musicv1.MusicService) map[string]client.Object {
+	components := map[string]client.Object{
+		"service": withKind(cr.builder.BuildAppService(ms), "Service"),
+	}
+	if builder.ShardsEnabled(ms) {
+		for i, shard := range ms.Spec.Shards {
+			components["app-"+shard.Name] = withKind(cr.builder.BuildAppShardStatefulSet(ms, i), "StatefulSet")
+			components["service-"+shard.Name] = withKind(cr.builder.BuildAppShardService(ms, i), "Service")
+			if builder.AutoscalingEnabled(shard.Autoscaling) {
+				components["hpa-"+shard.Name] = withKind(cr.builder.BuildAppShardAutoscaler(ms, i), "HorizontalPodAutoscaler")
+			}
+		}
+	} else if builder.AppUsesDeployment(ms) {
+		components["app"] = withKind(cr.builder.BuildAppDeployment(ms), "Deployment")
+	} else {
+		components["app"] = withKind(cr.builder.BuildAppStatefulSet(ms), "StatefulSet")
+	}
+	if builder.AutoscalingEnabled(ms.Spec.Autoscaling) {
+		components["hpa"] = withKind(cr.builder.BuildAutoscaler(ms), "HorizontalPodAutoscaler")
+	}
+
+	if builder.QueueManaged(ms) {
+		components["queue"] = withKind(cr.builder.BuildQueueStatefulSet(ms), "StatefulSet")
+	}
+	if ms.Spec.Search != nil {
+		components["search"] = withKind(cr.builder.BuildSearchStatefulSet(ms), "StatefulSet")
+	}
+	if ms.Spec.SessionStore != nil {
+		components["session-store"] = withKind(cr.builder.BuildSessionStoreStatefulSet(ms), "StatefulSet")
+	}
+	if ms.Spec.ObjectStorage != nil {
+		components["object-storage"] = withKind(cr.builder.BuildObjectStorageStatefulSet(ms), "StatefulSet")
+	}
+
+	db := ms.Spec.Database
+	if db == nil || !db.Enabled {
+		return components
+	}
+	if db.HighAvailability != nil && db.HighAvailability.Enabled {
+		components["db-galera"] = withKind(cr.builder.BuildDatabaseGaleraStatefulSet(ms), "StatefulSet")
+	} else {
+		components["db-master"] = withKind(cr.builder.BuildDatabaseMasterStatefulSet(ms), "StatefulSet")
+		if db.Replicas > 0 {
+			components["db-replica"] = withKind(cr.builder.BuildDatabaseReplicaStatefulSet(ms), "StatefulSet")
+			if builder.AutoscalingEnabled(db.Autoscaling) {
+				components["db-hpa"] = withKind(cr.builder.BuildDatabaseReplicaAutoscaler(ms), "HorizontalPodAutoscaler")
+			}
+		}
+	}
+	if backupEnabled(cr.builder, ms) {
+		components["backup"] = withKind(cr.builder.BuildDatabaseBackupCronJob(ms), "CronJob")
+	}
+	return components
+}
+
+// withKind gán kind cho object do builder dựng (builder không điền TypeMeta)
+func withKind(obj client.Object, kind string) client.Object {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	gvk.Kind = kind
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return obj
+}
+
+// componentReadiness đánh giá một tài nguyên con theo kind của nó
+func componentReadiness(obj client.Object) (bool, string) {
+	switch o := obj.(type) {
+	case *appsv1.StatefulSet:
+		desired := int32(1)
+		if o.Spec.Replicas != nil {
+			desired = *o.Spec.Replicas
+		}
+		if o.Status.ObservedGeneration < o.Generation {
+			return false, "rollout pending"
+		}
+		return o.Status.ReadyReplicas >= desired, fmt.Sprintf("%d/%d replicas ready", o.Status.ReadyReplicas, desired)
+	case *appsv1.Deployment:
+		desired := int32(1)
+		if o.Spec.Replicas != nil {
+			desired = *o.Spec.Replicas
+		}
+		if o.Status.ObservedGeneration < o.Generation {
+			return false, "rollout pending"
+		}
+		return o.Status.AvailableReplicas >= desired, fmt.Sprintf("%d/%d replicas available", o.Status.AvailableReplicas, desired)
+	case *corev1.Service:
+		if o.Spec.Type == corev1.ServiceTypeLoadBalancer && len(o.Status.LoadBalancer.Ingress) == 0 {
+			return false, "waiting for a load balancer address"
+		}
+		return true, string(o.Spec.Type)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		for _, cond := range o.Status.Conditions {
+			if cond.Type == autoscalingv2.ScalingActive && cond.Status != corev1.ConditionTrue {
+				return false, cond.Message
+			}
+		}
+		return true, fmt.Sprintf("%d current, %d desired replicas", o.Status.CurrentReplicas, o.Status.DesiredReplicas)
+	case *batchv1.CronJob:
+		if o.Spec.Suspend != nil && *o.Spec.Suspend {
+			return false, "suspended"
+		}
+		if o.Status.LastSuccessfulTime == nil {
+			return true, "no successful run yet"
+		}
+		return true, fmt.Sprintf("last successful run at %s", o.Status.LastSuccessfulTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return true, ""
+}
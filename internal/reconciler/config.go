@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// appConfigChecksums đọc ConfigMap cấu hình và tính checksum khởi động lại / nạp lại.
+// ConfigMap chưa tồn tại trả về checksum rỗng: pod chờ volume ở ContainerCreating và
+// việc tạo ConfigMap sẽ kích hoạt reconcile lại qua watch của controller
+func (ar *AppReconciler) appConfigChecksums(ctx context.Context, ms *musicv1.MusicService) (string, string, error) {
+	name := builder.AppConfigMapName(ms)
+	if name == "" {
+		return "", "", nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := ar.client.Get(ctx, types.NamespacedName{Name: name, Namespace: builder.WorkloadNamespace(ms)}, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return "", "", nil
+		}
+		return "", "", err
+	}
+	restart, reload := builder.ConfigChecksums(ms, cm)
+	return restart, reload, nil
+}
+
+// applyConfigChecksum ghi checksum các key cần khởi động lại lên pod template; key nạp lại được
+// không nằm trong checksum nên thay đổi chúng không tạo revision mới
+func (ar *AppReconciler) applyConfigChecksum(ctx context.Context, ms *musicv1.MusicService, template *corev1.PodTemplateSpec) error {
+	restart, _, err := ar.appConfigChecksums(ctx, ms)
+	if err != nil || restart == "" {
+		return err
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[builder.ConfigChecksumAnnotation] = restart
+	return nil
+}
+
+// ReconcileConfigReload ghi checksum các key nạp lại được lên từng pod ứng dụng và read pool;
+// sidecar config-reloader đọc annotation qua downward API và gửi tín hiệu khi file đã cập nhật,
+// nên pod không khởi động lại và các phiên đang phát được giữ nguyên
+func (ar *AppReconciler) ReconcileConfigReload(ctx context.Context, ms *musicv1.MusicService) error {
+	_, reload, err := ar.appConfigChecksums(ctx, ms)
+	if err != nil || reload == "" {
+		return err
+	}
+	log := ar.formatter.Logger(ctx, ms, "config")
+
+	for _, component := range []string{"music-service", "read-pool"} {
+		pods := &corev1.PodList{}
+		if err := ar.client.List(ctx, pods, client.InNamespace(builder.WorkloadNamespace(ms)),
+			client.MatchingLabels{"app": ms.Name, "component": component}); err != nil {
+			return err
+		}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if pod.DeletionTimestamp != nil || pod.Annotations[builder.ConfigReloadChecksumAnnotation] == reload {
+				continue
+			}
+			patch := client.MergeFrom(pod.DeepCopy())
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[builder.ConfigReloadChecksumAnnotation] = reload
+			log.Info(ar.formatter.Format(ms, "Requesting config reload"), "pod", pod.Name, "checksum", reload)
+			if err := ar.client.Patch(ctx, pod, patch); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
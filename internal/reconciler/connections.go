@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/appmetrics"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+const (
+	// defaultConnectionMetricsPath khớp với giá trị mặc định của connectionMetrics.path trong CRD
+	defaultConnectionMetricsPath = "/metrics"
+	// defaultConnectionMetricName khớp với giá trị mặc định của connectionMetrics.metricName trong CRD
+	defaultConnectionMetricName = "music_streaming_active_connections"
+)
+
+// ConnectionsReconciler đọc số kết nối streaming đang mở từ endpoint metrics của các pod ứng dụng
+// và ghi status.totalActiveConnections, status.connectionsPerReplica, status.lastUsedTime
+type ConnectionsReconciler struct {
+	client    client.Client
+	formatter *tone.Formatter
+	scraper   *appmetrics.Scraper
+}
+
+// NewConnectionsReconciler tạo một reconciler mới cho số kết nối; scraper nil nghĩa là không đọc được metrics
+func NewConnectionsReconciler(c client.Client, f *tone.Formatter, scraper *appmetrics.Scraper) *ConnectionsReconciler {
+	return &ConnectionsReconciler{
+		client:    c,
+		formatter: f,
+		scraper:   scraper,
+	}
+}
+
+// Reconcile cộng metric số kết nối của mọi pod ứng dụng đang sẵn sàng.
+// Lỗi khi đọc metrics chỉ được ghi log và giữ nguyên status để không chặn các bước reconcile còn lại
+func (cr *ConnectionsReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	log := cr.formatter.Logger(ctx, ms, "connections")
+
+	spec := ms.Spec.ConnectionMetrics
+	if spec == nil {
+		ms.Status.TotalActiveConnections = nil
+		ms.Status.ConnectionsPerReplica = nil
+		return nil
+	}
+
+	path := spec.Path
+	if path == "" {
+		path = defaultConnectionMetricsPath
+	}
+	metric := spec.MetricName
+	if metric == "" {
+		metric = defaultConnectionMetricName
+	}
+	port := spec.Port
+	if port == 0 {
+		port = builder.AppContainerPort(ms)
+	}
+
+	namespace := builder.WorkloadNamespace(ms)
+	pods := &corev1.PodList{}
+	if err := cr.client.List(ctx, pods, client.InNamespace(namespace),
+		client.MatchingLabels{"app": ms.Name, "component": "music-service"}); err != nil {
+		return err
+	}
+
+	var total int64
+	var ready, scraped int64
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !podServing(pod) {
+			continue
+		}
+		ready++
+		value, found, err := cr.scraper.Scrape(ctx, namespace, pod.Name, port, path, metric)
+		if err != nil {
+			log.Error(err, "failed to scrape connection metrics", "pod", pod.Name)
+			return nil
+		}
+		if !found {
+			continue
+		}
+		total += int64(value)
+		scraped++
+	}
+
+	// Pod sẵn sàng nhưng không có metric: sai cấu hình hoặc image không hỗ trợ, không báo 0 gây hiểu nhầm
+	if ready > 0 && scraped == 0 {
+		log.Info("app pods do not expose the connection metric", "metric", metric, "path", path)
+		ms.Status.TotalActiveConnections = nil
+		ms.Status.ConnectionsPerReplica = nil
+		return nil
+	}
+
+	var perReplica int64
+	if scraped > 0 {
+		perReplica = total / scraped
+	}
+	if total > 0 {
+		now := metav1.Now()
+		ms.Status.LastUsedTime = &now
+	}
+	ms.Status.TotalActiveConnections = &total
+	ms.Status.ConnectionsPerReplica = &perReplica
+	return nil
+}
+
+// podServing cho biết pod đang chạy, chưa bị xóa và đã vượt qua readiness probe
+func podServing(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// previousReplicationUserAnnotation trên Secret replication giữ user cũ cho tới khi bước Retiring xóa nó,
+// để lần xoay vòng bị gián đoạn trước khi status được lưu vẫn xóa đúng user
+const previousReplicationUserAnnotation = "music.mixcorp.org/previous-replication-user"
+
+// ReconcileCredentialRotation xoay vòng user replication khi annotation rotate-replication-credentials đổi giá trị
+// hoặc đã quá database.replication.credentialRotationInterval: tạo user mới trên master, khởi động lại lần lượt
+// từng replica để chạy lại CHANGE MASTER với user mới, rồi xóa user cũ.
+// Trả về true khi việc xoay vòng còn đang chạy để controller requeue
+func (dr *DatabaseReconciler) ReconcileCredentialRotation(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	if !replicationEnabled(ms) || ms.Spec.Database.Replicas == 0 {
+		return false, nil
+	}
+	if !dr.builder.DatabaseProvider(ms).Features().CredentialRotation {
+		return false, nil
+	}
+	if ms.Status.Database == nil {
+		ms.Status.Database = &musicv1.DatabaseStatus{}
+	}
+	rotation := ms.Status.Database.ReplicationCredentials
+	if rotation == nil {
+		rotation = &musicv1.ReplicationCredentialsStatus{}
+		ms.Status.Database.ReplicationCredentials = rotation
+	}
+
+	secret := &corev1.Secret{}
+	secretName := types.NamespacedName{Name: ms.Name + "-db-replication", Namespace: builder.WorkloadNamespace(ms)}
+	if err := dr.client.Get(ctx, secretName, secret); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if rotation.User == "" {
+		rotation.User = string(secret.Data["username"])
+	}
+
+	switch rotation.Phase {
+	case "Granting":
+		done, err := dr.runCredentialsJob(ctx, ms, builder.CredentialsStepGrant, "")
+		if err != nil || !done {
+			return true, err
+		}
+		rotation.Phase = "Rolling"
+		rotation.Message = "Restarting DB replicas one at a time with the new replication user"
+		return true, dr.rollReplicas(ctx, ms, rotation.User)
+
+	case "Rolling":
+		sts := &appsv1.StatefulSet{}
+		if err := dr.client.Get(ctx, types.NamespacedName{Name: builder.DatabaseReplicaName(ms), Namespace: secretName.Namespace}, sts); err != nil {
+			return true, err
+		}
+		if sts.Spec.Template.Annotations[builder.ReplicationUserAnnotation] != rotation.User {
+			return true, dr.rollReplicas(ctx, ms, rotation.User)
+		}
+		if !statefulSetRolledOut(sts) {
+			return true, nil
+		}
+		rotation.Phase = "Retiring"
+		rotation.Message = fmt.Sprintf("Dropping previous replication user %s", rotation.PreviousUser)
+		return true, nil
+
+	case "Retiring":
+		if rotation.PreviousUser != "" && rotation.PreviousUser != rotation.User {
+			done, err := dr.runCredentialsJob(ctx, ms, builder.CredentialsStepRetire, rotation.PreviousUser)
+			if err != nil || !done {
+				return true, err
+			}
+		}
+		if _, ok := secret.Annotations[previousReplicationUserAnnotation]; ok {
+			delete(secret.Annotations, previousReplicationUserAnnotation)
+			if err := dr.client.Update(ctx, secret); err != nil {
+				return true, err
+			}
+		}
+		rotation.Phase = "Completed"
+		rotation.LastRotationTime = &metav1.Time{Time: time.Now()}
+		rotation.Message = fmt.Sprintf("Replication user rotated from %s to %s", rotation.PreviousUser, rotation.User)
+		dr.recordCredentialChange(ms, corev1.EventTypeNormal, "Rotated",
+			fmt.Sprintf("Rotated replication user in Secret %s from %s to %s", secretName.Name, rotation.PreviousUser, rotation.User))
+		return false, nil
+	}
+
+	trigger := ms.Annotations[musicv1.RotateReplicationCredentialsAnnotation]
+	due := trigger != "" && trigger != rotation.LastTrigger
+	if replication := ms.Spec.Database.Replication; !due && replication != nil && replication.CredentialRotationInterval != nil {
+		since := secret.CreationTimestamp.Time
+		if rotation.LastRotationTime != nil {
+			since = rotation.LastRotationTime.Time
+		}
+		due = time.Since(since) >= replication.CredentialRotationInterval.Duration
+	}
+	if !due {
+		return false, nil
+	}
+	return true, dr.startCredentialRotation(ctx, ms, secret, rotation, trigger)
+}
+
+// startCredentialRotation ghi user và mật khẩu mới vào Secret replication rồi chuyển sang bước Granting.
+// Replica đang chạy vẫn dùng user cũ vì biến môi trường chỉ được đọc lại khi pod khởi động
+func (dr *DatabaseReconciler) startCredentialRotation(ctx context.Context, ms *musicv1.MusicService, secret *corev1.Secret,
+	rotation *musicv1.ReplicationCredentialsStatus, trigger string) error {
+	suffix, err := generatePassword(4)
+	if err != nil {
+		return err
+	}
+	password, err := generatePassword(16)
+	if err != nil {
+		return err
+	}
+
+	previous := secret.Annotations[previousReplicationUserAnnotation]
+	if previous == "" {
+		previous = string(secret.Data["username"])
+	}
+	user := "repl_" + suffix
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data["username"] = []byte(user)
+	secret.Data["password"] = []byte(password)
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[previousReplicationUserAnnotation] = previous
+	secret.Annotations[credentialsHashAnnotation] = credentialsHash(secret.Data)
+	if err := dr.client.Update(ctx, secret); err != nil {
+		return err
+	}
+
+	dr.formatter.Logger(ctx, ms, "database").Info(dr.formatter.Format(ms, "Rotating replication credentials"),
+		"previousUser", previous, "user", user)
+	rotation.Phase = "Granting"
+	rotation.User = user
+	rotation.PreviousUser = previous
+	rotation.StartedAt = &metav1.Time{Time: time.Now()}
+	rotation.LastTrigger = trigger
+	rotation.Message = fmt.Sprintf("Creating replication user %s on the master", user)
+	return nil
+}
+
+// runCredentialsJob chạy Job của một bước xoay vòng và trả về true khi Job đã thành công (Job được xóa ngay sau đó)
+func (dr *DatabaseReconciler) runCredentialsJob(ctx context.Context, ms *musicv1.MusicService, step, previousUser string) (bool, error) {
+	desired := dr.builder.BuildReplicationCredentialsJob(ms, step, previousUser)
+	job := &batchv1.Job{}
+	err := dr.client.Get(ctx, client.ObjectKeyFromObject(desired), job)
+	if errors.IsNotFound(err) {
+		return false, dr.client.Create(ctx, desired)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if job.Status.Failed > 0 && job.Status.Active == 0 && job.Status.Succeeded == 0 {
+		return false, fmt.Errorf("credential rotation Job %s failed; delete it to retry", job.Name)
+	}
+	if job.Status.Succeeded == 0 {
+		return false, nil
+	}
+	return true, client.IgnoreNotFound(dr.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
+
+// rollReplicas ghi user mới lên pod template của replica; StatefulSet khởi động lại từng pod một
+// và pod chỉ sẵn sàng khi replication với user mới đã chạy nên lỗi đăng nhập sẽ dừng việc cập nhật
+func (dr *DatabaseReconciler) rollReplicas(ctx context.Context, ms *musicv1.MusicService, user string) error {
+	sts := &appsv1.StatefulSet{}
+	if err := dr.client.Get(ctx, types.NamespacedName{Name: builder.DatabaseReplicaName(ms), Namespace: builder.WorkloadNamespace(ms)}, sts); err != nil {
+		return err
+	}
+	if sts.Spec.Template.Annotations == nil {
+		sts.Spec.Template.Annotations = map[string]string{}
+	}
+	sts.Spec.Template.Annotations[builder.ReplicationUserAnnotation] = user
+	return dr.client.Update(ctx, sts)
+}
+
+// statefulSetRolledOut báo StatefulSet đã cập nhật và sẵn sàng toàn bộ pod theo revision mới nhất
+func statefulSetRolledOut(sts *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	return sts.Status.ObservedGeneration >= sts.Generation &&
+		sts.Status.CurrentRevision == sts.Status.UpdateRevision &&
+		sts.Status.UpdatedReplicas == replicas &&
+		sts.Status.ReadyReplicas == replicas
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ nội dung dashboard, xem pkg/builder/dashboard.go.
+// - Việc sinh dashboard được bật bằng cờ --grafana-dashboards trong cmd/main.go.
+
+// DashboardReconciler xử lý ConfigMap dashboard Grafana của từng MusicService
+type DashboardReconciler struct {
+	client     client.Client
+	builder    *builder.ResourceBuilder
+	formatter  *tone.Formatter
+	labelKey   string
+	labelValue string
+}
+
+// NewDashboardReconciler tạo một reconciler mới cho dashboard; labelKey rỗng nghĩa là tắt tính năng
+func NewDashboardReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter, labelKey, labelValue string) *DashboardReconciler {
+	return &DashboardReconciler{
+		client:     c,
+		builder:    b,
+		formatter:  f,
+		labelKey:   labelKey,
+		labelValue: labelValue,
+	}
+}
+
+// Reconcile đồng bộ ConfigMap dashboard; khi tính năng bị tắt thì xóa ConfigMap đã sinh trước đó
+func (dr *DashboardReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	log := dr.formatter.Logger(ctx, ms, "dashboard")
+
+	configMap := &corev1.ConfigMap{}
+	name := types.NamespacedName{Name: builder.DashboardConfigMapName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	if dr.labelKey == "" {
+		return deleteObjectIfExists(ctx, dr.client, name, configMap)
+	}
+
+	desired, err := dr.builder.BuildDashboardConfigMap(ms, dr.labelKey, dr.labelValue)
+	if err != nil {
+		return err
+	}
+
+	err = dr.client.Get(ctx, name, configMap)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(dr.formatter.Format(ms, "Creating Grafana dashboard ConfigMap"), "ConfigMap", name.Name)
+		return dr.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(configMap.Data, desired.Data) || !reflect.DeepEqual(configMap.Labels, desired.Labels) {
+		log.Info(dr.formatter.Format(ms, "Updating Grafana dashboard ConfigMap"), "ConfigMap", name.Name)
+		configMap.Data = desired.Data
+		configMap.Labels = desired.Labels
+		return dr.client.Update(ctx, configMap)
+	}
+
+	return nil
+}
@@ -20,47 +20,56 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
+	"hash/fnv"
 	"reflect"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
-	"github.com/example/managedapp-operator/internal/builder"
 	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
 )
 
+// credentialsHashAnnotation records a hash of the replication credentials last seen by the operator,
+// so a password changed outside the operator is reported as a rotation
+const credentialsHashAnnotation = "music.mixcorp.org/credentials-hash"
+
 // DatabaseReconciler handles reconciliation of database StatefulSets and Services
 type DatabaseReconciler struct {
 	client    client.Client
 	builder   *builder.ResourceBuilder
 	formatter *tone.Formatter
+	recorder  record.EventRecorder
 }
 
-// NewDatabaseReconciler creates a new database reconciler
-func NewDatabaseReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter) *DatabaseReconciler {
+// NewDatabaseReconciler creates a new database reconciler; r receives the credential lifecycle Events
+func NewDatabaseReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter, r record.EventRecorder) *DatabaseReconciler {
 	return &DatabaseReconciler{
 		client:    c,
 		builder:   b,
 		formatter: f,
+		recorder:  r,
 	}
 }
 
 // ReconcileGalera reconciles the Galera Cluster StatefulSet and Services
 // Khi HA được bật, tất cả các node ngang hàng; node chết sẽ không gây gián đoạn dịch vụ
 func (dr *DatabaseReconciler) ReconcileGalera(ctx context.Context, ms *musicv1.MusicService) error {
-	log := log.FromContext(ctx)
+	log := dr.formatter.Logger(ctx, ms, "database")
 
 	sts := &appsv1.StatefulSet{}
 	stsName := types.NamespacedName{
 		Name:      ms.Name + "-db-galera",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 
 	err := dr.client.Get(ctx, stsName, sts)
@@ -78,17 +87,21 @@ func (dr *DatabaseReconciler) ReconcileGalera(ctx context.Context, ms *musicv1.M
 	if storageChanged {
 		policy := storageUpdatePolicy(databaseStorageSpec(ms))
 		if policy == musicv1.StorageUpdatePolicyRecreate {
-			log.Info("Recreating Galera StatefulSet and PVCs due to storage size change", "StatefulSet", stsName.Name)
-			return recreateStatefulSetStorage(ctx, dr.client, sts, "db-data", ms.Name+"-db-galera")
+			log.Info(dr.formatter.Format(ms, "Recreating Galera StatefulSet and PVCs due to storage size change"), "StatefulSet", stsName.Name)
+			return recreateStorage(ctx, dr.client, dr.builder, ms, sts, "db-data", ms.Name+"-db-galera", ms.Spec.Database.Storage)
 		}
 		if err := resizePVCs(ctx, dr.client, "db-data", ms.Name+"-db-galera", desiredSts); err != nil {
 			return err
 		}
 	}
 
+	if err := syncPVCMetadata(ctx, dr.client, "db-data", ms.Name+"-db-galera", desiredSts); err != nil {
+		return err
+	}
+
 	if statefulSetNeedsUpdate(sts, desiredSts) {
-		log.Info("Updating Galera StatefulSet", "StatefulSet", stsName.Name)
-		sts.Spec = desiredSts.Spec
+		log.Info(dr.formatter.Format(ms, "Updating Galera StatefulSet"), "StatefulSet", stsName.Name)
+		updateStatefulSetSpec(sts, desiredSts)
 		return dr.client.Update(ctx, sts)
 	}
 
@@ -101,7 +114,7 @@ func (dr *DatabaseReconciler) ReconcileGaleraServices(ctx context.Context, ms *m
 	galeraHLSvc := &corev1.Service{}
 	galeraHLSvcName := types.NamespacedName{
 		Name:      ms.Name + "-db-galera",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 	if err := dr.client.Get(ctx, galeraHLSvcName, galeraHLSvc); err != nil {
 		if !errors.IsNotFound(err) {
@@ -117,7 +130,7 @@ func (dr *DatabaseReconciler) ReconcileGaleraServices(ctx context.Context, ms *m
 	primarySvc := &corev1.Service{}
 	primarySvcName := types.NamespacedName{
 		Name:      ms.Name + "-db-master",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 	if err := dr.client.Get(ctx, primarySvcName, primarySvc); err != nil {
 		if !errors.IsNotFound(err) {
@@ -133,7 +146,7 @@ func (dr *DatabaseReconciler) ReconcileGaleraServices(ctx context.Context, ms *m
 	readSvc := &corev1.Service{}
 	readSvcName := types.NamespacedName{
 		Name:      ms.Name + "-db-read",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 	if err := dr.client.Get(ctx, readSvcName, readSvc); err != nil {
 		if !errors.IsNotFound(err) {
@@ -143,17 +156,21 @@ func (dr *DatabaseReconciler) ReconcileGaleraServices(ctx context.Context, ms *m
 		return dr.client.Create(ctx, readSvc)
 	}
 
+	if syncServiceRouting(readSvc, dr.builder.BuildDatabaseGaleraReadService(ms)) {
+		return dr.client.Update(ctx, readSvc)
+	}
+
 	return nil
 }
 
 // ReconcileMaster reconciles the database master StatefulSet
 func (dr *DatabaseReconciler) ReconcileMaster(ctx context.Context, ms *musicv1.MusicService) error {
-	log := log.FromContext(ctx)
+	log := dr.formatter.Logger(ctx, ms, "database")
 
 	sts := &appsv1.StatefulSet{}
 	stsName := types.NamespacedName{
 		Name:      ms.Name + "-db-master",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 
 	err := dr.client.Get(ctx, stsName, sts)
@@ -171,17 +188,21 @@ func (dr *DatabaseReconciler) ReconcileMaster(ctx context.Context, ms *musicv1.M
 	if storageChanged {
 		policy := storageUpdatePolicy(databaseStorageSpec(ms))
 		if policy == musicv1.StorageUpdatePolicyRecreate {
-			log.Info("Recreating DB master StatefulSet and PVCs due to storage size change", "StatefulSet", stsName.Name)
-			return recreateStatefulSetStorage(ctx, dr.client, sts, "db-data", ms.Name+"-db-master")
+			log.Info(dr.formatter.Format(ms, "Recreating DB master StatefulSet and PVCs due to storage size change"), "StatefulSet", stsName.Name)
+			return recreateStorage(ctx, dr.client, dr.builder, ms, sts, "db-data", ms.Name+"-db-master", ms.Spec.Database.Storage)
 		}
 		if err := resizePVCs(ctx, dr.client, "db-data", ms.Name+"-db-master", desiredSts); err != nil {
 			return err
 		}
 	}
 
+	if err := syncPVCMetadata(ctx, dr.client, "db-data", ms.Name+"-db-master", desiredSts); err != nil {
+		return err
+	}
+
 	if statefulSetNeedsUpdate(sts, desiredSts) {
-		log.Info("Updating DB master StatefulSet", "StatefulSet", stsName.Name)
-		sts.Spec = desiredSts.Spec
+		log.Info(dr.formatter.Format(ms, "Updating DB master StatefulSet"), "StatefulSet", stsName.Name)
+		updateStatefulSetSpec(sts, desiredSts)
 		return dr.client.Update(ctx, sts)
 	}
 
@@ -198,12 +219,12 @@ func (dr *DatabaseReconciler) ReconcileReplicas(ctx context.Context, ms *musicv1
 		return err
 	}
 
-	log := log.FromContext(ctx)
+	log := dr.formatter.Logger(ctx, ms, "database")
 
 	sts := &appsv1.StatefulSet{}
 	stsName := types.NamespacedName{
 		Name:      ms.Name + "-db-replica",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 
 	err := dr.client.Get(ctx, stsName, sts)
@@ -221,17 +242,21 @@ func (dr *DatabaseReconciler) ReconcileReplicas(ctx context.Context, ms *musicv1
 	if storageChanged {
 		policy := storageUpdatePolicy(databaseStorageSpec(ms))
 		if policy == musicv1.StorageUpdatePolicyRecreate {
-			log.Info("Recreating DB replica StatefulSet and PVCs due to storage size change", "StatefulSet", stsName.Name)
-			return recreateStatefulSetStorage(ctx, dr.client, sts, "db-data", ms.Name+"-db-replica")
+			log.Info(dr.formatter.Format(ms, "Recreating DB replica StatefulSet and PVCs due to storage size change"), "StatefulSet", stsName.Name)
+			return recreateStorage(ctx, dr.client, dr.builder, ms, sts, "db-data", ms.Name+"-db-replica", ms.Spec.Database.Storage)
 		}
 		if err := resizePVCs(ctx, dr.client, "db-data", ms.Name+"-db-replica", desiredSts); err != nil {
 			return err
 		}
 	}
 
+	if err := syncPVCMetadata(ctx, dr.client, "db-data", ms.Name+"-db-replica", desiredSts); err != nil {
+		return err
+	}
+
 	if statefulSetNeedsUpdate(sts, desiredSts) {
-		log.Info("Updating DB replica StatefulSet", "StatefulSet", stsName.Name)
-		sts.Spec = desiredSts.Spec
+		log.Info(dr.formatter.Format(ms, "Updating DB replica StatefulSet"), "StatefulSet", stsName.Name)
+		updateStatefulSetSpec(sts, desiredSts)
 		return dr.client.Update(ctx, sts)
 	}
 
@@ -243,7 +268,7 @@ func (dr *DatabaseReconciler) ReconcileServices(ctx context.Context, ms *musicv1
 	masterSvc := &corev1.Service{}
 	masterSvcName := types.NamespacedName{
 		Name:      ms.Name + "-db-master",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 
 	err := dr.client.Get(ctx, masterSvcName, masterSvc)
@@ -256,34 +281,66 @@ func (dr *DatabaseReconciler) ReconcileServices(ctx context.Context, ms *musicv1
 		return err
 	}
 
-	// Service đọc (dành cho replica)
-	if ms.Spec.Database.Replicas > 0 {
-		readSvc := &corev1.Service{}
-		readSvcName := types.NamespacedName{
-			Name:      ms.Name + "-db-read",
-			Namespace: ms.Namespace,
+	// Service đọc (dành cho replica, hoặc master khi bật readFallbackToMaster)
+	if ms.Spec.Database.Replicas > 0 || ms.Spec.Database.ReadFallbackToMaster {
+		desired := dr.builder.BuildDatabaseReadService(ms)
+		if ms.Spec.Database.ReadFallbackToMaster {
+			ready, err := dr.replicasReady(ctx, ms)
+			if err != nil {
+				return err
+			}
+			if !ready {
+				desired = dr.builder.BuildDatabaseReadFallbackService(ms)
+			}
 		}
 
-		err := dr.client.Get(ctx, readSvcName, readSvc)
+		readSvc := &corev1.Service{}
+		err := dr.client.Get(ctx, client.ObjectKeyFromObject(desired), readSvc)
 		if err != nil && errors.IsNotFound(err) {
-			readSvc = dr.builder.BuildDatabaseReadService(ms)
-			return dr.client.Create(ctx, readSvc)
+			return dr.client.Create(ctx, desired)
+		} else if err != nil {
+			return err
+		}
+
+		changed := syncServiceRouting(readSvc, desired)
+		if !reflect.DeepEqual(readSvc.Spec.Selector, desired.Spec.Selector) {
+			log := dr.formatter.Logger(ctx, ms, "database")
+			log.Info(dr.formatter.Format(ms, "Switching db-read Service backend"), "Service", readSvc.Name, "component", desired.Spec.Selector["component"])
+			readSvc.Spec.Selector = desired.Spec.Selector
+			changed = true
+		}
+		if changed {
+			return dr.client.Update(ctx, readSvc)
 		}
 	}
 
 	return nil
 }
 
+// replicasReady reports whether at least one database replica is ready to serve reads
+func (dr *DatabaseReconciler) replicasReady(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	if ms.Spec.Database.Replicas == 0 {
+		return false, nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	stsName := types.NamespacedName{Name: ms.Name + "-db-replica", Namespace: builder.WorkloadNamespace(ms)}
+	if err := dr.client.Get(ctx, stsName, sts); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return sts.Status.ReadyReplicas > 0, nil
+}
+
 // ReconcileAutoscaler reconciles the HPA for database replicas
 func (dr *DatabaseReconciler) ReconcileAutoscaler(ctx context.Context, ms *musicv1.MusicService) error {
-	if ms.Spec.Database.Autoscaling == nil || ms.Spec.Database.Replicas == 0 {
+	if !builder.AutoscalingEnabled(ms.Spec.Database.Autoscaling) || ms.Spec.Database.Replicas == 0 {
 		return nil
 	}
 
 	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
 	hpaName := types.NamespacedName{
 		Name:      ms.Name + "-db-replica-autoscaler",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 
 	err := dr.client.Get(ctx, hpaName, hpa)
@@ -311,6 +368,8 @@ func databaseStorageSpec(ms *musicv1.MusicService) musicv1.StorageSpec {
 	return musicv1.StorageSpec{}
 }
 
+// ensureReplicationSecret creates the replication credentials and repairs missing keys.
+// Generation, repair and rotation by someone else are reported as Events and the ReplicationCredentials condition
 func (dr *DatabaseReconciler) ensureReplicationSecret(ctx context.Context, ms *musicv1.MusicService) (*corev1.Secret, error) {
 	if !replicationEnabled(ms) || ms.Spec.Database.Replicas == 0 {
 		return nil, nil
@@ -318,7 +377,7 @@ func (dr *DatabaseReconciler) ensureReplicationSecret(ctx context.Context, ms *m
 
 	secretName := types.NamespacedName{
 		Name:      ms.Name + "-db-replication",
-		Namespace: ms.Namespace,
+		Namespace: builder.WorkloadNamespace(ms),
 	}
 	secret := &corev1.Secret{}
 	if err := dr.client.Get(ctx, secretName, secret); err != nil {
@@ -334,11 +393,9 @@ func (dr *DatabaseReconciler) ensureReplicationSecret(ctx context.Context, ms *m
 
 		secret = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      secretName.Name,
-				Namespace: secretName.Namespace,
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(ms, musicv1.GroupVersion.WithKind("MusicService")),
-				},
+				Name:            secretName.Name,
+				Namespace:       secretName.Namespace,
+				OwnerReferences: dr.builder.OwnerReferences(ms),
 			},
 			Type: corev1.SecretTypeOpaque,
 			Data: map[string][]byte{
@@ -346,17 +403,23 @@ func (dr *DatabaseReconciler) ensureReplicationSecret(ctx context.Context, ms *m
 				"password": []byte(password),
 			},
 		}
+		secret.Annotations = map[string]string{credentialsHashAnnotation: credentialsHash(secret.Data)}
 
-		return secret, dr.client.Create(ctx, secret)
+		if err := dr.client.Create(ctx, secret); err != nil {
+			return nil, err
+		}
+		dr.recordCredentialChange(ms, corev1.EventTypeNormal, "Generated",
+			fmt.Sprintf("Generated replication credentials in Secret %s", secretName.Name))
+		return secret, nil
 	}
 
-	updated := false
+	var repaired []string
 	if secret.Data == nil {
 		secret.Data = map[string][]byte{}
 	}
 	if _, ok := secret.Data["username"]; !ok {
 		secret.Data["username"] = []byte("repl")
-		updated = true
+		repaired = append(repaired, "username")
 	}
 	if _, ok := secret.Data["password"]; !ok {
 		password, err := generatePassword(16)
@@ -364,17 +427,98 @@ func (dr *DatabaseReconciler) ensureReplicationSecret(ctx context.Context, ms *m
 			return nil, err
 		}
 		secret.Data["password"] = []byte(password)
-		updated = true
+		repaired = append(repaired, "password")
 	}
-	if updated {
-		if err := dr.client.Update(ctx, secret); err != nil {
-			return nil, err
-		}
+
+	hash := credentialsHash(secret.Data)
+	previousHash, tracked := secret.Annotations[credentialsHashAnnotation]
+	if len(repaired) == 0 && tracked && previousHash == hash {
+		return secret, nil
 	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[credentialsHashAnnotation] = hash
+	if err := dr.client.Update(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(repaired) > 0:
+		dr.recordCredentialChange(ms, corev1.EventTypeWarning, "Repaired",
+			fmt.Sprintf("Regenerated missing keys %v in replication Secret %s", repaired, secretName.Name))
+	case tracked:
+		dr.recordCredentialChange(ms, corev1.EventTypeNormal, "Rotated",
+			fmt.Sprintf("Replication credentials in Secret %s were changed outside the operator", secretName.Name))
+	}
+	// A Secret created before the hash annotation existed is adopted without an Event
 
 	return secret, nil
 }
 
+// recordCredentialChange emits an Event and sets the ReplicationCredentials condition for a credential lifecycle change.
+// The condition is persisted with the next status update
+func (dr *DatabaseReconciler) recordCredentialChange(ms *musicv1.MusicService, eventType, action, message string) {
+	if dr.recorder != nil {
+		dr.recorder.Event(ms, eventType, "ReplicationSecret"+action, dr.formatter.Format(ms, message))
+	}
+	meta.SetStatusCondition(&ms.Status.Conditions, metav1.Condition{
+		Type:               "ReplicationCredentials",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: ms.Generation,
+		Reason:             action,
+		Message:            message,
+	})
+}
+
+// credentialsHash returns a short hash of the replication username and password
+func credentialsHash(data map[string][]byte) string {
+	h := fnv.New32a()
+	_, _ = h.Write(data["username"])
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write(data["password"])
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// ReconcileConnectionSecret publishes the <name>-db-conn Secret applications use to reach the database
+// The app user password is generated once and kept; the other keys follow the spec
+func (dr *DatabaseReconciler) ReconcileConnectionSecret(ctx context.Context, ms *musicv1.MusicService) error {
+	log := dr.formatter.Logger(ctx, ms, "database")
+
+	secret := &corev1.Secret{}
+	secretName := types.NamespacedName{
+		Name:      builder.DatabaseConnectionSecretName(ms),
+		Namespace: builder.WorkloadNamespace(ms),
+	}
+	err := dr.client.Get(ctx, secretName, secret)
+	if err != nil && errors.IsNotFound(err) {
+		password, err := generatePassword(16)
+		if err != nil {
+			return err
+		}
+		log.Info(dr.formatter.Format(ms, "Creating DB connection Secret"), "Secret", secretName.Name)
+		return dr.client.Create(ctx, dr.builder.BuildDatabaseConnectionSecret(ms, password))
+	} else if err != nil {
+		return err
+	}
+
+	password := string(secret.Data[builder.ConnectionPasswordKey])
+	if password == "" {
+		if password, err = generatePassword(16); err != nil {
+			return err
+		}
+	}
+
+	desired := dr.builder.BuildDatabaseConnectionSecret(ms, password)
+	if !reflect.DeepEqual(secret.Data, desired.Data) {
+		log.Info(dr.formatter.Format(ms, "Updating DB connection Secret"), "Secret", secretName.Name)
+		secret.Data = desired.Data
+		return dr.client.Update(ctx, secret)
+	}
+
+	return nil
+}
+
 func generatePassword(length int) (string, error) {
 	buf := make([]byte, length)
 	if _, err := rand.Read(buf); err != nil {
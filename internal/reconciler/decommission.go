@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// DecommissionReplicas gỡ từng replica có ordinal cao nhất trước khi giảm spec.database.replicas:
+// chạy Job dừng replication trên replica (engine không hỗ trợ thì bỏ qua Job), giảm StatefulSet đi một
+// rồi tùy chọn xóa PVC của replica đó
+// Trả về true khi còn replica đang được gỡ; lúc đó không được để ReconcileReplicas giảm replicas trực tiếp
+func (dr *DatabaseReconciler) DecommissionReplicas(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	log := dr.formatter.Logger(ctx, ms, "database")
+	namespace := builder.WorkloadNamespace(ms)
+
+	sts := &appsv1.StatefulSet{}
+	if err := dr.client.Get(ctx, types.NamespacedName{Name: builder.DatabaseReplicaName(ms), Namespace: namespace}, sts); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Khi có HPA, số replica do HPA quyết định nên không gỡ theo spec
+	current := *sts.Spec.Replicas
+	if builder.AutoscalingEnabled(ms.Spec.Database.Autoscaling) || current <= ms.Spec.Database.Replicas {
+		return false, nil
+	}
+	victim := current - 1
+
+	// Engine không có bước dừng replication riêng thì gỡ replica trực tiếp
+	if !dr.builder.DatabaseProvider(ms).Features().ReplicaDecommission {
+		return true, dr.removeReplica(ctx, ms, sts, victim)
+	}
+
+	jobName := types.NamespacedName{Name: builder.ReplicaDecommissionJobName(ms, victim), Namespace: namespace}
+	job := &batchv1.Job{}
+	err := dr.client.Get(ctx, jobName, job)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, err
+	}
+
+	if errors.IsNotFound(err) {
+		pod := &corev1.Pod{}
+		podName := types.NamespacedName{Name: fmt.Sprintf("%s-%d", sts.Name, victim), Namespace: namespace}
+		if err := dr.client.Get(ctx, podName, pod); err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+		// Replica không chạy thì không còn replication để dừng, giảm replicas ngay
+		if pod.Status.PodIP == "" || pod.Status.Phase != corev1.PodRunning {
+			return true, dr.removeReplica(ctx, ms, sts, victim)
+		}
+
+		log.Info(dr.formatter.Format(ms, "Stopping replication before removing DB replica"), "Pod", podName.Name)
+		return true, dr.client.Create(ctx, dr.builder.BuildReplicaDecommissionJob(ms, victim, pod.Status.PodIP))
+	}
+
+	if job.Status.Failed > 0 && job.Status.Active == 0 && job.Status.Succeeded == 0 {
+		return true, fmt.Errorf("decommission Job %s failed; delete it to retry", jobName.Name)
+	}
+	if job.Status.Succeeded == 0 {
+		return true, nil
+	}
+
+	if err := dr.removeReplica(ctx, ms, sts, victim); err != nil {
+		return true, err
+	}
+	return true, client.IgnoreNotFound(dr.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
+
+// removeReplica giảm StatefulSet replica còn ordinal chỉ định và xóa PVC nếu bật deletePVCOnScaleDown
+func (dr *DatabaseReconciler) removeReplica(ctx context.Context, ms *musicv1.MusicService, sts *appsv1.StatefulSet, ordinal int32) error {
+	log := dr.formatter.Logger(ctx, ms, "database")
+
+	log.Info(dr.formatter.Format(ms, "Removing DB replica"), "StatefulSet", sts.Name, "ordinal", ordinal)
+	sts.Spec.Replicas = &ordinal
+	if err := dr.client.Update(ctx, sts); err != nil {
+		return err
+	}
+
+	replication := ms.Spec.Database.Replication
+	if replication == nil || !replication.DeletePVCOnScaleDown {
+		return nil
+	}
+	// PVC còn được pod đang dừng dùng thì API server giữ lại cho tới khi pod bị xóa
+	pvcName := types.NamespacedName{Name: builder.DatabaseReplicaPVCName(ms, ordinal), Namespace: sts.Namespace}
+	log.Info(dr.formatter.Format(ms, "Deleting PVC of removed DB replica"), "PVC", pvcName.Name)
+	return deleteObjectIfExists(ctx, dr.client, pvcName, &corev1.PersistentVolumeClaim{})
+}
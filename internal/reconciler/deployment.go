@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileDeployment đồng bộ Deployment của ứng dụng khi spec.workloadType là Deployment;
+// ở chế độ StatefulSet thì xóa Deployment còn sót lại
+func (ar *AppReconciler) ReconcileDeployment(ctx context.Context, ms *musicv1.MusicService) error {
+	log := ar.formatter.Logger(ctx, ms, "app")
+	name := types.NamespacedName{Name: ms.Name, Namespace: builder.WorkloadNamespace(ms)}
+
+	if !builder.AppUsesDeployment(ms) {
+		forgetDrift(ms, "Deployment", name.Name)
+		return deleteObjectIfExists(ctx, ar.client, name, &appsv1.Deployment{})
+	}
+
+	deployment := &appsv1.Deployment{}
+	err := ar.client.Get(ctx, name, deployment)
+	if err != nil && errors.IsNotFound(err) {
+		deployment = ar.builder.BuildAppDeployment(ms)
+		if err := ar.applyConfigChecksum(ctx, ms, &deployment.Spec.Template); err != nil {
+			return err
+		}
+		stampDesiredHash(deployment, builder.DesiredHash(deployment))
+		log.Info(ar.formatter.Format(ms, "Creating new Deployment"), "Deployment", name.Name)
+		return ar.client.Create(ctx, deployment)
+	} else if err != nil {
+		return err
+	}
+
+	desired := ar.builder.BuildAppDeployment(ms)
+	if err := ar.applyConfigChecksum(ctx, ms, &desired.Spec.Template); err != nil {
+		return err
+	}
+	// Băm trước khi lấy replica từ HPA để việc co giãn không bị coi là desired đổi
+	desiredHash := builder.DesiredHash(desired)
+	// Khi có HPA, số replica do HPA quyết định nên giữ nguyên giá trị hiện tại
+	if builder.AutoscalingEnabled(ms.Spec.Autoscaling) {
+		desired.Spec.Replicas = deployment.Spec.Replicas
+	}
+
+	if recordDrift(ms, "Deployment", deployment, desiredHash, deploymentDiff(deployment, desired)) {
+		log.Info(ar.formatter.Format(ms, "Deployment modified outside the operator, keeping it as driftPolicy is Report"), "Deployment", name.Name)
+		return nil
+	}
+
+	needsUpdate := deploymentNeedsUpdate(deployment, desired)
+	if needsUpdate {
+		log.Info(ar.formatter.Format(ms, "Updating Deployment"), "Deployment", name.Name)
+		deployment.Spec.Replicas = desired.Spec.Replicas
+		deployment.Spec.MinReadySeconds = desired.Spec.MinReadySeconds
+		deployment.Spec.Strategy = desired.Spec.Strategy
+		deployment.Spec.Template = desired.Spec.Template
+	}
+	if stampDesiredHash(deployment, desiredHash) || needsUpdate {
+		return ar.client.Update(ctx, deployment)
+	}
+
+	return nil
+}
+
+// deploymentNeedsUpdate kiểm tra xem spec của Deployment ứng dụng có cần cập nhật không
+func deploymentNeedsUpdate(current, desired *appsv1.Deployment) bool {
+	return len(deploymentDiff(current, desired)) > 0
+}
+
+// deploymentDiff trả về đường dẫn các field Deployment do operator quản lý mà current khác desired
+func deploymentDiff(current, desired *appsv1.Deployment) []string {
+	var fields []string
+	if *current.Spec.Replicas != *desired.Spec.Replicas {
+		fields = append(fields, "spec.replicas")
+	}
+
+	if current.Spec.MinReadySeconds != desired.Spec.MinReadySeconds {
+		fields = append(fields, "spec.minReadySeconds")
+	}
+	if !reflect.DeepEqual(current.Spec.Strategy, desired.Spec.Strategy) {
+		fields = append(fields, "spec.strategy")
+	}
+
+	return append(fields, podTemplateDiff(&current.Spec.Template, &desired.Spec.Template)...)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Pod ứng dụng có readiness gate builder.ServingConditionType khi bật spec.streaming.drain.
+// - Pod còn giữ: operator đặt condition True để pod nhận lưu lượng.
+// - Pod sắp bị xóa khi giảm replica: đặt False (rút khỏi Service), chờ hết kết nối hoặc hết timeout,
+//   sau đó ReconcileStatefulSet mới được giảm replica; trong lúc chờ operator requeue thay vì chặn reconcile.
+// - Khi có HPA, số replica là của StatefulSet đang chạy: HPA xóa pod trực tiếp nên preStop hook của pod
+//   (builder.applyDrainPodSpec) chờ hết kết nối thay cho operator.
+// - Với spec.shards, mỗi StatefulSet shard được xử lý như trên theo replicas/autoscaling của shard đó.
+
+// drainScrapeTimeout giới hạn thời gian đọc /metrics của một pod
+const drainScrapeTimeout = 2 * time.Second
+
+// ReconcileDrain mở/rút lưu lượng của pod ứng dụng (hoặc pod của từng shard) theo số replica mong muốn
+// Trả về true khi còn pod đang rút kết nối; lúc đó không được giảm replica của StatefulSet
+func (ar *AppReconciler) ReconcileDrain(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	if !builder.DrainEnabled(ms) || builder.AppUsesDeployment(ms) {
+		return false, nil
+	}
+	if !builder.ShardsEnabled(ms) {
+		return ar.drainStatefulSet(ctx, ms, ms.Name, ms.Spec.Replicas, builder.AutoscalingEnabled(ms.Spec.Autoscaling))
+	}
+
+	draining := false
+	for _, shard := range ms.Spec.Shards {
+		shardDraining, err := ar.drainStatefulSet(ctx, ms, builder.ShardName(ms, shard.Name), shard.Replicas, builder.AutoscalingEnabled(shard.Autoscaling))
+		if err != nil {
+			return false, err
+		}
+		draining = draining || shardDraining
+	}
+	return draining, nil
+}
+
+// drainStatefulSet mở/rút lưu lượng của pod thuộc StatefulSet name; replicas là số pod mong muốn,
+// bị bỏ qua khi autoscaled vì HPA quyết định số replica
+func (ar *AppReconciler) drainStatefulSet(ctx context.Context, ms *musicv1.MusicService, name string, replicas int32, autoscaled bool) (bool, error) {
+	log := ar.formatter.Logger(ctx, ms, "drain")
+
+	sts := &appsv1.StatefulSet{}
+	if err := ar.client.Get(ctx, types.NamespacedName{Name: name, Namespace: builder.WorkloadNamespace(ms)}, sts); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := ar.client.List(ctx, pods, client.InNamespace(sts.Namespace), client.MatchingLabels(sts.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+
+	// HPA đổi replica trực tiếp trên StatefulSet nên spec.replicas không phản ánh số pod mong muốn
+	target := replicas
+	if autoscaled && sts.Spec.Replicas != nil {
+		target = *sts.Spec.Replicas
+	}
+	draining := false
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		ordinal, ok := podOrdinal(sts.Name, pod.Name)
+		if !ok || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if ordinal < target {
+			if err := ar.setServing(ctx, pod, true); err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		// Pod nằm ngoài số replica mong muốn: rút khỏi Service rồi chờ kết nối kết thúc
+		if err := ar.setServing(ctx, pod, false); err != nil {
+			return false, err
+		}
+		drained, err := ar.podDrained(ctx, ms, pod)
+		if err != nil {
+			return false, err
+		}
+		if !drained {
+			log.Info(ar.formatter.Format(ms, "Waiting for connections to drain before scale-down"), "Pod", pod.Name)
+			draining = true
+		}
+	}
+
+	return draining, nil
+}
+
+// setServing đặt condition readiness gate của pod; bỏ đánh dấu rút kết nối khi mở lại pod
+func (ar *AppReconciler) setServing(ctx context.Context, pod *corev1.Pod, serving bool) error {
+	if _, started := pod.Annotations[builder.DrainStartedAnnotation]; serving && started {
+		delete(pod.Annotations, builder.DrainStartedAnnotation)
+		if err := ar.client.Update(ctx, pod); err != nil {
+			return err
+		}
+	}
+
+	status := corev1.ConditionTrue
+	reason := "Serving"
+	if !serving {
+		status = corev1.ConditionFalse
+		reason = "Draining"
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == builder.ServingConditionType && condition.Status == status {
+			return nil
+		}
+	}
+
+	patch := client.MergeFrom(pod.DeepCopy())
+	conditions := pod.Status.Conditions[:0]
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != builder.ServingConditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+	pod.Status.Conditions = append(conditions, corev1.PodCondition{
+		Type:               builder.ServingConditionType,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: metav1.Now(),
+	})
+	return ar.client.Status().Patch(ctx, pod, patch)
+}
+
+// podDrained cho biết pod đã hết kết nối đang phát hoặc đã hết thời gian chờ
+// Không đọc được metric thì chỉ dựa vào thời gian chờ
+func (ar *AppReconciler) podDrained(ctx context.Context, ms *musicv1.MusicService, pod *corev1.Pod) (bool, error) {
+	started, err := time.Parse(time.RFC3339, pod.Annotations[builder.DrainStartedAnnotation])
+	if err != nil {
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[builder.DrainStartedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		return false, ar.client.Update(ctx, pod)
+	}
+
+	if time.Since(started) >= builder.DrainTimeout(ms) {
+		return true, nil
+	}
+	if pod.Status.PodIP == "" || pod.Status.Phase != corev1.PodRunning {
+		return true, nil
+	}
+
+	connections, err := scrapeActiveConnections(ctx, pod.Status.PodIP, builder.AppContainerPort(ms))
+	if err != nil {
+		return false, nil
+	}
+	return connections == 0, nil
+}
+
+// scrapeActiveConnections đọc tổng builder.ActiveConnectionsMetric từ /metrics của pod
+func scrapeActiveConnections(ctx context.Context, podIP string, port int32) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, drainScrapeTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("http://%s/metrics", net.JoinHostPort(podIP, strconv.Itoa(int(port))))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("metrics endpoint returned %s", resp.Status)
+	}
+
+	total, found := 0.0, false
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		// Dòng mẫu: <tên>{<nhãn>} <giá trị> [timestamp]
+		rest, ok := strings.CutPrefix(scanner.Text(), builder.ActiveConnectionsMetric)
+		if !ok || rest == "" || (rest[0] != '{' && rest[0] != ' ') {
+			continue
+		}
+		if rest[0] == '{' {
+			end := strings.LastIndex(rest, "}")
+			if end < 0 {
+				continue
+			}
+			rest = rest[end+1:]
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+		total += value
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("metric %s not found", builder.ActiveConnectionsMetric)
+	}
+	return total, nil
+}
+
+// podOrdinal tách ordinal từ tên pod "<statefulset>-<n>"
+func podOrdinal(stsName, podName string) (int32, bool) {
+	suffix, ok := strings.CutPrefix(podName, stsName+"-")
+	if !ok {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(suffix, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package reconciler
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// stampDesiredHash ghi mã băm desired lên tài nguyên con sắp được tạo hoặc cập nhật, trả về true nếu giá trị đổi
+func stampDesiredHash(obj client.Object, hash string) bool {
+	annotations := obj.GetAnnotations()
+	if annotations[builder.AppliedDesiredHashAnnotation] == hash {
+		return false
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[builder.AppliedDesiredHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+	return true
+}
+
+// recordDrift ghi các field lệch của tài nguyên con vào status.drift và trả về true nếu operator
+// phải giữ nguyên tài nguyên (driftPolicy Report). Khác biệt chỉ là drift khi tài nguyên mang mã băm của đúng
+// desired hiện tại, tức là operator đã áp dụng desired này; nếu desired đổi (spec, checksum ConfigMap, phiên bản
+// operator) thì khác biệt là thay đổi cần áp dụng nên không được ghi.
+// Khi không còn khác biệt, mục chưa bị ghi đè được xóa; mục đã ghi đè được giữ đến khi spec đổi
+func recordDrift(ms *musicv1.MusicService, kind string, obj client.Object, desiredHash string, fields []string) bool {
+	index := -1
+	for i, entry := range ms.Status.Drift {
+		if entry.Kind == kind && entry.Name == obj.GetName() {
+			index = i
+			break
+		}
+	}
+
+	if len(fields) == 0 || obj.GetAnnotations()[builder.AppliedDesiredHashAnnotation] != desiredHash {
+		if index >= 0 && (!ms.Status.Drift[index].Reverted || ms.Status.Drift[index].Generation != ms.Generation) {
+			ms.Status.Drift = append(ms.Status.Drift[:index], ms.Status.Drift[index+1:]...)
+		}
+		return false
+	}
+
+	report := ms.Spec.DriftPolicy == musicv1.DriftPolicyReport
+	entry := musicv1.DriftedResource{
+		Kind:             kind,
+		Name:             obj.GetName(),
+		Fields:           fields,
+		LastDetectedTime: metav1.Now(),
+		Reverted:         !report,
+		Generation:       ms.Generation,
+	}
+	if index < 0 {
+		ms.Status.Drift = append(ms.Status.Drift, entry)
+	} else {
+		ms.Status.Drift[index] = entry
+	}
+	return report
+}
+
+// forgetDrift xóa mục status.drift của tài nguyên con đã bị operator xóa
+func forgetDrift(ms *musicv1.MusicService, kind, name string) {
+	for i, entry := range ms.Status.Drift {
+		if entry.Kind == kind && entry.Name == name {
+			ms.Status.Drift = append(ms.Status.Drift[:i], ms.Status.Drift[i+1:]...)
+			return
+		}
+	}
+}
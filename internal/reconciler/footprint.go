@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ footprint được tính thế nào, xem pkg/builder/footprint.go.
+// - Budget theo tenant được cấu hình bằng các cờ --tenant-budget-* trong cmd/main.go.
+
+// errBudgetExceeded đánh dấu lỗi do vượt budget để controller phân biệt với lỗi API
+var errBudgetExceeded = goerrors.New("tenant budget exceeded")
+
+// IsBudgetExceeded cho biết lỗi trả về từ FootprintReconciler có phải do vượt budget hay không
+func IsBudgetExceeded(err error) bool {
+	return goerrors.Is(err, errBudgetExceeded)
+}
+
+// FootprintReconciler tính tổng tài nguyên của MusicService và kiểm tra budget của tenant.
+// Tenant là namespace: budget áp dụng cho tổng footprint của mọi MusicService trong namespace,
+// riêng MusicService dùng tenancy Dedicated là một tenant độc lập.
+type FootprintReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+	budget    corev1.ResourceList
+}
+
+// NewFootprintReconciler tạo một reconciler mới cho footprint; budget rỗng nghĩa là không giới hạn
+func NewFootprintReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter, budget corev1.ResourceList) *FootprintReconciler {
+	return &FootprintReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+		budget:    budget,
+	}
+}
+
+// Reconcile trả về lỗi nếu footprint của cả namespace vượt budget, ngược lại ghi footprint vào status.
+// Việc kiểm tra chạy trước khi tạo tài nguyên nên spec vượt budget không làm thay đổi gì trong cluster;
+// status giữ footprint đã được chấp nhận lần trước để instance bị từ chối không chiếm budget của instance khác
+func (fr *FootprintReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	log := fr.formatter.Logger(ctx, ms, "footprint")
+
+	footprint := fr.builder.ComputeFootprint(ms)
+	if len(fr.budget) == 0 {
+		ms.Status.Footprint = &footprint
+		return nil
+	}
+
+	list := &musicv1.MusicServiceList{}
+	if !builder.DedicatedTenancy(ms) {
+		if err := fr.client.List(ctx, list, client.InNamespace(ms.Namespace)); err != nil {
+			return err
+		}
+	}
+
+	total := *footprint.DeepCopy()
+	for i := range list.Items {
+		other := &list.Items[i]
+		if other.Name == ms.Name || other.DeletionTimestamp != nil || other.Status.Footprint == nil || builder.DedicatedTenancy(other) {
+			continue
+		}
+		total.CPU.Add(other.Status.Footprint.CPU)
+		total.Memory.Add(other.Status.Footprint.Memory)
+		total.Storage.Add(other.Status.Footprint.Storage)
+	}
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceStorage} {
+		limit, ok := fr.budget[name]
+		if !ok {
+			continue
+		}
+		used := footprintQuantity(total, name)
+		if used.Cmp(limit) > 0 {
+			log.Info(fr.formatter.Format(ms, "Refusing to reconcile: tenant budget exceeded"),
+				"resource", name, "used", used.String(), "budget", limit.String())
+			return fmt.Errorf("%w: tenant %s would use %s %s, budget is %s",
+				errBudgetExceeded, builder.WorkloadNamespace(ms), used.String(), name, limit.String())
+		}
+	}
+
+	ms.Status.Footprint = &footprint
+	return nil
+}
+
+func footprintQuantity(footprint musicv1.ResourceFootprint, name corev1.ResourceName) resource.Quantity {
+	switch name {
+	case corev1.ResourceCPU:
+		return footprint.CPU
+	case corev1.ResourceMemory:
+		return footprint.Memory
+	default:
+		return footprint.Storage
+	}
+}
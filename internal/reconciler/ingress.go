@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileIngress đồng bộ Ingress của ứng dụng; bỏ spec.ingress thì xóa Ingress
+func (ar *AppReconciler) ReconcileIngress(ctx context.Context, ms *musicv1.MusicService) error {
+	log := ar.formatter.Logger(ctx, ms, "ingress")
+
+	ingress := &networkingv1.Ingress{}
+	name := types.NamespacedName{Name: ms.Name, Namespace: builder.WorkloadNamespace(ms)}
+
+	if ms.Spec.Ingress == nil {
+		return deleteObjectIfExists(ctx, ar.client, name, ingress)
+	}
+
+	err := ar.client.Get(ctx, name, ingress)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating Ingress"), "Ingress", name.Name)
+		return ar.client.Create(ctx, ar.builder.BuildIngress(ms))
+	} else if err != nil {
+		return err
+	}
+
+	desired := ar.builder.BuildIngress(ms)
+	annotations, annotationsChanged := syncIngressAnnotations(ingress.Annotations, desired.Annotations)
+	if !reflect.DeepEqual(ingress.Spec, desired.Spec) || annotationsChanged {
+		log.Info(ar.formatter.Format(ms, "Updating Ingress"), "Ingress", name.Name)
+		ingress.Spec = desired.Spec
+		ingress.Annotations = annotations
+		return ar.client.Update(ctx, ingress)
+	}
+
+	return nil
+}
+
+// syncIngressAnnotations đặt các annotation desired lên Ingress và chỉ xóa các key ingress-nginx do operator quản lý
+// mà desired không còn; annotation do controller hoặc công cụ khác thêm (cert-manager, external-dns...) được giữ
+func syncIngressAnnotations(current, desired map[string]string) (map[string]string, bool) {
+	annotations, changed := mergeMetadata(current, desired)
+	for _, key := range builder.IngressOwnedAnnotations() {
+		if _, wanted := desired[key]; wanted {
+			continue
+		}
+		if _, ok := annotations[key]; ok {
+			delete(annotations, key)
+			changed = true
+		}
+	}
+	return annotations, changed
+}
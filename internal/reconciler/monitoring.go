@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// MonitoringReconciler xử lý PodMonitor của prometheus-operator cho metrics của ứng dụng
+type MonitoringReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+}
+
+// NewMonitoringReconciler tạo một reconciler mới cho PodMonitor
+func NewMonitoringReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter) *MonitoringReconciler {
+	return &MonitoringReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+	}
+}
+
+// Reconcile đồng bộ PodMonitor khi spec.monitoring.enabled, ngược lại xóa PodMonitor đã tạo.
+// Cluster chưa cài CRD PodMonitor thì chỉ ghi log để không chặn các bước reconcile còn lại
+func (mr *MonitoringReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	log := mr.formatter.Logger(ctx, ms, "monitoring")
+
+	podMonitor := &unstructured.Unstructured{}
+	podMonitor.SetGroupVersionKind(builder.PodMonitorGVK)
+	name := types.NamespacedName{Name: builder.PodMonitorName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	if !builder.MonitoringEnabled(ms) {
+		err := deleteObjectIfExists(ctx, mr.client, name, podMonitor)
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+
+	desired := mr.builder.BuildPodMonitor(ms)
+	err := mr.client.Get(ctx, name, podMonitor)
+	if meta.IsNoMatchError(err) {
+		log.Info(mr.formatter.Format(ms, "PodMonitor CRD is not installed, skipping app monitoring"))
+		return nil
+	}
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(mr.formatter.Format(ms, "Creating PodMonitor"), "PodMonitor", name.Name)
+		return mr.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(podMonitor.Object["spec"], desired.Object["spec"]) ||
+		!reflect.DeepEqual(podMonitor.GetLabels(), desired.GetLabels()) {
+		log.Info(mr.formatter.Format(ms, "Updating PodMonitor"), "PodMonitor", name.Name)
+		podMonitor.Object["spec"] = desired.Object["spec"]
+		podMonitor.SetLabels(desired.GetLabels())
+		return mr.client.Update(ctx, podMonitor)
+	}
+
+	return nil
+}
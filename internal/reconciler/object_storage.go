@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileObjectStorage đồng bộ Secret thông tin đăng nhập, Service và StatefulSet MinIO;
+// bỏ spec.objectStorage thì xóa chúng nhưng giữ PVC minio-data
+func (ar *AppReconciler) ReconcileObjectStorage(ctx context.Context, ms *musicv1.MusicService) error {
+	namespace := builder.WorkloadNamespace(ms)
+	minioName := types.NamespacedName{Name: builder.ObjectStorageName(ms), Namespace: namespace}
+	secretName := types.NamespacedName{Name: builder.ObjectStorageSecretName(ms), Namespace: namespace}
+
+	if ms.Spec.ObjectStorage == nil {
+		if err := deleteObjectIfExists(ctx, ar.client, minioName, &appsv1.StatefulSet{}); err != nil {
+			return err
+		}
+		if err := deleteObjectIfExists(ctx, ar.client, minioName, &corev1.Service{}); err != nil {
+			return err
+		}
+		return deleteObjectIfExists(ctx, ar.client, secretName, &corev1.Secret{})
+	}
+
+	if err := ar.reconcileObjectStorageSecret(ctx, ms, secretName); err != nil {
+		return err
+	}
+	if err := ar.reconcileSingleNodeService(ctx, ar.builder.BuildObjectStorageService(ms)); err != nil {
+		return err
+	}
+	return ar.reconcileSingleNodeStatefulSet(ctx, ms, "minio", ar.builder.BuildObjectStorageStatefulSet(ms), &ms.Spec.ObjectStorage.Storage)
+}
+
+// reconcileObjectStorageSecret ghi Secret thông tin đăng nhập; access key và secret key được sinh một lần
+// vì MinIO lưu tài khoản root cùng dữ liệu trên PVC
+func (ar *AppReconciler) reconcileObjectStorageSecret(ctx context.Context, ms *musicv1.MusicService, name types.NamespacedName) error {
+	log := ar.formatter.Logger(ctx, ms, "minio")
+
+	secret := &corev1.Secret{}
+	err := ar.client.Get(ctx, name, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	keys := map[string]string{}
+	for _, key := range []string{builder.ObjectStorageAccessKeyKey, builder.ObjectStorageSecretKeyKey} {
+		keys[key] = string(secret.Data[key])
+		if keys[key] == "" {
+			if keys[key], err = generatePassword(16); err != nil {
+				return err
+			}
+		}
+	}
+	desired := ar.builder.BuildObjectStorageSecret(ms, keys[builder.ObjectStorageAccessKeyKey], keys[builder.ObjectStorageSecretKeyKey])
+
+	if !exists {
+		log.Info(ar.formatter.Format(ms, "Creating object storage credentials Secret"), "Secret", name.Name)
+		return ar.client.Create(ctx, desired)
+	}
+	if !reflect.DeepEqual(secret.Data, desired.Data) {
+		log.Info(ar.formatter.Format(ms, "Updating object storage credentials Secret"), "Secret", name.Name)
+		secret.Data = desired.Data
+		return ar.client.Update(ctx, secret)
+	}
+	return nil
+}
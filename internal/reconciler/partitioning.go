@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcilePartitioning ghi bảng phân chia nội dung vào status.partitioning và ConfigMap <name>-partitions;
+// khi bỏ spec.partitioning thì xóa cả hai
+func (ar *AppReconciler) ReconcilePartitioning(ctx context.Context, ms *musicv1.MusicService) error {
+	log := ar.formatter.Logger(ctx, ms, "partitioning")
+	name := types.NamespacedName{Name: builder.PartitionsConfigMapName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	ms.Status.Partitioning = builder.BuildPartitioningStatus(ms)
+	if ms.Status.Partitioning == nil {
+		return deleteObjectIfExists(ctx, ar.client, name, &corev1.ConfigMap{})
+	}
+
+	desired, err := ar.builder.BuildPartitionsConfigMap(ms)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = ar.client.Get(ctx, name, configMap)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating partitions ConfigMap"), "ConfigMap", name.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(configMap.Data, desired.Data) {
+		log.Info(ar.formatter.Format(ms, "Updating partitions ConfigMap"), "ConfigMap", name.Name)
+		configMap.Data = desired.Data
+		return ar.client.Update(ctx, configMap)
+	}
+	return nil
+}
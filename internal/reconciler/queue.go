@@ -0,0 +1,179 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileQueue đồng bộ Secret kết nối broker cùng StatefulSet/Service của broker do operator triển khai.
+// Dùng broker bên ngoài hoặc bỏ spec.queue thì xóa broker; PVC queue-data được giữ lại như PVC của ứng dụng
+func (ar *AppReconciler) ReconcileQueue(ctx context.Context, ms *musicv1.MusicService) error {
+	namespace := builder.WorkloadNamespace(ms)
+	queueName := types.NamespacedName{Name: builder.QueueName(ms), Namespace: namespace}
+	secretName := types.NamespacedName{Name: builder.QueueConnectionSecretName(ms), Namespace: namespace}
+
+	if ms.Spec.Queue == nil {
+		if err := deleteObjectIfExists(ctx, ar.client, secretName, &corev1.Secret{}); err != nil {
+			return err
+		}
+	}
+	if !builder.QueueManaged(ms) {
+		if err := deleteObjectIfExists(ctx, ar.client, queueName, &appsv1.StatefulSet{}); err != nil {
+			return err
+		}
+		if err := deleteObjectIfExists(ctx, ar.client, queueName, &corev1.Service{}); err != nil {
+			return err
+		}
+	}
+	if ms.Spec.Queue == nil {
+		return nil
+	}
+
+	if err := ar.reconcileQueueConnectionSecret(ctx, ms, secretName); err != nil {
+		return err
+	}
+	if !builder.QueueManaged(ms) {
+		return nil
+	}
+	if err := ar.reconcileSingleNodeService(ctx, ar.builder.BuildQueueService(ms)); err != nil {
+		return err
+	}
+	return ar.reconcileSingleNodeStatefulSet(ctx, ms, "queue", ar.builder.BuildQueueStatefulSet(ms), ms.Spec.Queue.Storage)
+}
+
+// reconcileQueueConnectionSecret ghi Secret kết nối; mật khẩu của broker do operator triển khai được sinh
+// một lần và giữ lại giữa các lần reconcile, còn thông tin của broker bên ngoài luôn chép từ credentialsSecret
+func (ar *AppReconciler) reconcileQueueConnectionSecret(ctx context.Context, ms *musicv1.MusicService, name types.NamespacedName) error {
+	log := ar.formatter.Logger(ctx, ms, "queue")
+
+	secret := &corev1.Secret{}
+	err := ar.client.Get(ctx, name, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	username, password, err := ar.queueCredentials(ctx, ms, secret)
+	if err != nil {
+		return err
+	}
+	desired := ar.builder.BuildQueueConnectionSecret(ms, username, password)
+
+	if !exists {
+		log.Info(ar.formatter.Format(ms, "Creating queue connection Secret"), "Secret", name.Name)
+		return ar.client.Create(ctx, desired)
+	}
+	if !reflect.DeepEqual(secret.Data, desired.Data) {
+		log.Info(ar.formatter.Format(ms, "Updating queue connection Secret"), "Secret", name.Name)
+		secret.Data = desired.Data
+		return ar.client.Update(ctx, secret)
+	}
+	return nil
+}
+
+// queueCredentials trả về username/password ghi vào Secret kết nối; current là Secret hiện tại (rỗng nếu chưa có)
+func (ar *AppReconciler) queueCredentials(ctx context.Context, ms *musicv1.MusicService, current *corev1.Secret) (string, string, error) {
+	if external := ms.Spec.Queue.External; external != nil {
+		if external.CredentialsSecret == "" {
+			return "", "", nil
+		}
+		source := &corev1.Secret{}
+		if err := ar.client.Get(ctx, types.NamespacedName{Name: external.CredentialsSecret, Namespace: builder.WorkloadNamespace(ms)}, source); err != nil {
+			return "", "", fmt.Errorf("queue credentials Secret %s: %w", external.CredentialsSecret, err)
+		}
+		return string(source.Data[builder.ConnectionUsernameKey]), string(source.Data[builder.ConnectionPasswordKey]), nil
+	}
+
+	username := builder.QueueUsername(ms)
+	if username == "" {
+		return "", "", nil
+	}
+	password := string(current.Data[builder.ConnectionPasswordKey])
+	if password == "" {
+		var err error
+		if password, err = generatePassword(16); err != nil {
+			return "", "", err
+		}
+	}
+	return username, password, nil
+}
+
+// reconcileSingleNodeService tạo Service của thành phần phụ và đồng bộ port khi engine đổi
+func (ar *AppReconciler) reconcileSingleNodeService(ctx context.Context, desired *corev1.Service) error {
+	svc := &corev1.Service{}
+	err := ar.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, svc)
+	if err != nil && errors.IsNotFound(err) {
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if syncServicePorts(svc, desired) {
+		return ar.client.Update(ctx, svc)
+	}
+	return nil
+}
+
+// reconcileSingleNodeStatefulSet đồng bộ StatefulSet một pod của thành phần phụ, mở rộng hoặc tạo lại
+// PVC <component>-data theo storage.updatePolicy như PVC của ứng dụng
+func (ar *AppReconciler) reconcileSingleNodeStatefulSet(ctx context.Context, ms *musicv1.MusicService, component string,
+	desired *appsv1.StatefulSet, storage *musicv1.StorageSpec) error {
+	log := ar.formatter.Logger(ctx, ms, component)
+	name := types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}
+	claimName := component + "-data"
+
+	sts := &appsv1.StatefulSet{}
+	err := ar.client.Get(ctx, name, sts)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating "+component+" StatefulSet"), "StatefulSet", name.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if storageSizeChanged(sts, desired) {
+		if storageUpdatePolicy(*storage) == musicv1.StorageUpdatePolicyRecreate {
+			log.Info(ar.formatter.Format(ms, "Recreating "+component+" StatefulSet and PVCs due to storage size change"), "StatefulSet", name.Name)
+			return recreateStorage(ctx, ar.client, ar.builder, ms, sts, claimName, name.Name, storage)
+		}
+		if err := resizePVCs(ctx, ar.client, claimName, name.Name, desired); err != nil {
+			return err
+		}
+	}
+
+	if err := syncPVCMetadata(ctx, ar.client, claimName, name.Name, desired); err != nil {
+		return err
+	}
+
+	if statefulSetNeedsUpdate(sts, desired) {
+		log.Info(ar.formatter.Format(ms, "Updating "+component+" StatefulSet"), "StatefulSet", name.Name)
+		updateStatefulSetSpec(sts, desired)
+		return ar.client.Update(ctx, sts)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileReadPool đồng bộ Deployment, Service và HPA của read pool; tắt read pool thì xóa cả ba
+func (ar *AppReconciler) ReconcileReadPool(ctx context.Context, ms *musicv1.MusicService) error {
+	name := types.NamespacedName{Name: builder.ReadPoolName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	if ms.Spec.ReadPool == nil || !ms.Spec.ReadPool.Enabled {
+		hpaName := types.NamespacedName{Name: name.Name + "-autoscaler", Namespace: name.Namespace}
+		if err := deleteObjectIfExists(ctx, ar.client, hpaName, &autoscalingv2.HorizontalPodAutoscaler{}); err != nil {
+			return err
+		}
+		if err := deleteObjectIfExists(ctx, ar.client, name, &corev1.Service{}); err != nil {
+			return err
+		}
+		return deleteObjectIfExists(ctx, ar.client, name, &appsv1.Deployment{})
+	}
+
+	if err := ar.reconcileReadPoolDeployment(ctx, ms, name); err != nil {
+		return err
+	}
+	if err := ar.reconcileReadPoolService(ctx, ms, name); err != nil {
+		return err
+	}
+	return ar.reconcileReadPoolAutoscaler(ctx, ms)
+}
+
+func (ar *AppReconciler) reconcileReadPoolDeployment(ctx context.Context, ms *musicv1.MusicService, name types.NamespacedName) error {
+	log := ar.formatter.Logger(ctx, ms, "read-pool")
+
+	deployment := &appsv1.Deployment{}
+	err := ar.client.Get(ctx, name, deployment)
+	if err != nil && errors.IsNotFound(err) {
+		deployment = ar.builder.BuildReadPoolDeployment(ms)
+		if err := ar.applyConfigChecksum(ctx, ms, &deployment.Spec.Template); err != nil {
+			return err
+		}
+		log.Info(ar.formatter.Format(ms, "Creating read pool Deployment"), "Deployment", name.Name)
+		return ar.client.Create(ctx, deployment)
+	} else if err != nil {
+		return err
+	}
+
+	desired := ar.builder.BuildReadPoolDeployment(ms)
+	if err := ar.applyConfigChecksum(ctx, ms, &desired.Spec.Template); err != nil {
+		return err
+	}
+	// Khi có HPA, số replica do HPA quyết định nên giữ nguyên giá trị hiện tại
+	if builder.AutoscalingEnabled(ms.Spec.ReadPool.Autoscaling) {
+		desired.Spec.Replicas = deployment.Spec.Replicas
+	}
+
+	if *deployment.Spec.Replicas != *desired.Spec.Replicas ||
+		templateAnnotationsChanged(&deployment.Spec.Template, &desired.Spec.Template) ||
+		podSpecNeedsUpdate(&deployment.Spec.Template.Spec, &desired.Spec.Template.Spec) {
+		log.Info(ar.formatter.Format(ms, "Updating read pool Deployment"), "Deployment", name.Name)
+		deployment.Spec.Replicas = desired.Spec.Replicas
+		deployment.Spec.Template = desired.Spec.Template
+		return ar.client.Update(ctx, deployment)
+	}
+
+	return nil
+}
+
+func (ar *AppReconciler) reconcileReadPoolService(ctx context.Context, ms *musicv1.MusicService, name types.NamespacedName) error {
+	service := &corev1.Service{}
+	err := ar.client.Get(ctx, name, service)
+	if err != nil && errors.IsNotFound(err) {
+		return ar.client.Create(ctx, ar.builder.BuildReadPoolService(ms))
+	} else if err != nil {
+		return err
+	}
+
+	desired := ar.builder.BuildReadPoolService(ms)
+	changed := syncServiceRouting(service, desired)
+	if !reflect.DeepEqual(service.Spec.Ports, desired.Spec.Ports) {
+		service.Spec.Ports = desired.Spec.Ports
+		changed = true
+	}
+	if changed {
+		return ar.client.Update(ctx, service)
+	}
+
+	return nil
+}
+
+func (ar *AppReconciler) reconcileReadPoolAutoscaler(ctx context.Context, ms *musicv1.MusicService) error {
+	hpaName := types.NamespacedName{Name: builder.ReadPoolName(ms) + "-autoscaler", Namespace: builder.WorkloadNamespace(ms)}
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+
+	if !builder.AutoscalingEnabled(ms.Spec.ReadPool.Autoscaling) {
+		return deleteObjectIfExists(ctx, ar.client, hpaName, hpa)
+	}
+
+	err := ar.client.Get(ctx, hpaName, hpa)
+	if err != nil && errors.IsNotFound(err) {
+		return ar.client.Create(ctx, ar.builder.BuildReadPoolAutoscaler(ms))
+	} else if err != nil {
+		return err
+	}
+
+	desired := ar.builder.BuildReadPoolAutoscaler(ms)
+	if autoscalerNeedsUpdate(hpa, desired) {
+		hpa.Spec = desired.Spec
+		return ar.client.Update(ctx, hpa)
+	}
+
+	return nil
+}
+
+// deleteObjectIfExists xóa obj theo key nếu tồn tại
+func deleteObjectIfExists(ctx context.Context, c client.Client, key types.NamespacedName, obj client.Object) error {
+	if err := c.Get(ctx, key, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return client.IgnoreNotFound(c.Delete(ctx, obj))
+}
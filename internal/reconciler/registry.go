@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileRegistrySecret đồng bộ Secret "<tên>-registry" mà mọi pod dùng làm imagePullSecrets;
+// bỏ spec.registryCredentials thì xóa Secret
+func (ar *AppReconciler) ReconcileRegistrySecret(ctx context.Context, ms *musicv1.MusicService) error {
+	log := ar.formatter.Logger(ctx, ms, "registry")
+	name := types.NamespacedName{Name: builder.RegistrySecretName(ms), Namespace: builder.WorkloadNamespace(ms)}
+
+	if ms.Spec.RegistryCredentials == nil {
+		return deleteObjectIfExists(ctx, ar.client, name, &corev1.Secret{})
+	}
+
+	dockerConfig, err := ar.registryDockerConfig(ctx, ms)
+	if err != nil {
+		return err
+	}
+	desired := ar.builder.BuildRegistrySecret(ms, dockerConfig)
+
+	secret := &corev1.Secret{}
+	err = ar.client.Get(ctx, name, secret)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating registry Secret"), "Secret", name.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(secret.Data, desired.Data) {
+		log.Info(ar.formatter.Format(ms, "Updating registry Secret"), "Secret", name.Name)
+		secret.Data = desired.Data
+		return ar.client.Update(ctx, secret)
+	}
+
+	return nil
+}
+
+// registryDockerConfig đọc .dockerconfigjson từ secretName hoặc dựng từ server/username/passwordSecretRef;
+// các Secret nguồn luôn nằm trong namespace của MusicService
+func (ar *AppReconciler) registryDockerConfig(ctx context.Context, ms *musicv1.MusicService) ([]byte, error) {
+	creds := ms.Spec.RegistryCredentials
+
+	if creds.SecretName != "" {
+		source := &corev1.Secret{}
+		if err := ar.client.Get(ctx, types.NamespacedName{Name: creds.SecretName, Namespace: ms.Namespace}, source); err != nil {
+			return nil, fmt.Errorf("registry Secret %s: %w", creds.SecretName, err)
+		}
+		data, ok := source.Data[corev1.DockerConfigJsonKey]
+		if !ok {
+			return nil, fmt.Errorf("registry Secret %s has no %s key", creds.SecretName, corev1.DockerConfigJsonKey)
+		}
+		return data, nil
+	}
+
+	if creds.PasswordSecretRef == nil {
+		return nil, fmt.Errorf("registryCredentials.passwordSecretRef is required with server")
+	}
+	source := &corev1.Secret{}
+	if err := ar.client.Get(ctx, types.NamespacedName{Name: creds.PasswordSecretRef.Name, Namespace: ms.Namespace}, source); err != nil {
+		return nil, fmt.Errorf("registry password Secret %s: %w", creds.PasswordSecretRef.Name, err)
+	}
+	password, ok := source.Data[creds.PasswordSecretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("registry password Secret %s has no %s key", creds.PasswordSecretRef.Name, creds.PasswordSecretRef.Key)
+	}
+	return builder.DockerConfigJSON(creds.Server, creds.Username, string(password))
+}
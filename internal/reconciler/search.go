@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileSearch đồng bộ Secret kết nối, Service và StatefulSet của search engine;
+// bỏ spec.search thì xóa chúng nhưng giữ PVC search-data
+func (ar *AppReconciler) ReconcileSearch(ctx context.Context, ms *musicv1.MusicService) error {
+	namespace := builder.WorkloadNamespace(ms)
+	searchName := types.NamespacedName{Name: builder.SearchName(ms), Namespace: namespace}
+	secretName := types.NamespacedName{Name: builder.SearchConnectionSecretName(ms), Namespace: namespace}
+
+	if ms.Spec.Search == nil {
+		if err := deleteObjectIfExists(ctx, ar.client, searchName, &appsv1.StatefulSet{}); err != nil {
+			return err
+		}
+		if err := deleteObjectIfExists(ctx, ar.client, searchName, &corev1.Service{}); err != nil {
+			return err
+		}
+		return deleteObjectIfExists(ctx, ar.client, secretName, &corev1.Secret{})
+	}
+
+	if err := ar.reconcileSearchConnectionSecret(ctx, ms, secretName); err != nil {
+		return err
+	}
+	if err := ar.reconcileSingleNodeService(ctx, ar.builder.BuildSearchService(ms)); err != nil {
+		return err
+	}
+	return ar.reconcileSingleNodeStatefulSet(ctx, ms, "search", ar.builder.BuildSearchStatefulSet(ms), ms.Spec.Search.Storage)
+}
+
+// reconcileSearchConnectionSecret ghi Secret kết nối; API key được sinh một lần và giữ lại giữa các lần reconcile
+// vì Meilisearch chỉ chấp nhận master key đã dùng khi tạo index
+func (ar *AppReconciler) reconcileSearchConnectionSecret(ctx context.Context, ms *musicv1.MusicService, name types.NamespacedName) error {
+	log := ar.formatter.Logger(ctx, ms, "search")
+
+	secret := &corev1.Secret{}
+	err := ar.client.Get(ctx, name, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	var apiKey string
+	if builder.SearchUsesAPIKey(ms) {
+		apiKey = string(secret.Data[builder.SearchAPIKeyKey])
+		if apiKey == "" {
+			if apiKey, err = generatePassword(16); err != nil {
+				return err
+			}
+		}
+	}
+	desired := ar.builder.BuildSearchConnectionSecret(ms, apiKey)
+
+	if !exists {
+		log.Info(ar.formatter.Format(ms, "Creating search connection Secret"), "Secret", name.Name)
+		return ar.client.Create(ctx, desired)
+	}
+	if !reflect.DeepEqual(secret.Data, desired.Data) {
+		log.Info(ar.formatter.Format(ms, "Updating search connection Secret"), "Secret", name.Name)
+		secret.Data = desired.Data
+		return ar.client.Update(ctx, secret)
+	}
+	return nil
+}
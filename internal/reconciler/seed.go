@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ các field seed, xem api/v1/musicservice_types.go (SeedSpec).
+// - Nếu chưa rõ Job nạp nội dung được dựng thế nào, xem pkg/builder/seed.go.
+// - Trạng thái Available chờ seed hoàn tất, xem internal/status/manager.go.
+
+const (
+	seedPhaseRunning   = "Running"
+	seedPhaseCompleted = "Completed"
+	seedPhaseFailed    = "Failed"
+)
+
+// SeedReconciler xử lý các Job nạp nội dung ban đầu vào volume music-data
+type SeedReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+}
+
+// NewSeedReconciler tạo một reconciler mới cho việc nạp nội dung
+func NewSeedReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter) *SeedReconciler {
+	return &SeedReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+	}
+}
+
+// Reconcile tạo một Job nạp nội dung cho mỗi pod ứng dụng và tổng hợp kết quả vào status.seed
+// Khi spec.seed thay đổi, các Job cũ bị xóa và tạo lại với mã băm mới
+func (sr *SeedReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	if ms.Spec.Seed == nil {
+		ms.Status.Seed = nil
+		return nil
+	}
+
+	log := sr.formatter.Logger(ctx, ms, "seed")
+
+	// Số pod thực tế có thể khác spec.replicas khi HPA đang điều chỉnh
+	sts := &appsv1.StatefulSet{}
+	stsName := types.NamespacedName{Name: ms.Name, Namespace: builder.WorkloadNamespace(ms)}
+	if err := sr.client.Get(ctx, stsName, sts); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	hash := builder.SeedHash(ms)
+	status := &musicv1.SeedStatus{Phase: seedPhaseRunning, Hash: hash}
+
+	for ordinal := int32(0); ordinal < replicas; ordinal++ {
+		desired := sr.builder.BuildSeedJob(ms, ordinal)
+		job := &batchv1.Job{}
+		err := sr.client.Get(ctx, client.ObjectKeyFromObject(desired), job)
+		if errors.IsNotFound(err) {
+			log.Info(sr.formatter.Format(ms, "Creating seed Job"), "Job", desired.Name)
+			if err := sr.client.Create(ctx, desired); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Job spec bất biến nên nguồn thay đổi thì xóa Job cũ, lần reconcile sau sẽ tạo lại
+		if job.Annotations[builder.SeedHashAnnotation] != hash {
+			if job.DeletionTimestamp == nil {
+				log.Info(sr.formatter.Format(ms, "Seed sources changed, replacing seed Job"), "Job", job.Name)
+				if err := sr.client.Delete(ctx, job, client.PropagationPolicy("Background")); client.IgnoreNotFound(err) != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if job.Status.Succeeded > 0 {
+			status.CompletedReplicas++
+			continue
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+				status.Phase = seedPhaseFailed
+			}
+		}
+	}
+
+	if status.Phase != seedPhaseFailed && status.CompletedReplicas >= replicas {
+		status.Phase = seedPhaseCompleted
+	}
+	ms.Status.Seed = status
+
+	return nil
+}
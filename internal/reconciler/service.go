@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// syncServiceRouting chép tùy chọn định tuyến topology từ desired sang current, trả về true nếu có thay đổi
+func syncServiceRouting(current, desired *corev1.Service) bool {
+	changed := false
+
+	if !reflect.DeepEqual(current.Spec.TrafficDistribution, desired.Spec.TrafficDistribution) {
+		current.Spec.TrafficDistribution = desired.Spec.TrafficDistribution
+		changed = true
+	}
+
+	mode, wanted := desired.Annotations[builder.TopologyModeAnnotation]
+	if current.Annotations[builder.TopologyModeAnnotation] != mode {
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		if wanted {
+			current.Annotations[builder.TopologyModeAnnotation] = mode
+		} else {
+			delete(current.Annotations, builder.TopologyModeAnnotation)
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+// syncServiceTrafficPolicy chép kiểu Service và traffic policy từ desired sang current, trả về true nếu có thay đổi
+func syncServiceTrafficPolicy(current, desired *corev1.Service) bool {
+	changed := false
+
+	if current.Spec.Type != desired.Spec.Type {
+		current.Spec.Type = desired.Spec.Type
+		// Chuyển về ClusterIP phải bỏ các node port đã cấp phát, nếu không API server từ chối cập nhật
+		if desired.Spec.Type == corev1.ServiceTypeClusterIP {
+			for i := range current.Spec.Ports {
+				current.Spec.Ports[i].NodePort = 0
+			}
+			current.Spec.HealthCheckNodePort = 0
+		}
+		changed = true
+	}
+
+	if current.Spec.ExternalTrafficPolicy != desired.Spec.ExternalTrafficPolicy {
+		current.Spec.ExternalTrafficPolicy = desired.Spec.ExternalTrafficPolicy
+		if desired.Spec.ExternalTrafficPolicy != corev1.ServiceExternalTrafficPolicyLocal {
+			current.Spec.HealthCheckNodePort = 0
+		}
+		changed = true
+	}
+
+	if !reflect.DeepEqual(current.Spec.InternalTrafficPolicy, desired.Spec.InternalTrafficPolicy) {
+		current.Spec.InternalTrafficPolicy = desired.Spec.InternalTrafficPolicy
+		changed = true
+	}
+
+	return changed
+}
+
+// syncServicePorts đồng bộ danh sách port theo tên từ desired sang current: thêm port mới, bỏ port thừa
+// và chép port, targetPort, giữ nguyên node port đã cấp phát; trả về true nếu có thay đổi
+func syncServicePorts(current, desired *corev1.Service) bool {
+	existing := make(map[string]corev1.ServicePort, len(current.Spec.Ports))
+	for _, port := range current.Spec.Ports {
+		existing[port.Name] = port
+	}
+
+	changed := len(current.Spec.Ports) != len(desired.Spec.Ports)
+	ports := make([]corev1.ServicePort, 0, len(desired.Spec.Ports))
+	for _, want := range desired.Spec.Ports {
+		port, ok := existing[want.Name]
+		if !ok {
+			ports = append(ports, want)
+			changed = true
+			continue
+		}
+		if port.Port != want.Port || port.TargetPort != want.TargetPort {
+			port.Port = want.Port
+			port.TargetPort = want.TargetPort
+			changed = true
+		}
+		ports = append(ports, port)
+	}
+
+	if changed {
+		current.Spec.Ports = ports
+	}
+	return changed
+}
+
+// serviceDiff trả về đường dẫn các field Service mà syncServiceRouting, syncServiceTrafficPolicy
+// và syncServicePorts quản lý và current khác desired
+func serviceDiff(current, desired *corev1.Service) []string {
+	var fields []string
+	if !reflect.DeepEqual(current.Spec.TrafficDistribution, desired.Spec.TrafficDistribution) {
+		fields = append(fields, "spec.trafficDistribution")
+	}
+	if current.Annotations[builder.TopologyModeAnnotation] != desired.Annotations[builder.TopologyModeAnnotation] {
+		fields = append(fields, "metadata.annotations["+builder.TopologyModeAnnotation+"]")
+	}
+	if current.Spec.Type != desired.Spec.Type {
+		fields = append(fields, "spec.type")
+	}
+	if current.Spec.ExternalTrafficPolicy != desired.Spec.ExternalTrafficPolicy {
+		fields = append(fields, "spec.externalTrafficPolicy")
+	}
+	if !reflect.DeepEqual(current.Spec.InternalTrafficPolicy, desired.Spec.InternalTrafficPolicy) {
+		fields = append(fields, "spec.internalTrafficPolicy")
+	}
+	// Chạy trên bản sao vì syncServicePorts chép port sang current
+	if syncServicePorts(current.DeepCopy(), desired) {
+		fields = append(fields, "spec.ports")
+	}
+	return fields
+}
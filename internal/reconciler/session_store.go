@@ -0,0 +1,90 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileSessionStore đồng bộ Secret kết nối, Service và StatefulSet của Redis lưu phiên;
+// bỏ spec.sessionStore thì xóa chúng nhưng giữ PVC session-data
+func (ar *AppReconciler) ReconcileSessionStore(ctx context.Context, ms *musicv1.MusicService) error {
+	namespace := builder.WorkloadNamespace(ms)
+	sessionName := types.NamespacedName{Name: builder.SessionStoreName(ms), Namespace: namespace}
+	secretName := types.NamespacedName{Name: builder.SessionStoreConnectionSecretName(ms), Namespace: namespace}
+
+	if ms.Spec.SessionStore == nil {
+		if err := deleteObjectIfExists(ctx, ar.client, sessionName, &appsv1.StatefulSet{}); err != nil {
+			return err
+		}
+		if err := deleteObjectIfExists(ctx, ar.client, sessionName, &corev1.Service{}); err != nil {
+			return err
+		}
+		return deleteObjectIfExists(ctx, ar.client, secretName, &corev1.Secret{})
+	}
+
+	if err := ar.reconcileSessionStoreConnectionSecret(ctx, ms, secretName); err != nil {
+		return err
+	}
+	if err := ar.reconcileSingleNodeService(ctx, ar.builder.BuildSessionStoreService(ms)); err != nil {
+		return err
+	}
+	return ar.reconcileSingleNodeStatefulSet(ctx, ms, "session", ar.builder.BuildSessionStoreStatefulSet(ms), ms.Spec.SessionStore.Storage)
+}
+
+// reconcileSessionStoreConnectionSecret ghi Secret kết nối; mật khẩu được sinh một lần và giữ lại
+// vì Redis chỉ đọc --requirepass khi khởi động
+func (ar *AppReconciler) reconcileSessionStoreConnectionSecret(ctx context.Context, ms *musicv1.MusicService, name types.NamespacedName) error {
+	log := ar.formatter.Logger(ctx, ms, "session")
+
+	secret := &corev1.Secret{}
+	err := ar.client.Get(ctx, name, secret)
+	if err != nil && errors.IsNotFound(err) {
+		password, err := generatePassword(16)
+		if err != nil {
+			return err
+		}
+		log.Info(ar.formatter.Format(ms, "Creating session store connection Secret"), "Secret", name.Name)
+		return ar.client.Create(ctx, ar.builder.BuildSessionStoreConnectionSecret(ms, password))
+	} else if err != nil {
+		return err
+	}
+
+	password := string(secret.Data[builder.ConnectionPasswordKey])
+	if password == "" {
+		if password, err = generatePassword(16); err != nil {
+			return err
+		}
+	}
+
+	desired := ar.builder.BuildSessionStoreConnectionSecret(ms, password)
+	if !reflect.DeepEqual(secret.Data, desired.Data) {
+		log.Info(ar.formatter.Format(ms, "Updating session store connection Secret"), "Secret", name.Name)
+		secret.Data = desired.Data
+		return ar.client.Update(ctx, secret)
+	}
+	return nil
+}
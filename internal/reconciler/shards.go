@@ -0,0 +1,187 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// ReconcileShards đồng bộ StatefulSet, Service và HPA của từng shard trong spec.shards,
+// sau đó xóa tài nguyên của shard không còn trong spec (PVC music-data của shard được giữ lại);
+// draining giữ số replica hiện tại của các shard khi ReconcileDrain còn pod đang rút kết nối
+func (ar *AppReconciler) ReconcileShards(ctx context.Context, ms *musicv1.MusicService, draining bool) error {
+	for i := range ms.Spec.Shards {
+		if err := ar.reconcileShardService(ctx, ms, i); err != nil {
+			return err
+		}
+		if err := ar.reconcileShardStatefulSet(ctx, ms, i, draining); err != nil {
+			return err
+		}
+		if err := ar.reconcileShardAutoscaler(ctx, ms, i); err != nil {
+			return err
+		}
+	}
+	return ar.deleteStaleShards(ctx, ms)
+}
+
+func (ar *AppReconciler) reconcileShardService(ctx context.Context, ms *musicv1.MusicService, index int) error {
+	log := ar.formatter.Logger(ctx, ms, "shard")
+	desired := ar.builder.BuildAppShardService(ms, index)
+
+	service := &corev1.Service{}
+	err := ar.client.Get(ctx, client.ObjectKeyFromObject(desired), service)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating shard Service"), "Service", desired.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	routingChanged := syncServiceRouting(service, desired)
+	portsChanged := syncServicePorts(service, desired)
+	if syncServiceTrafficPolicy(service, desired) || routingChanged || portsChanged {
+		log.Info(ar.formatter.Format(ms, "Updating shard Service routing"), "Service", desired.Name)
+		return ar.client.Update(ctx, service)
+	}
+	return nil
+}
+
+func (ar *AppReconciler) reconcileShardStatefulSet(ctx context.Context, ms *musicv1.MusicService, index int, draining bool) error {
+	log := ar.formatter.Logger(ctx, ms, "shard")
+	shard := ms.Spec.Shards[index]
+	desired := ar.builder.BuildAppShardStatefulSet(ms, index)
+	if err := ar.applyConfigChecksum(ctx, ms, &desired.Spec.Template); err != nil {
+		return err
+	}
+
+	sts := &appsv1.StatefulSet{}
+	err := ar.client.Get(ctx, client.ObjectKeyFromObject(desired), sts)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating shard StatefulSet"), "StatefulSet", desired.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	// Khi shard có HPA, số replica do HPA quyết định nên giữ nguyên giá trị hiện tại;
+	// khi đang rút kết nối thì chưa giảm replica
+	if builder.AutoscalingEnabled(shard.Autoscaling) || (draining && *desired.Spec.Replicas < *sts.Spec.Replicas) {
+		desired.Spec.Replicas = sts.Spec.Replicas
+	}
+
+	if storageSizeChanged(sts, desired) {
+		if storageUpdatePolicy(appStorageSpec(ms)) == musicv1.StorageUpdatePolicyRecreate {
+			log.Info(ar.formatter.Format(ms, "Recreating shard StatefulSet and PVCs due to storage size change"), "StatefulSet", desired.Name)
+			return recreateStorage(ctx, ar.client, ar.builder, ms, sts, "music-data", desired.Name, ms.Spec.Storage)
+		}
+		if err := resizePVCs(ctx, ar.client, "music-data", desired.Name, desired); err != nil {
+			return err
+		}
+	}
+
+	if err := syncPVCMetadata(ctx, ar.client, "music-data", desired.Name, desired); err != nil {
+		return err
+	}
+
+	// podManagementPolicy bất biến: xóa StatefulSet nhưng giữ pod và PVC như với StatefulSet ứng dụng
+	if sts.Spec.PodManagementPolicy != desired.Spec.PodManagementPolicy {
+		log.Info(ar.formatter.Format(ms, "Recreating shard StatefulSet to change podManagementPolicy"), "StatefulSet", desired.Name)
+		return client.IgnoreNotFound(ar.client.Delete(ctx, sts, client.PropagationPolicy(metav1.DeletePropagationOrphan)))
+	}
+
+	if statefulSetNeedsUpdate(sts, desired) {
+		log.Info(ar.formatter.Format(ms, "Updating shard StatefulSet"), "StatefulSet", desired.Name)
+		updateStatefulSetSpec(sts, desired)
+		return ar.client.Update(ctx, sts)
+	}
+	return nil
+}
+
+func (ar *AppReconciler) reconcileShardAutoscaler(ctx context.Context, ms *musicv1.MusicService, index int) error {
+	log := ar.formatter.Logger(ctx, ms, "shard")
+	shard := ms.Spec.Shards[index]
+	name := types.NamespacedName{Name: builder.ShardAutoscalerName(ms, shard.Name), Namespace: builder.WorkloadNamespace(ms)}
+
+	if !builder.AutoscalingEnabled(shard.Autoscaling) {
+		return deleteObjectIfExists(ctx, ar.client, name, &autoscalingv2.HorizontalPodAutoscaler{})
+	}
+
+	desired := ar.builder.BuildAppShardAutoscaler(ms, index)
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := ar.client.Get(ctx, name, hpa)
+	if err != nil && errors.IsNotFound(err) {
+		log.Info(ar.formatter.Format(ms, "Creating shard HorizontalPodAutoscaler"), "HPA", name.Name)
+		return ar.client.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	if autoscalerNeedsUpdate(hpa, desired) {
+		log.Info(ar.formatter.Format(ms, "Updating shard HorizontalPodAutoscaler"), "HPA", name.Name)
+		hpa.Spec = desired.Spec
+		return ar.client.Update(ctx, hpa)
+	}
+	return nil
+}
+
+// deleteStaleShards xóa StatefulSet, Service và HPA mang nhãn shard không còn trong spec.shards
+func (ar *AppReconciler) deleteStaleShards(ctx context.Context, ms *musicv1.MusicService) error {
+	log := ar.formatter.Logger(ctx, ms, "shard")
+	wanted := map[string]bool{}
+	for _, shard := range ms.Spec.Shards {
+		wanted[shard.Name] = true
+	}
+
+	lists := []client.ObjectList{
+		&appsv1.StatefulSetList{},
+		&corev1.ServiceList{},
+		&autoscalingv2.HorizontalPodAutoscalerList{},
+	}
+	for _, list := range lists {
+		if err := ar.client.List(ctx, list, client.InNamespace(builder.WorkloadNamespace(ms)),
+			client.MatchingLabels{"app": ms.Name}, client.HasLabels{builder.ShardLabel}); err != nil {
+			return err
+		}
+		objects, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+		for _, item := range objects {
+			obj, ok := item.(client.Object)
+			if !ok || wanted[obj.GetLabels()[builder.ShardLabel]] || obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			log.Info(ar.formatter.Format(ms, "Deleting removed shard resource"), "name", obj.GetName())
+			if err := ar.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
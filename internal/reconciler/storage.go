@@ -23,10 +23,15 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
 )
 
 // Hướng dẫn đọc nhanh:
@@ -67,6 +72,55 @@ func recreateStatefulSetStorage(ctx context.Context, c client.Client, sts *appsv
 	return deletePVCsByPrefix(ctx, c, claimName, appName, sts.Namespace)
 }
 
+// recreateStorage áp dụng updatePolicy Recreate; với spec.snapshots.beforeRecreate, PVC chỉ bị xóa
+// sau khi mọi PVC đã có VolumeSnapshot readyToUse, trong lúc chờ trả về lỗi để controller thử lại
+func recreateStorage(ctx context.Context, c client.Client, b *builder.ResourceBuilder, ms *musicv1.MusicService,
+	sts *appsv1.StatefulSet, claimName, appName string, storage *musicv1.StorageSpec) error {
+	if builder.SnapshotsBeforeRecreate(ms) {
+		pending, err := snapshotPVCs(ctx, c, b, ms, claimName, appName, sts.Namespace, builder.VolumeSnapshotClassName(ms, storage))
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("waiting for VolumeSnapshots %s to become ready before recreating storage", strings.Join(pending, ", "))
+		}
+	}
+
+	return recreateStatefulSetStorage(ctx, c, sts, claimName, appName)
+}
+
+// snapshotPVCs tạo VolumeSnapshot cho từng PVC còn thiếu và trả về tên các snapshot chưa readyToUse
+func snapshotPVCs(ctx context.Context, c client.Client, b *builder.ResourceBuilder, ms *musicv1.MusicService,
+	claimName, appName, namespace string, className *string) ([]string, error) {
+	pvcs, err := listPVCsByPrefix(ctx, c, claimName, appName, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for i := range pvcs {
+		desired := b.BuildPVCSnapshot(ms, &pvcs[i], className)
+		snapshot := &unstructured.Unstructured{}
+		snapshot.SetGroupVersionKind(builder.VolumeSnapshotGVK)
+		err := c.Get(ctx, client.ObjectKeyFromObject(desired), snapshot)
+		if errors.IsNotFound(err) {
+			if err := c.Create(ctx, desired); err != nil {
+				return nil, err
+			}
+			pending = append(pending, desired.GetName())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse"); !ready {
+			pending = append(pending, snapshot.GetName())
+		}
+	}
+
+	return pending, nil
+}
+
 func resizePVCs(ctx context.Context, c client.Client, claimName, appName string, desired *appsv1.StatefulSet) error {
 	desiredSize, hasDesired := storageRequestFromStatefulSet(desired)
 	if !hasDesired {
@@ -86,6 +140,14 @@ func resizePVCs(ctx context.Context, c client.Client, claimName, appName string,
 		if currentSize.Cmp(desiredSize) >= 0 {
 			continue
 		}
+		// The API server rejects the update anyway; the status reports ExpansionNotSupported instead
+		allowed, err := storageClassAllowsExpansion(ctx, c, &pvc)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			continue
+		}
 		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desiredSize
 		if err := c.Update(ctx, &pvc); err != nil {
 			return err
@@ -95,6 +157,83 @@ func resizePVCs(ctx context.Context, c client.Client, claimName, appName string,
 	return nil
 }
 
+// syncPVCMetadata gắn label/annotation của volumeClaimTemplate lên các PVC đã tạo;
+// StatefulSet không tự cập nhật PVC có sẵn và không cho sửa volumeClaimTemplates.
+// Chỉ thêm hoặc ghi đè key, không xóa key do công cụ khác gắn
+func syncPVCMetadata(ctx context.Context, c client.Client, claimName, appName string, desired *appsv1.StatefulSet) error {
+	var template *corev1.PersistentVolumeClaim
+	for i := range desired.Spec.VolumeClaimTemplates {
+		if desired.Spec.VolumeClaimTemplates[i].Name == claimName {
+			template = &desired.Spec.VolumeClaimTemplates[i]
+		}
+	}
+	if template == nil || (len(template.Labels) == 0 && len(template.Annotations) == 0) {
+		return nil
+	}
+
+	pvcs, err := listPVCsByPrefix(ctx, c, claimName, appName, desired.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, pvc := range pvcs {
+		labels, labelsChanged := mergeMetadata(pvc.Labels, template.Labels)
+		annotations, annotationsChanged := mergeMetadata(pvc.Annotations, template.Annotations)
+		if !labelsChanged && !annotationsChanged {
+			continue
+		}
+		pvc.Labels = labels
+		pvc.Annotations = annotations
+		if err := c.Update(ctx, &pvc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeMetadata ghi các key của desired lên current và cho biết có thay đổi không
+func mergeMetadata(current, desired map[string]string) (map[string]string, bool) {
+	changed := false
+	for key, value := range desired {
+		if existing, ok := current[key]; ok && existing == value {
+			continue
+		}
+		if current == nil {
+			current = map[string]string{}
+		}
+		current[key] = value
+		changed = true
+	}
+	return current, changed
+}
+
+// updateStatefulSetSpec chép spec mong muốn nhưng giữ volumeClaimTemplates hiện tại vì API server từ chối sửa field này
+func updateStatefulSetSpec(current, desired *appsv1.StatefulSet) {
+	templates := current.Spec.VolumeClaimTemplates
+	replicationUser, rotated := current.Spec.Template.Annotations[builder.ReplicationUserAnnotation]
+	current.Spec = desired.Spec
+	current.Spec.VolumeClaimTemplates = templates
+	// User replication do bước xoay vòng ghi lên pod template, giữ lại để không khởi động lại replica lần nữa
+	if rotated {
+		if current.Spec.Template.Annotations == nil {
+			current.Spec.Template.Annotations = map[string]string{}
+		}
+		current.Spec.Template.Annotations[builder.ReplicationUserAnnotation] = replicationUser
+	}
+}
+
+func storageClassAllowsExpansion(ctx context.Context, c client.Client, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+	sc := &storagev1.StorageClass{}
+	if err := c.Get(ctx, types.NamespacedName{Name: *pvc.Spec.StorageClassName}, sc); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion, nil
+}
+
 func deletePVCsByPrefix(ctx context.Context, c client.Client, claimName, appName, namespace string) error {
 	pvcs, err := listPVCsByPrefix(ctx, c, claimName, appName, namespace)
 	if err != nil {
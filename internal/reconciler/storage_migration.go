@@ -0,0 +1,371 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ updatePolicy Migrate, xem api/v1/musicservice_types.go (StorageSpec, StorageMigrationStatus).
+// - Nếu chưa rõ các Job sao lưu/khôi phục/kiểm tra, xem pkg/builder/storage_migration.go.
+// - Trong lúc co nhỏ, controller bỏ qua StatefulSet ứng dụng, xem internal/controller/musicservice_controller.go.
+
+const (
+	migrationPhaseQuiescing  = "Quiescing"
+	migrationPhaseBackingUp  = "BackingUp"
+	migrationPhaseRecreating = "Recreating"
+	migrationPhaseRestoring  = "Restoring"
+	migrationPhaseVerifying  = "Verifying"
+	migrationPhaseCopying    = "Copying"
+	migrationPhaseCompleted  = "Completed"
+	migrationPhaseFailed     = "Failed"
+)
+
+// StorageMigrationReconciler co nhỏ PVC music-data bằng máy trạng thái:
+// Quiescing -> BackingUp -> Recreating -> Restoring -> Verifying -> Completed
+// và chuyển giữa PVC riêng từng pod với PVC dùng chung: Quiescing -> Copying -> Completed
+type StorageMigrationReconciler struct {
+	client    client.Client
+	builder   *builder.ResourceBuilder
+	formatter *tone.Formatter
+}
+
+// NewStorageMigrationReconciler tạo một reconciler mới cho việc co nhỏ lưu trữ
+func NewStorageMigrationReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter) *StorageMigrationReconciler {
+	return &StorageMigrationReconciler{
+		client:    c,
+		builder:   b,
+		formatter: f,
+	}
+}
+
+// Reconcile tiến thêm một bước của quá trình co nhỏ và ghi kết quả vào status.storageMigration
+// Trả về true khi quá trình đang chạy; lúc đó controller không được đụng tới StatefulSet ứng dụng
+func (sr *StorageMigrationReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	migration := ms.Status.StorageMigration
+	if migration == nil || migration.Phase == migrationPhaseCompleted {
+		return sr.start(ctx, ms)
+	}
+
+	log := sr.formatter.Logger(ctx, ms, "storage-migration")
+	namespace := builder.WorkloadNamespace(ms)
+
+	switch migration.Phase {
+	case migrationPhaseQuiescing:
+		// Xóa StatefulSet (giữ PVC) và chờ pod dừng hẳn để dữ liệu không còn bị ghi
+		sts := &appsv1.StatefulSet{}
+		err := sr.client.Get(ctx, types.NamespacedName{Name: ms.Name, Namespace: namespace}, sts)
+		if errors.IsNotFound(err) {
+			if migration.AccessMode != "" {
+				sr.advance(ms, migrationPhaseCopying, "Copying music-data into "+string(migration.AccessMode)+" volumes")
+			} else {
+				sr.advance(ms, migrationPhaseBackingUp, "Backing up music-data volumes")
+			}
+			return true, nil
+		}
+		if err != nil {
+			return true, err
+		}
+		if sts.DeletionTimestamp == nil {
+			log.Info(sr.formatter.Format(ms, "Stopping app StatefulSet before migrating storage"), "StatefulSet", sts.Name)
+			return true, client.IgnoreNotFound(sr.client.Delete(ctx, sts, client.PropagationPolicy(metav1.DeletePropagationForeground)))
+		}
+		return true, nil
+
+	case migrationPhaseBackingUp:
+		target, err := resource.ParseQuantity(migration.TargetSize)
+		if err != nil {
+			return true, err
+		}
+		staging := sr.builder.BuildStorageMigrationPVC(ms, target, migration.Replicas)
+		if err := sr.client.Get(ctx, client.ObjectKeyFromObject(staging), &corev1.PersistentVolumeClaim{}); errors.IsNotFound(err) {
+			log.Info(sr.formatter.Format(ms, "Creating storage migration PVC"), "PVC", staging.Name)
+			return true, sr.client.Create(ctx, staging)
+		} else if err != nil {
+			return true, err
+		}
+		return true, sr.runStep(ctx, ms, builder.StorageMigrationBackup, migrationPhaseRecreating, "Recreating music-data volumes at "+migration.TargetSize)
+
+	case migrationPhaseRecreating:
+		target, err := resource.ParseQuantity(migration.TargetSize)
+		if err != nil {
+			return true, err
+		}
+		// PVC cũ phải bị xóa hẳn (finalizer pvc-protection) rồi mới tạo PVC mới cùng tên
+		pending := false
+		for ordinal := int32(0); ordinal < migration.Replicas; ordinal++ {
+			desired := sr.builder.BuildAppDataPVC(ms, ordinal, target)
+			pvc := &corev1.PersistentVolumeClaim{}
+			err := sr.client.Get(ctx, client.ObjectKeyFromObject(desired), pvc)
+			if errors.IsNotFound(err) {
+				log.Info(sr.formatter.Format(ms, "Creating resized music-data PVC"), "PVC", desired.Name)
+				if err := sr.client.Create(ctx, desired); err != nil {
+					return true, err
+				}
+				continue
+			}
+			if err != nil {
+				return true, err
+			}
+			if size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; size.Cmp(target) == 0 && pvc.DeletionTimestamp == nil {
+				continue
+			}
+			pending = true
+			if pvc.DeletionTimestamp == nil {
+				log.Info(sr.formatter.Format(ms, "Deleting music-data PVC for shrink"), "PVC", pvc.Name)
+				if err := sr.client.Delete(ctx, pvc); client.IgnoreNotFound(err) != nil {
+					return true, err
+				}
+			}
+		}
+		if !pending {
+			sr.advance(ms, migrationPhaseRestoring, "Restoring data into the resized volumes")
+		}
+		return true, nil
+
+	case migrationPhaseRestoring:
+		return true, sr.runStep(ctx, ms, builder.StorageMigrationRestore, migrationPhaseVerifying, "Verifying restored data")
+
+	case migrationPhaseVerifying:
+		if err := sr.runStep(ctx, ms, builder.StorageMigrationVerify, migrationPhaseCompleted,
+			fmt.Sprintf("Storage shrunk from %s to %s", migration.FromSize, migration.TargetSize)); err != nil {
+			return true, err
+		}
+		if migration.Phase == migrationPhaseCompleted {
+			log.Info(sr.formatter.Format(ms, "Storage shrink completed, cleaning up"), "from", migration.FromSize, "to", migration.TargetSize)
+			return true, sr.cleanup(ctx, ms)
+		}
+		return true, nil
+
+	case migrationPhaseCopying:
+		// PVC đích phải có trước Job; PVC dùng chung do AppReconciler.ReconcileSharedStorage tạo
+		if migration.AccessMode == musicv1.StorageAccessModeReadWriteOnce {
+			size, err := resource.ParseQuantity(ms.Spec.Storage.Size)
+			if err != nil {
+				return true, err
+			}
+			for ordinal := int32(0); ordinal < migration.Replicas; ordinal++ {
+				desired := sr.builder.BuildAppDataPVC(ms, ordinal, size)
+				if err := sr.client.Get(ctx, client.ObjectKeyFromObject(desired), &corev1.PersistentVolumeClaim{}); errors.IsNotFound(err) {
+					log.Info(sr.formatter.Format(ms, "Creating music-data PVC"), "PVC", desired.Name)
+					if err := sr.client.Create(ctx, desired); err != nil {
+						return true, err
+					}
+				} else if err != nil {
+					return true, err
+				}
+			}
+		}
+		if err := sr.runStep(ctx, ms, builder.StorageMigrationCopy, migrationPhaseCompleted,
+			"music-data moved to "+string(migration.AccessMode)+" volumes; the previous volumes are kept"); err != nil {
+			return true, err
+		}
+		if migration.Phase == migrationPhaseCompleted {
+			log.Info(sr.formatter.Format(ms, "Storage access mode change completed, cleaning up"), "accessMode", migration.AccessMode)
+			return true, sr.cleanup(ctx, ms)
+		}
+		return true, nil
+
+	case migrationPhaseFailed:
+		// Người dùng xóa Job lỗi để chạy lại đúng bước đó
+		step := migrationStep(migration.FailedPhase)
+		if step == "" {
+			return true, nil
+		}
+		err := sr.client.Get(ctx, types.NamespacedName{Name: builder.StorageMigrationJobName(ms, step), Namespace: namespace}, &batchv1.Job{})
+		if errors.IsNotFound(err) {
+			log.Info(sr.formatter.Format(ms, "Failed storage migration Job removed, retrying"), "phase", migration.FailedPhase)
+			sr.advance(ms, migration.FailedPhase, "Retrying "+step)
+			return true, nil
+		}
+		return true, err
+	}
+
+	return true, nil
+}
+
+// start bắt đầu quá trình khi spec.storage.accessMode khác chế độ của StatefulSet hiện tại,
+// hoặc khi updatePolicy là Migrate và spec.storage.size nhỏ hơn PVC hiện tại
+func (sr *StorageMigrationReconciler) start(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	if ms.Spec.Storage == nil || builder.AppUsesDeployment(ms) {
+		return false, nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	if err := sr.client.Get(ctx, types.NamespacedName{Name: ms.Name, Namespace: builder.WorkloadNamespace(ms)}, sts); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if sts.DeletionTimestamp != nil {
+		return false, nil
+	}
+	if shared := builder.AppSharedStorage(ms); shared != statefulSetUsesSharedStorage(sts) {
+		return sr.startAccessModeChange(ctx, ms, sts)
+	}
+
+	if storageUpdatePolicy(appStorageSpec(ms)) != musicv1.StorageUpdatePolicyMigrate {
+		return false, nil
+	}
+	current, ok := storageRequestFromStatefulSet(sts)
+	if !ok {
+		return false, nil
+	}
+	target, err := resource.ParseQuantity(ms.Spec.Storage.Size)
+	if err != nil || target.Cmp(current) >= 0 {
+		return false, err
+	}
+
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if replicas == 0 {
+		return false, nil
+	}
+
+	now := metav1.Now()
+	sr.formatter.Logger(ctx, ms, "storage-migration").Info(sr.formatter.Format(ms, "Starting storage shrink"),
+		"from", current.String(), "to", target.String(), "volumes", replicas)
+	ms.Status.StorageMigration = &musicv1.StorageMigrationStatus{
+		Phase:      migrationPhaseQuiescing,
+		FromSize:   current.String(),
+		TargetSize: target.String(),
+		Replicas:   replicas,
+		StartTime:  &now,
+		Message:    "Stopping app pods before backing up music-data",
+	}
+	return true, nil
+}
+
+// startAccessModeChange dừng ứng dụng để chép music-data sang PVC của accessMode mới;
+// khi tách PVC chung ra, mỗi replica hiện tại nhận một bản sao đầy đủ
+func (sr *StorageMigrationReconciler) startAccessModeChange(ctx context.Context, ms *musicv1.MusicService, sts *appsv1.StatefulSet) (bool, error) {
+	accessMode := musicv1.StorageAccessModeReadWriteOnce
+	if builder.AppSharedStorage(ms) {
+		accessMode = musicv1.StorageAccessModeReadWriteMany
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil && *sts.Spec.Replicas > 0 {
+		replicas = *sts.Spec.Replicas
+	}
+
+	now := metav1.Now()
+	sr.formatter.Logger(ctx, ms, "storage-migration").Info(sr.formatter.Format(ms, "Starting storage access mode change"),
+		"accessMode", accessMode, "volumes", replicas)
+	ms.Status.StorageMigration = &musicv1.StorageMigrationStatus{
+		Phase:      migrationPhaseQuiescing,
+		AccessMode: accessMode,
+		Replicas:   replicas,
+		StartTime:  &now,
+		Message:    "Stopping app pods before copying music-data",
+	}
+	return true, nil
+}
+
+// statefulSetUsesSharedStorage cho biết StatefulSet hiện tại mount PVC music-data dùng chung thay vì volumeClaimTemplates
+func statefulSetUsesSharedStorage(sts *appsv1.StatefulSet) bool {
+	for _, volume := range sts.Spec.Template.Spec.Volumes {
+		if volume.Name == "music-data" && volume.PersistentVolumeClaim != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// runStep tạo Job của bước hiện tại nếu chưa có và chuyển sang next khi Job thành công
+func (sr *StorageMigrationReconciler) runStep(ctx context.Context, ms *musicv1.MusicService, step, next, message string) error {
+	migration := ms.Status.StorageMigration
+	desired := sr.builder.BuildStorageMigrationJob(ms, step, migration.Replicas)
+
+	job := &batchv1.Job{}
+	err := sr.client.Get(ctx, client.ObjectKeyFromObject(desired), job)
+	if errors.IsNotFound(err) {
+		sr.formatter.Logger(ctx, ms, "storage-migration").Info(sr.formatter.Format(ms, "Creating storage migration Job"), "Job", desired.Name)
+		return sr.client.Create(ctx, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	if job.Status.Succeeded > 0 {
+		sr.advance(ms, next, message)
+		return nil
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			migration.FailedPhase = migration.Phase
+			migration.Phase = migrationPhaseFailed
+			migration.Message = fmt.Sprintf("Job %s failed: %s; delete the Job to retry this step", job.Name, cond.Message)
+		}
+	}
+	return nil
+}
+
+// cleanup xóa các Job và PVC trung gian sau khi dữ liệu đã được kiểm tra
+func (sr *StorageMigrationReconciler) cleanup(ctx context.Context, ms *musicv1.MusicService) error {
+	namespace := builder.WorkloadNamespace(ms)
+	for _, step := range []string{builder.StorageMigrationBackup, builder.StorageMigrationRestore, builder.StorageMigrationVerify, builder.StorageMigrationCopy} {
+		job := &batchv1.Job{}
+		if err := sr.client.Get(ctx, types.NamespacedName{Name: builder.StorageMigrationJobName(ms, step), Namespace: namespace}, job); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		if err := sr.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return deleteObjectIfExists(ctx, sr.client,
+		types.NamespacedName{Name: builder.StorageMigrationName(ms), Namespace: namespace}, &corev1.PersistentVolumeClaim{})
+}
+
+func (sr *StorageMigrationReconciler) advance(ms *musicv1.MusicService, phase, message string) {
+	ms.Status.StorageMigration.Phase = phase
+	ms.Status.StorageMigration.FailedPhase = ""
+	ms.Status.StorageMigration.Message = message
+}
+
+// migrationStep trả về bước Job ứng với một phase, hoặc chuỗi rỗng nếu phase không chạy Job
+func migrationStep(phase string) string {
+	switch phase {
+	case migrationPhaseBackingUp:
+		return builder.StorageMigrationBackup
+	case migrationPhaseRestoring:
+		return builder.StorageMigrationRestore
+	case migrationPhaseVerifying:
+		return builder.StorageMigrationVerify
+	case migrationPhaseCopying:
+		return builder.StorageMigrationCopy
+	}
+	return ""
+}
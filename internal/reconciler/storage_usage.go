@@ -0,0 +1,239 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/internal/volumestats"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// defaultAutoGrowThresholdPercent khớp với giá trị mặc định của thresholdPercent trong CRD
+const defaultAutoGrowThresholdPercent = 80
+
+// storageUsageTarget là một nhóm PVC (theo tiền tố claimName-appName-) cùng dùng một StorageSpec
+type storageUsageTarget struct {
+	component string
+	claimName string
+	appName   string
+	storage   *musicv1.StorageSpec
+}
+
+// StorageUsageReconciler đọc mức sử dụng PVC music-data và db-data từ kubelet, ghi vào status.volumes
+// và mở rộng PVC khi vượt ngưỡng autoGrow.
+// PVC được mở rộng trực tiếp (không qua volumeClaimTemplates) nên có thể lớn hơn spec.*.storage.size
+type StorageUsageReconciler struct {
+	client    client.Client
+	formatter *tone.Formatter
+	collector *volumestats.Collector
+	recorder  record.EventRecorder
+}
+
+// NewStorageUsageReconciler tạo một reconciler mới cho mức sử dụng PVC; collector nil nghĩa là không đọc được mức sử dụng
+func NewStorageUsageReconciler(c client.Client, f *tone.Formatter, collector *volumestats.Collector, r record.EventRecorder) *StorageUsageReconciler {
+	return &StorageUsageReconciler{
+		client:    c,
+		formatter: f,
+		collector: collector,
+		recorder:  r,
+	}
+}
+
+// Reconcile ghi mức sử dụng từng PVC vào status.volumes, rồi mở rộng PVC có mức sử dụng vượt
+// autoGrow.thresholdPercent thêm step, tối đa maxSize.
+// Lỗi khi đọc stats từ kubelet chỉ được ghi log và giữ nguyên status.volumes để không chặn các bước reconcile còn lại
+func (sr *StorageUsageReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	log := sr.formatter.Logger(ctx, ms, "storage-usage")
+
+	targets := storageUsageTargets(ms)
+	if len(targets) == 0 {
+		ms.Status.Volumes = nil
+		return nil
+	}
+
+	namespace := builder.WorkloadNamespace(ms)
+	var pvcs []corev1.PersistentVolumeClaim
+	var owners []storageUsageTarget
+	for _, target := range targets {
+		found, err := listPVCsByPrefix(ctx, sr.client, target.claimName, target.appName, namespace)
+		if err != nil {
+			return err
+		}
+		for range found {
+			owners = append(owners, target)
+		}
+		pvcs = append(pvcs, found...)
+	}
+
+	usage, err := sr.volumeUsage(ctx, namespace, pvcs)
+	if err != nil {
+		log.Error(err, "failed to read volume usage from kubelet")
+		return nil
+	}
+
+	var volumes []musicv1.VolumeUsageStatus
+	for i := range pvcs {
+		u, ok := usage[types.NamespacedName{Namespace: namespace, Name: pvcs[i].Name}]
+		if !ok {
+			continue
+		}
+		volumes = append(volumes, musicv1.VolumeUsageStatus{
+			Component:     owners[i].component,
+			PVC:           pvcs[i].Name,
+			UsedBytes:     u.UsedBytes,
+			CapacityBytes: u.CapacityBytes,
+			UsedPercent:   u.Percent(),
+		})
+		if owners[i].storage.AutoGrow == nil {
+			continue
+		}
+		if err := sr.grow(ctx, ms, &pvcs[i], u, owners[i].storage.AutoGrow); err != nil {
+			return err
+		}
+	}
+	ms.Status.Volumes = volumes
+
+	return nil
+}
+
+// volumeUsage đọc stats/summary của các node đang chạy pod mount một trong các PVC
+func (sr *StorageUsageReconciler) volumeUsage(ctx context.Context, namespace string,
+	pvcs []corev1.PersistentVolumeClaim) (map[types.NamespacedName]volumestats.Usage, error) {
+	claims := make(map[string]bool, len(pvcs))
+	for _, pvc := range pvcs {
+		claims[pvc.Name] = true
+	}
+
+	pods := &corev1.PodList{}
+	if err := sr.client.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	nodes := map[string]bool{}
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && claims[volume.PersistentVolumeClaim.ClaimName] {
+				nodes[pod.Spec.NodeName] = true
+			}
+		}
+	}
+
+	usage := map[types.NamespacedName]volumestats.Usage{}
+	for node := range nodes {
+		nodeUsage, err := sr.collector.NodeUsage(ctx, node)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", node, err)
+		}
+		for name, u := range nodeUsage {
+			usage[name] = u
+		}
+	}
+	return usage, nil
+}
+
+// grow mở rộng một PVC nếu mức sử dụng vượt ngưỡng và lần mở rộng trước đã hoàn tất
+func (sr *StorageUsageReconciler) grow(ctx context.Context, ms *musicv1.MusicService,
+	pvc *corev1.PersistentVolumeClaim, usage volumestats.Usage, autoGrow *musicv1.StorageAutoGrowSpec) error {
+	threshold := autoGrow.ThresholdPercent
+	if threshold == 0 {
+		threshold = defaultAutoGrowThresholdPercent
+	}
+	percent := usage.Percent()
+	if percent < threshold {
+		return nil
+	}
+
+	request, hasRequest := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !hasRequest {
+		return nil
+	}
+	// Chờ lần mở rộng trước xong, nếu không kubelet vẫn báo dung lượng cũ và PVC bị cộng step liên tục
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; !ok || request.Cmp(capacity) > 0 {
+		return nil
+	}
+
+	// Webhook đã kiểm tra định dạng; giá trị sai ở đây nghĩa là webhook bị tắt
+	step, err := resource.ParseQuantity(autoGrow.Step)
+	if err != nil {
+		return fmt.Errorf("invalid autoGrow.step %q: %w", autoGrow.Step, err)
+	}
+	maxSize, err := resource.ParseQuantity(autoGrow.MaxSize)
+	if err != nil {
+		return fmt.Errorf("invalid autoGrow.maxSize %q: %w", autoGrow.MaxSize, err)
+	}
+
+	if request.Cmp(maxSize) >= 0 {
+		sr.recorder.Event(ms, corev1.EventTypeWarning, "StorageAutoGrowLimitReached", sr.formatter.Format(ms,
+			fmt.Sprintf("PVC %s is %d%% full and already at autoGrow.maxSize %s", pvc.Name, percent, maxSize.String())))
+		return nil
+	}
+
+	allowed, err := storageClassAllowsExpansion(ctx, sr.client, pvc)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		sr.recorder.Event(ms, corev1.EventTypeWarning, "StorageAutoGrowUnsupported", sr.formatter.Format(ms,
+			fmt.Sprintf("PVC %s is %d%% full but its StorageClass does not allow volume expansion", pvc.Name, percent)))
+		return nil
+	}
+
+	newSize := request.DeepCopy()
+	newSize.Add(step)
+	if newSize.Cmp(maxSize) > 0 {
+		newSize = maxSize
+	}
+
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = newSize
+	if err := sr.client.Update(ctx, pvc); err != nil {
+		return err
+	}
+
+	sr.recorder.Event(ms, corev1.EventTypeNormal, "StorageAutoGrown", sr.formatter.Format(ms,
+		fmt.Sprintf("Expanding PVC %s from %s to %s (%d%% used)", pvc.Name, request.String(), newSize.String(), percent)))
+	return nil
+}
+
+// storageUsageTargets trả về các nhóm PVC dữ liệu của MusicService
+func storageUsageTargets(ms *musicv1.MusicService) []storageUsageTarget {
+	var targets []storageUsageTarget
+	if ms.Spec.Storage != nil {
+		targets = append(targets, storageUsageTarget{component: "app", claimName: "music-data", appName: ms.Name, storage: ms.Spec.Storage})
+	}
+
+	db := ms.Spec.Database
+	if db == nil || !db.Enabled || db.Storage == nil {
+		return targets
+	}
+	for _, component := range []string{"db-master", "db-replica", "db-galera"} {
+		targets = append(targets, storageUsageTarget{component: component, claimName: "db-data", appName: ms.Name + "-" + component, storage: db.Storage})
+	}
+	return targets
+}
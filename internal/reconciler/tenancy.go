@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/tone"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ các field tenancy, xem api/v1/musicservice_types.go (TenancySpec).
+// - Nếu chưa rõ namespace/quota/NetworkPolicy được dựng thế nào, xem pkg/builder/tenancy.go.
+// - Management namespace được cấu hình bằng cờ --management-namespace trong cmd/main.go.
+
+// TenancyReconciler xử lý việc tạo namespace tenant, ResourceQuota và NetworkPolicy cho chế độ Dedicated
+type TenancyReconciler struct {
+	client              client.Client
+	builder             *builder.ResourceBuilder
+	formatter           *tone.Formatter
+	managementNamespace string
+}
+
+// NewTenancyReconciler tạo một reconciler mới cho tenancy; managementNamespace rỗng nghĩa là tắt chế độ Dedicated
+func NewTenancyReconciler(c client.Client, b *builder.ResourceBuilder, f *tone.Formatter, managementNamespace string) *TenancyReconciler {
+	return &TenancyReconciler{
+		client:              c,
+		builder:             b,
+		formatter:           f,
+		managementNamespace: managementNamespace,
+	}
+}
+
+// Reconcile đồng bộ namespace tenant cùng quota và NetworkPolicy trước khi tạo tài nguyên con
+func (tr *TenancyReconciler) Reconcile(ctx context.Context, ms *musicv1.MusicService) error {
+	if !builder.DedicatedTenancy(ms) {
+		return nil
+	}
+
+	if tr.managementNamespace == "" || ms.Namespace != tr.managementNamespace {
+		return fmt.Errorf("dedicated tenancy is only allowed for MusicServices in the management namespace %q", tr.managementNamespace)
+	}
+
+	if err := tr.reconcileNamespace(ctx, ms); err != nil {
+		return err
+	}
+	if err := tr.reconcileQuota(ctx, ms); err != nil {
+		return err
+	}
+	for _, desired := range tr.builder.BuildTenantNetworkPolicies(ms) {
+		if err := tr.reconcileNetworkPolicy(ctx, desired); err != nil {
+			return err
+		}
+	}
+
+	ms.Status.TenantNamespace = builder.TenantNamespaceName(ms)
+	return nil
+}
+
+// Cleanup xóa namespace tenant khi MusicService bị xóa; mọi tài nguyên con bị xóa theo namespace
+func (tr *TenancyReconciler) Cleanup(ctx context.Context, ms *musicv1.MusicService) error {
+	if !builder.DedicatedTenancy(ms) {
+		return nil
+	}
+
+	namespace := &corev1.Namespace{}
+	err := tr.client.Get(ctx, client.ObjectKey{Name: builder.TenantNamespaceName(ms)}, namespace)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !ownsTenantNamespace(ms, namespace) {
+		return nil
+	}
+
+	tr.formatter.Logger(ctx, ms, "tenancy").Info(tr.formatter.Format(ms, "Deleting tenant namespace"), "Namespace", namespace.Name)
+	return client.IgnoreNotFound(tr.client.Delete(ctx, namespace))
+}
+
+func (tr *TenancyReconciler) reconcileNamespace(ctx context.Context, ms *musicv1.MusicService) error {
+	log := tr.formatter.Logger(ctx, ms, "tenancy")
+
+	desired := tr.builder.BuildTenantNamespace(ms)
+	namespace := &corev1.Namespace{}
+	err := tr.client.Get(ctx, client.ObjectKeyFromObject(desired), namespace)
+	if errors.IsNotFound(err) {
+		log.Info(tr.formatter.Format(ms, "Creating tenant namespace"), "Namespace", desired.Name)
+		return tr.client.Create(ctx, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Không chiếm quyền một namespace có sẵn không do MusicService này tạo ra
+	if !ownsTenantNamespace(ms, namespace) {
+		return fmt.Errorf("namespace %s already exists and is not managed by MusicService %s/%s", namespace.Name, ms.Namespace, ms.Name)
+	}
+	if namespace.DeletionTimestamp != nil {
+		return fmt.Errorf("tenant namespace %s is terminating", namespace.Name)
+	}
+
+	return nil
+}
+
+func (tr *TenancyReconciler) reconcileQuota(ctx context.Context, ms *musicv1.MusicService) error {
+	log := tr.formatter.Logger(ctx, ms, "tenancy")
+
+	desired := tr.builder.BuildTenantResourceQuota(ms)
+	quota := &corev1.ResourceQuota{}
+	err := tr.client.Get(ctx, client.ObjectKeyFromObject(desired), quota)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if len(ms.Spec.Tenancy.Quota) == 0 {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return client.IgnoreNotFound(tr.client.Delete(ctx, quota))
+	}
+
+	if errors.IsNotFound(err) {
+		log.Info(tr.formatter.Format(ms, "Creating tenant ResourceQuota"), "ResourceQuota", desired.Name)
+		return tr.client.Create(ctx, desired)
+	}
+
+	if !reflect.DeepEqual(quota.Spec.Hard, desired.Spec.Hard) {
+		log.Info(tr.formatter.Format(ms, "Updating tenant ResourceQuota"), "ResourceQuota", desired.Name)
+		quota.Spec.Hard = desired.Spec.Hard
+		return tr.client.Update(ctx, quota)
+	}
+
+	return nil
+}
+
+func (tr *TenancyReconciler) reconcileNetworkPolicy(ctx context.Context, desired *networkingv1.NetworkPolicy) error {
+	policy := &networkingv1.NetworkPolicy{}
+	err := tr.client.Get(ctx, client.ObjectKeyFromObject(desired), policy)
+	if errors.IsNotFound(err) {
+		return tr.client.Create(ctx, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(policy.Spec, desired.Spec) {
+		policy.Spec = desired.Spec
+		return tr.client.Update(ctx, policy)
+	}
+
+	return nil
+}
+
+func ownsTenantNamespace(ms *musicv1.MusicService, namespace *corev1.Namespace) bool {
+	return namespace.Labels[builder.TenantOwnerNamespaceLabel] == ms.Namespace &&
+		namespace.Labels[builder.TenantOwnerNameLabel] == ms.Name
+}
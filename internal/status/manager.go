@@ -19,31 +19,60 @@ package status
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/appmetrics"
+	"github.com/example/managedapp-operator/internal/notify"
+	"github.com/example/managedapp-operator/pkg/builder"
+	"github.com/example/managedapp-operator/pkg/database"
 )
 
 // Manager handles status updates for MusicService objects
 type Manager struct {
-	client client.Client
+	client   client.Client
+	notifier *notify.Notifier
+	recorder record.EventRecorder
+	scraper  *appmetrics.Scraper
 }
 
-// NewManager creates a new status manager
-func NewManager(c client.Client) *Manager {
-	return &Manager{client: c}
+// NewManager creates a new status manager; n may be nil to disable notifications,
+// r may be nil to skip condition transition Events and s may be nil to leave galeraClusterSize unreported
+func NewManager(c client.Client, n *notify.Notifier, r record.EventRecorder, s *appmetrics.Scraper) *Manager {
+	return &Manager{client: c, notifier: n, recorder: r, scraper: s}
 }
 
-// setCondition adds or updates a condition in the conditions slice
-func setCondition(conditions *[]metav1.Condition, condition metav1.Condition) {
+// recordCondition sets the condition on the MusicService and emits an Event when its status or reason changed,
+// so `kubectl describe` shows a timeline of health changes
+func (m *Manager) recordCondition(ms *musicv1.MusicService, condition metav1.Condition) {
+	previous, changed := setCondition(&ms.Status.Conditions, condition)
+	if !changed || m.recorder == nil {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if condition.Status == metav1.ConditionFalse && previous == metav1.ConditionTrue {
+		eventType = corev1.EventTypeWarning
+	}
+	m.recorder.Eventf(ms, eventType, "ConditionChanged", "%s: %s -> %s (%s)",
+		condition.Type, previous, condition.Status, condition.Reason)
+}
+
+// setCondition adds or updates a condition in the conditions slice.
+// It returns the previous status (Unknown for a new condition) and whether the status or reason changed
+func setCondition(conditions *[]metav1.Condition, condition metav1.Condition) (metav1.ConditionStatus, bool) {
 	if conditions == nil || *conditions == nil {
 		*conditions = make([]metav1.Condition, 0, 1)
 	}
@@ -63,15 +92,16 @@ func setCondition(conditions *[]metav1.Condition, condition metav1.Condition) {
 				condition.LastTransitionTime = c.LastTransitionTime
 			}
 			(*conditions)[i] = condition
-			return
+			return c.Status, c.Status != condition.Status || c.Reason != condition.Reason
 		}
 	}
 	*conditions = append(*conditions, condition)
+	return metav1.ConditionUnknown, true
 }
 
 // UpdateReconciled marks the service as successfully reconciled
 func (m *Manager) UpdateReconciled(ctx context.Context, ms *musicv1.MusicService) error {
-	setCondition(&ms.Status.Conditions, metav1.Condition{
+	m.recordCondition(ms, metav1.Condition{
 		Type:               "Reconciled",
 		Status:             metav1.ConditionTrue,
 		ObservedGeneration: ms.Generation,
@@ -81,17 +111,19 @@ func (m *Manager) UpdateReconciled(ctx context.Context, ms *musicv1.MusicService
 
 	ms.Status.LastReconcileTime = &metav1.Time{Time: time.Now()}
 	ms.Status.LastError = ""
+	ms.Status.LastAppliedSpecHash = builder.SpecHash(ms)
 
 	return m.client.Status().Update(ctx, ms)
 }
 
 // UpdateError marks the service with an error condition
 func (m *Manager) UpdateError(ctx context.Context, ms *musicv1.MusicService, reason, message string) error {
+	previousPhase := ms.Status.Phase
 	ms.Status.Phase = "Failed"
 	ms.Status.LastError = message
 	ms.Status.LastReconcileTime = &metav1.Time{Time: time.Now()}
 
-	setCondition(&ms.Status.Conditions, metav1.Condition{
+	m.recordCondition(ms, metav1.Condition{
 		Type:               "Reconciled",
 		Status:             metav1.ConditionFalse,
 		ObservedGeneration: ms.Generation,
@@ -99,47 +131,290 @@ func (m *Manager) UpdateError(ctx context.Context, ms *musicv1.MusicService, rea
 		Message:            message,
 	})
 
+	return m.updateAndNotifyFailed(ctx, ms, previousPhase, reason, message)
+}
+
+// UpdateDatabaseInitializing records that the database is waiting for the initFrom restore Job
+func (m *Manager) UpdateDatabaseInitializing(ctx context.Context, ms *musicv1.MusicService, message string) error {
+	ms.Status.Phase = "Progressing"
+	ms.Status.LastReconcileTime = &metav1.Time{Time: time.Now()}
+
+	m.recordCondition(ms, metav1.Condition{
+		Type:               "DatabaseInitialized",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: ms.Generation,
+		Reason:             "RestoreInProgress",
+		Message:            message,
+	})
+
 	return m.client.Status().Update(ctx, ms)
 }
 
+// UpdateStorageMigration records the progress of a music-data shrink or access mode change
+func (m *Manager) UpdateStorageMigration(ctx context.Context, ms *musicv1.MusicService) error {
+	migration := ms.Status.StorageMigration
+	previousPhase := ms.Status.Phase
+	ms.Status.Phase = "Progressing"
+	ms.Status.LastReconcileTime = &metav1.Time{Time: time.Now()}
+
+	condition := metav1.Condition{
+		Type:               "StorageMigrated",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: ms.Generation,
+		Reason:             migration.Phase,
+		Message:            migration.Message,
+	}
+	switch migration.Phase {
+	case "Completed":
+		condition.Status = metav1.ConditionTrue
+	case "Failed":
+		ms.Status.Phase = "Failed"
+		ms.Status.LastError = migration.Message
+	}
+	m.recordCondition(ms, condition)
+
+	return m.updateAndNotifyFailed(ctx, ms, previousPhase, "StorageMigrationFailed", migration.Message)
+}
+
+// updateAndNotifyFailed persists the status and notifies once when the phase has just become Failed,
+// so a service that keeps failing on every retry does not page on-call again
+func (m *Manager) updateAndNotifyFailed(ctx context.Context, ms *musicv1.MusicService, previousPhase, reason, message string) error {
+	if err := m.client.Status().Update(ctx, ms); err != nil {
+		return err
+	}
+	if ms.Status.Phase == "Failed" && previousPhase != "Failed" {
+		m.notifier.Notify(ctx, notify.Event{
+			Kind:      notify.KindFailed,
+			Namespace: ms.Namespace,
+			Name:      ms.Name,
+			Reason:    reason,
+			Message:   message,
+		})
+	}
+	return nil
+}
+
 // UpdateFromAppStatefulSet syncs status from the application StatefulSet
 func (m *Manager) UpdateFromAppStatefulSet(ctx context.Context, ms *musicv1.MusicService, sts *appsv1.StatefulSet) error {
-	ms.Status.ReadyReplicas = sts.Status.ReadyReplicas
-	ms.Status.DesiredReplicas = *sts.Spec.Replicas
+	ms.Status.Shards = nil
+	m.updateAppAvailability(ms, sts.Status.ReadyReplicas, *sts.Spec.Replicas)
+	if ms.Spec.Storage != nil {
+		m.updateStorageWarnings(ctx, ms, sts, "music-data", ms.Name, ms.Spec.Storage.Size, "StorageWarningApp")
+	} else {
+		meta.RemoveStatusCondition(&ms.Status.Conditions, "StorageWarningApp")
+	}
+	m.updateAutoscalingRequests(ms, &sts.Spec.Template.Spec)
+
+	return m.client.Status().Update(ctx, ms)
+}
+
+// UpdateFromAppShards syncs status from the shard StatefulSets of spec.shards; the app totals are the
+// sums over the shards and a shard whose StatefulSet does not exist yet counts as not ready
+func (m *Manager) UpdateFromAppShards(ctx context.Context, ms *musicv1.MusicService) error {
+	shards := make([]musicv1.ShardStatus, 0, len(ms.Spec.Shards))
+	var ready, desired int32
+	var first *appsv1.StatefulSet
+	for _, shard := range ms.Spec.Shards {
+		status := musicv1.ShardStatus{Name: shard.Name, DesiredReplicas: shard.Replicas}
+		sts := &appsv1.StatefulSet{}
+		err := m.client.Get(ctx, types.NamespacedName{Name: builder.ShardName(ms, shard.Name), Namespace: builder.WorkloadNamespace(ms)}, sts)
+		switch {
+		case err == nil:
+			if sts.Spec.Replicas != nil {
+				status.DesiredReplicas = *sts.Spec.Replicas
+			}
+			status.ReadyReplicas = sts.Status.ReadyReplicas
+			if first == nil {
+				first = sts
+			}
+		case !errors.IsNotFound(err):
+			return err
+		}
+		ready += status.ReadyReplicas
+		desired += status.DesiredReplicas
+		shards = append(shards, status)
+	}
+
+	ms.Status.Shards = shards
+	m.updateAppAvailability(ms, ready, desired)
+	// Every shard uses the same claim template, and the music-data-<name>- prefix covers all shard PVCs
+	if ms.Spec.Storage != nil && first != nil {
+		m.updateStorageWarnings(ctx, ms, first, "music-data", ms.Name, ms.Spec.Storage.Size, "StorageWarningApp")
+	} else {
+		meta.RemoveStatusCondition(&ms.Status.Conditions, "StorageWarningApp")
+	}
+	if first != nil {
+		m.updateAutoscalingRequests(ms, &first.Spec.Template.Spec)
+	}
+
+	return m.client.Status().Update(ctx, ms)
+}
+
+// UpdateFromAppDeployment syncs status from the application Deployment used with spec.workloadType Deployment
+func (m *Manager) UpdateFromAppDeployment(ctx context.Context, ms *musicv1.MusicService, deployment *appsv1.Deployment) error {
+	ms.Status.Shards = nil
+	m.updateAppAvailability(ms, deployment.Status.ReadyReplicas, *deployment.Spec.Replicas)
+	// Without volumeClaimTemplates there are no music-data PVCs to warn about
+	meta.RemoveStatusCondition(&ms.Status.Conditions, "StorageWarningApp")
+	m.updateAutoscalingRequests(ms, &deployment.Spec.Template.Spec)
+
+	return m.client.Status().Update(ctx, ms)
+}
+
+// updateAppAvailability sets the phase and the Available condition from the app workload's ready replicas
+func (m *Manager) updateAppAvailability(ms *musicv1.MusicService, readyReplicas, desiredReplicas int32) {
+	ms.Status.ReadyReplicas = readyReplicas
+	ms.Status.DesiredReplicas = desiredReplicas
 	ms.Status.ObservedGeneration = ms.Generation
 
-	if sts.Status.ReadyReplicas == 0 {
+	if readyReplicas == 0 {
 		ms.Status.Phase = "Pending"
-		setCondition(&ms.Status.Conditions, metav1.Condition{
+		m.recordCondition(ms, metav1.Condition{
 			Type:               "Available",
 			Status:             metav1.ConditionFalse,
 			ObservedGeneration: ms.Generation,
 			Reason:             "PodsNotReady",
 			Message:            "Waiting for pods to be ready",
 		})
-	} else if sts.Status.ReadyReplicas < *sts.Spec.Replicas {
+	} else if readyReplicas < desiredReplicas {
 		ms.Status.Phase = "Progressing"
-		setCondition(&ms.Status.Conditions, metav1.Condition{
+		m.recordCondition(ms, metav1.Condition{
 			Type:               "Available",
 			Status:             metav1.ConditionFalse,
 			ObservedGeneration: ms.Generation,
 			Reason:             "PodsProgressing",
-			Message:            fmt.Sprintf("Waiting for pods: %d/%d ready", sts.Status.ReadyReplicas, *sts.Spec.Replicas),
+			Message:            fmt.Sprintf("Waiting for pods: %d/%d ready", readyReplicas, desiredReplicas),
+		})
+	} else if ms.Spec.Seed != nil && (ms.Status.Seed == nil || ms.Status.Seed.Phase != "Completed") {
+		// Pods are up but the seed Jobs have not finished loading the starter content yet
+		ms.Status.Phase = "Progressing"
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "Available",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: ms.Generation,
+			Reason:             "SeedInProgress",
+			Message:            "Waiting for seed Jobs to load content into music-data",
 		})
 	} else {
 		ms.Status.Phase = "Available"
-		setCondition(&ms.Status.Conditions, metav1.Condition{
+		m.recordCondition(ms, metav1.Condition{
 			Type:               "Available",
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: ms.Generation,
 			Reason:             "PodsReady",
 			Message:            "All replicas are ready",
 		})
+		// Use the database status from the previous reconcile so the phase does not flip to Available
+		// until UpdateDatabase refreshes it
+		m.updateDegraded(ms)
 	}
+}
 
-	m.updateStorageWarnings(ctx, ms, sts, "music-data", ms.Name, ms.Spec.Storage.Size, "StorageWarningApp")
+// updateDegraded switches an Available service to Degraded while the database is unhealthy and back
+// once it recovers; Pending, Progressing and Failed are left untouched since they already explain the state
+func (m *Manager) updateDegraded(ms *musicv1.MusicService) {
+	if ms.Status.Phase != "Available" && ms.Status.Phase != "Degraded" {
+		return
+	}
 
-	return m.client.Status().Update(ctx, ms)
+	reason, message := databaseDegradation(ms)
+	if reason == "" {
+		ms.Status.Phase = "Available"
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "Degraded",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: ms.Generation,
+			Reason:             "Healthy",
+			Message:            "All components are healthy",
+		})
+		return
+	}
+
+	ms.Status.Phase = "Degraded"
+	m.recordCondition(ms, metav1.Condition{
+		Type:               "Degraded",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: ms.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// databaseDegradation returns why the database keeps the service from being fully healthy,
+// or an empty reason when it is healthy or not enabled
+func databaseDegradation(ms *musicv1.MusicService) (string, string) {
+	spec, db := ms.Spec.Database, ms.Status.Database
+	if spec == nil || !spec.Enabled || db == nil {
+		return "", ""
+	}
+
+	if spec.HighAvailability != nil && spec.HighAvailability.Enabled {
+		if db.GaleraClusterStatus != "Primary" {
+			return "DatabaseUnavailable", fmt.Sprintf("Galera cluster has no primary component (%s)", db.GaleraClusterStatus)
+		}
+		if nodes := spec.Replicas + 1; db.GaleraReadyNodes < nodes {
+			return "DatabaseNodesNotReady", fmt.Sprintf("%d/%d Galera nodes are synced", db.GaleraReadyNodes, nodes)
+		}
+		return "", ""
+	}
+
+	if !db.MasterReady {
+		return "DatabaseUnavailable", "Database master has no ready pods"
+	}
+	if spec.Replicas == 0 {
+		return "", ""
+	}
+	switch {
+	case db.ReplicaDeletionDetected:
+		return "ReplicationBroken", "Replica StatefulSet was deleted"
+	case db.ReplicasReady == 0:
+		return "ReplicationBroken", "No replica is replicating within the lag limit"
+	case db.ReplicasReady < spec.Replicas:
+		return "DatabaseReplicasNotReady", fmt.Sprintf("%d/%d database replicas are ready", db.ReplicasReady, spec.Replicas)
+	}
+	return "", ""
+}
+
+// updateAutoscalingRequests warns when the app HPA targets a resource the app container does not request;
+// without requests the HPA cannot compute utilization and never scales
+func (m *Manager) updateAutoscalingRequests(ms *musicv1.MusicService, podSpec *corev1.PodSpec) {
+	if !builder.AutoscalingEnabled(ms.Spec.Autoscaling) {
+		meta.RemoveStatusCondition(&ms.Status.Conditions, "AutoscalingRequests")
+		return
+	}
+
+	var requests corev1.ResourceList
+	for _, container := range podSpec.Containers {
+		if container.Name == "music-service" {
+			requests = container.Resources.Requests
+		}
+	}
+	var missing []string
+	if _, ok := requests[corev1.ResourceCPU]; !ok {
+		missing = append(missing, string(corev1.ResourceCPU))
+	}
+	if _, ok := requests[corev1.ResourceMemory]; !ok && ms.Spec.Autoscaling.TargetMemoryUtilizationPercentage != nil {
+		missing = append(missing, string(corev1.ResourceMemory))
+	}
+
+	condition := metav1.Condition{
+		Type:               "AutoscalingRequests",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: ms.Generation,
+		Reason:             "RequestsSet",
+		Message:            "App containers request the resources targeted by the HPA",
+	}
+	switch {
+	case len(missing) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RequestsMissing"
+		condition.Message = fmt.Sprintf("Autoscaling is configured but app containers have no %s requests; "+
+			"set spec.resources or the operator default requests", strings.Join(missing, "/"))
+	case ms.Spec.Resources == nil:
+		condition.Reason = "DefaultRequests"
+		condition.Message = "App containers use the operator default requests; set spec.resources to size them explicitly"
+	}
+	m.recordCondition(ms, condition)
 }
 
 // UpdateDatabase updates database-specific status
@@ -148,9 +423,21 @@ func (m *Manager) UpdateDatabase(ctx context.Context, ms *musicv1.MusicService)
 		ms.Status.Database = &musicv1.DatabaseStatus{}
 	}
 
+	masterWasReady := ms.Status.Database.MasterReady
+	if err := m.updateDatabaseNodes(ctx, ms); err != nil {
+		return err
+	}
+	if ms.Spec.Database.HighAvailability != nil && ms.Spec.Database.HighAvailability.Enabled {
+		previousStatus := ms.Status.Database.GaleraClusterStatus
+		m.updateGalera(ctx, ms)
+		recordGaleraFailover(ms.Status.Database, previousStatus)
+		m.updateDegraded(ms)
+		return m.updateDatabaseAndNotify(ctx, ms, masterWasReady, ms.Name+"-db-galera")
+	}
+
 	// Check master status
 	masterSts := &appsv1.StatefulSet{}
-	masterName := types.NamespacedName{Name: ms.Name + "-db-master", Namespace: ms.Namespace}
+	masterName := types.NamespacedName{Name: ms.Name + "-db-master", Namespace: builder.WorkloadNamespace(ms)}
 	if err := m.client.Get(ctx, masterName, masterSts); err == nil {
 		ms.Status.Database.MasterReady = masterSts.Status.ReadyReplicas > 0
 
@@ -165,10 +452,20 @@ func (m *Manager) UpdateDatabase(ctx context.Context, ms *musicv1.MusicService)
 		}
 	}
 
+	if ms.Spec.Database.InitFrom != nil && ms.Status.Database.InitializedFrom != "" {
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "DatabaseInitialized",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ms.Generation,
+			Reason:             "RestoreCompleted",
+			Message:            fmt.Sprintf("Database initialized from backup of %s", ms.Status.Database.InitializedFrom),
+		})
+	}
+
 	// Check replica status
 	if ms.Spec.Database.Replicas > 0 {
 		replicaSts := &appsv1.StatefulSet{}
-		replicaName := types.NamespacedName{Name: ms.Name + "-db-replica", Namespace: ms.Namespace}
+		replicaName := types.NamespacedName{Name: ms.Name + "-db-replica", Namespace: builder.WorkloadNamespace(ms)}
 		if err := m.client.Get(ctx, replicaName, replicaSts); err == nil {
 			ms.Status.Database.ReplicasReady = replicaSts.Status.ReadyReplicas
 			ms.Status.Database.ReplicaEverCreated = true
@@ -176,7 +473,7 @@ func (m *Manager) UpdateDatabase(ctx context.Context, ms *musicv1.MusicService)
 			ms.Status.Database.ReplicaLastSeen = &metav1.Time{Time: time.Now()}
 			ms.Status.Database.ReplicationReady = replicaSts.Status.ReadyReplicas > 0
 
-			setCondition(&ms.Status.Conditions, metav1.Condition{
+			m.recordCondition(ms, metav1.Condition{
 				Type:               "DatabaseReplicaHistory",
 				Status:             metav1.ConditionTrue,
 				ObservedGeneration: ms.Generation,
@@ -186,7 +483,7 @@ func (m *Manager) UpdateDatabase(ctx context.Context, ms *musicv1.MusicService)
 		} else if errors.IsNotFound(err) {
 			if ms.Status.Database.ReplicaEverCreated {
 				ms.Status.Database.ReplicaDeletionDetected = true
-				setCondition(&ms.Status.Conditions, metav1.Condition{
+				m.recordCondition(ms, metav1.Condition{
 					Type:               "DatabaseReplicaHistory",
 					Status:             metav1.ConditionFalse,
 					ObservedGeneration: ms.Generation,
@@ -197,7 +494,398 @@ func (m *Manager) UpdateDatabase(ctx context.Context, ms *musicv1.MusicService)
 		}
 	}
 
-	return m.client.Status().Update(ctx, ms)
+	recordMasterFailover(ms.Status.Database, masterWasReady, ms.Name+"-db-master-0")
+	m.updateDegraded(ms)
+	return m.updateDatabaseAndNotify(ctx, ms, masterWasReady, ms.Name+"-db-master")
+}
+
+// databaseNodeRoles maps the component label of database pods to the role reported in status.database.nodes
+var databaseNodeRoles = map[string]string{
+	"db-master":  "master",
+	"db-replica": "replica",
+	"db-galera":  "galera",
+}
+
+// updateDatabaseNodes lists the database pods into status.database.nodes, sorted by name
+func (m *Manager) updateDatabaseNodes(ctx context.Context, ms *musicv1.MusicService) error {
+	pods := &corev1.PodList{}
+	if err := m.client.List(ctx, pods, client.InNamespace(builder.WorkloadNamespace(ms)), client.MatchingLabels{"app": ms.Name}); err != nil {
+		return err
+	}
+
+	var engine musicv1.DatabaseEngine
+	if ms.Spec.Database != nil {
+		engine = ms.Spec.Database.Engine
+	}
+	container := database.GetProvider(string(engine)).ContainerName()
+	var nodes []musicv1.DatabaseNodeStatus
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		role, ok := databaseNodeRoles[pod.Labels["component"]]
+		if !ok {
+			continue
+		}
+		nodes = append(nodes, databaseNode(pod, role, container))
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	ms.Status.Database.Nodes = nodes
+	return nil
+}
+
+// databaseNode summarizes a database pod; restarts and waiting reasons come from the server container named
+// by the engine's provider. Readiness probes already check replication lag on replicas
+// and the wsrep state on Galera members, so readiness maps directly to the replication state
+func databaseNode(pod *corev1.Pod, role, serverContainer string) musicv1.DatabaseNodeStatus {
+	node := musicv1.DatabaseNodeStatus{Name: pod.Name, Role: role, NodeName: pod.Spec.NodeName, Ready: podReady(pod)}
+
+	var waiting string
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.Name != serverContainer {
+			continue
+		}
+		node.Restarts = container.RestartCount
+		if container.State.Waiting != nil {
+			waiting = container.State.Waiting.Reason
+		}
+	}
+
+	switch {
+	case pod.DeletionTimestamp != nil:
+		node.State = "Terminating"
+	case waiting != "":
+		node.State = waiting
+	case pod.Status.Phase != corev1.PodRunning:
+		node.State = string(pod.Status.Phase)
+	case role == "replica" && node.Ready:
+		node.State = "Replicating"
+	case role == "replica":
+		node.State = "NotReplicating"
+	case role == "galera" && node.Ready:
+		node.State = "Synced"
+	case role == "galera":
+		node.State = "NotSynced"
+	case node.Ready:
+		node.State = "Ready"
+	default:
+		node.State = "NotReady"
+	}
+	return node
+}
+
+// maxFailoverHistory bounds status.database.failoverHistory
+const maxFailoverHistory = 10
+
+// recordMasterFailover records the master losing or regaining its ready pod.
+// A recovery is only recorded after a recorded loss, so the first time the master becomes ready is not a failover
+func recordMasterFailover(db *musicv1.DatabaseStatus, wasReady bool, master string) {
+	switch {
+	case wasReady && !db.MasterReady:
+		recordFailover(db, master, "", "MasterNotReady")
+	case !wasReady && db.MasterReady && lastFailoverReason(db) == "MasterNotReady":
+		recordFailover(db, "", master, "MasterRecovered")
+	}
+}
+
+// recordGaleraFailover records the Galera cluster losing or regaining its primary component
+func recordGaleraFailover(db *musicv1.DatabaseStatus, previous string) {
+	current := db.GaleraClusterStatus
+	switch {
+	case previous == "Primary" && current != "Primary":
+		recordFailover(db, previous, current, "QuorumLost")
+	case previous != "" && previous != "Primary" && current == "Primary":
+		recordFailover(db, previous, current, "QuorumRestored")
+	}
+}
+
+// recordFailover appends an event to the failover history, keeping only the newest maxFailoverHistory entries
+func recordFailover(db *musicv1.DatabaseStatus, from, to, reason string) {
+	db.FailoverHistory = append(db.FailoverHistory, musicv1.FailoverEvent{
+		Time:   metav1.Now(),
+		From:   from,
+		To:     to,
+		Reason: reason,
+	})
+	if excess := len(db.FailoverHistory) - maxFailoverHistory; excess > 0 {
+		db.FailoverHistory = db.FailoverHistory[excess:]
+	}
+}
+
+func lastFailoverReason(db *musicv1.DatabaseStatus) string {
+	if len(db.FailoverHistory) == 0 {
+		return ""
+	}
+	return db.FailoverHistory[len(db.FailoverHistory)-1].Reason
+}
+
+// updateDatabaseAndNotify persists the status and sends a PrimaryDown notification when the
+// write endpoint (master or Galera primary component) stopped having ready pods
+func (m *Manager) updateDatabaseAndNotify(ctx context.Context, ms *musicv1.MusicService, masterWasReady bool, stsName string) error {
+	if err := m.client.Status().Update(ctx, ms); err != nil {
+		return err
+	}
+	if masterWasReady && !ms.Status.Database.MasterReady {
+		m.notifier.Notify(ctx, notify.Event{
+			Kind:      notify.KindPrimaryDown,
+			Namespace: ms.Namespace,
+			Name:      ms.Name,
+			Reason:    "MasterNotReady",
+			Message:   fmt.Sprintf("Database master %s has no ready pods", stsName),
+		})
+	}
+	return nil
+}
+
+// updateGalera fills the Galera fields of the database status from the Galera StatefulSet.
+// The Galera readiness probe only passes on nodes Synced in the primary component,
+// so ready pods are the members of the primary component
+func (m *Manager) updateGalera(ctx context.Context, ms *musicv1.MusicService) {
+	db := ms.Status.Database
+	stsName := ms.Name + "-db-galera"
+	sts := &appsv1.StatefulSet{}
+	if err := m.client.Get(ctx, types.NamespacedName{Name: stsName, Namespace: builder.WorkloadNamespace(ms)}, sts); err != nil {
+		db.MasterReady = false
+		db.GaleraClusterSize = 0
+		db.GaleraReadyNodes = 0
+		db.GaleraClusterStatus = "Disconnected"
+		db.Phase = "Pending"
+		return
+	}
+
+	db.GaleraReadyNodes = sts.Status.ReadyReplicas
+	pods := &corev1.PodList{}
+	if err := m.client.List(ctx, pods, client.InNamespace(sts.Namespace), client.MatchingLabels(sts.Spec.Selector.MatchLabels)); err == nil {
+		db.GaleraClusterSize = m.galeraClusterSize(ctx, pods.Items)
+		if node := galeraBootstrapNode(pods.Items); node != "" {
+			db.BootstrapNode = node
+		}
+	}
+	switch {
+	case sts.Status.ReadyReplicas > 0:
+		db.GaleraClusterStatus = "Primary"
+		db.Phase = "Ready"
+	case sts.Status.Replicas > 0:
+		db.GaleraClusterStatus = "NonPrimary"
+		db.Phase = "Pending"
+	default:
+		db.GaleraClusterStatus = "Disconnected"
+		db.Phase = "Pending"
+	}
+	db.MasterReady = sts.Status.ReadyReplicas > 0
+
+	if ms.Spec.Database.Storage != nil {
+		m.updateStorageWarnings(ctx, ms, sts, "db-data", stsName, ms.Spec.Database.Storage.Size, "StorageWarningDatabase")
+	}
+}
+
+// podReady reports whether the pod has a true Ready condition
+func podReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// galeraClusterSize returns the largest wsrep_cluster_size reported by the exporter sidecar of a ready Galera pod.
+// Ready pods are Synced in the primary component, so they agree on its size; pods that cannot be scraped
+// are skipped and 0 means no ready pod reported it
+func (m *Manager) galeraClusterSize(ctx context.Context, pods []corev1.Pod) int32 {
+	var size int32
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || !podReady(pod) {
+			continue
+		}
+		value, found, err := m.scraper.Scrape(ctx, pod.Namespace, pod.Name, builder.GaleraExporterPort, "/metrics", builder.GaleraClusterSizeMetric)
+		if err != nil || !found {
+			continue
+		}
+		if int32(value) > size {
+			size = int32(value)
+		}
+	}
+	return size
+}
+
+// galeraBootstrapNode returns the Galera pod whose config init container last reported that it bootstrapped
+// a new cluster because its data directory had no grastate.dat, or "" when no current pod did; the previous
+// value is kept in status in that case since joining pods never bootstrap
+func galeraBootstrapNode(pods []corev1.Pod) string {
+	var node string
+	var finished time.Time
+	for _, pod := range pods {
+		for _, status := range pod.Status.InitContainerStatuses {
+			terminated := status.State.Terminated
+			if status.Name != builder.GaleraConfigContainerName || terminated == nil ||
+				strings.TrimSpace(terminated.Message) != builder.GaleraBootstrapMessage {
+				continue
+			}
+			if node == "" || terminated.FinishedAt.After(finished) {
+				node, finished = pod.Name, terminated.FinishedAt.Time
+			}
+		}
+	}
+	return node
+}
+
+// UpdateStorageResizing sets the StorageResizing condition from the PVCs of the app and the database
+// and returns true while an expansion is still progressing so the controller can keep polling it.
+// The condition is persisted with the next status update
+func (m *Manager) UpdateStorageResizing(ctx context.Context, ms *musicv1.MusicService) (bool, error) {
+	type pvcSet struct{ claimName, appName, size string }
+	var sets []pvcSet
+	if ms.Spec.Storage != nil {
+		sets = append(sets, pvcSet{"music-data", ms.Name, ms.Spec.Storage.Size})
+	}
+	if db := ms.Spec.Database; db != nil && db.Enabled && db.Storage != nil {
+		if db.HighAvailability != nil && db.HighAvailability.Enabled {
+			sets = append(sets, pvcSet{"db-data", ms.Name + "-db-galera", db.Storage.Size})
+		} else {
+			sets = append(sets, pvcSet{"db-data", ms.Name + "-db-master", db.Storage.Size})
+			if db.Replicas > 0 {
+				sets = append(sets, pvcSet{"db-data", ms.Name + "-db-replica", db.Storage.Size})
+			}
+		}
+	}
+
+	var pending, unsupported []string
+	reason := "Resizing"
+	for _, set := range sets {
+		desired, err := resource.ParseQuantity(set.size)
+		if err != nil {
+			continue
+		}
+		pvcs, err := m.listPVCsByPrefix(ctx, set.claimName, set.appName, builder.WorkloadNamespace(ms))
+		if err != nil {
+			return false, err
+		}
+
+		for i := range pvcs {
+			pvc := &pvcs[i]
+			if pvc.Status.Phase != corev1.ClaimBound {
+				continue
+			}
+			request := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+			if request.Cmp(desired) >= 0 && capacity.Cmp(request) >= 0 {
+				continue
+			}
+
+			switch {
+			case hasPVCCondition(pvc, corev1.PersistentVolumeClaimFileSystemResizePending):
+				reason = "FileSystemResizePending"
+				pending = append(pending, pvc.Name+" (waiting for the node to expand the filesystem)")
+			case hasPVCCondition(pvc, corev1.PersistentVolumeClaimResizing):
+				pending = append(pending, pvc.Name+" (volume expansion in progress)")
+			default:
+				allowed, err := m.storageClassAllowsExpansion(ctx, pvc)
+				if err != nil {
+					return false, err
+				}
+				if !allowed {
+					unsupported = append(unsupported, pvc.Name)
+					continue
+				}
+				pending = append(pending, pvc.Name+" (waiting for the resize to start)")
+			}
+		}
+	}
+
+	switch {
+	case len(unsupported) > 0:
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "StorageResizing",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ms.Generation,
+			Reason:             "ExpansionNotSupported",
+			Message:            fmt.Sprintf("StorageClass does not allow volume expansion for: %s", strings.Join(unsupported, ", ")),
+		})
+	case len(pending) > 0:
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "StorageResizing",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ms.Generation,
+			Reason:             reason,
+			Message:            fmt.Sprintf("Expanding: %s", strings.Join(pending, ", ")),
+		})
+	default:
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "StorageResizing",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: ms.Generation,
+			Reason:             "ResizeComplete",
+			Message:            "All PVCs have the requested capacity",
+		})
+	}
+
+	return len(pending) > 0, nil
+}
+
+// defaultAlmostFullPercent matches the almostFullPercent default in the CRD
+const defaultAlmostFullPercent = 90
+
+// UpdateStorageUsage sets the StorageAlmostFull condition from status.volumes, comparing each PVC with
+// almostFullPercent of spec.storage (app) or spec.database.storage (database components).
+// The condition is removed while no usage has been collected and is persisted with the next status update
+func (m *Manager) UpdateStorageUsage(ms *musicv1.MusicService) {
+	if len(ms.Status.Volumes) == 0 {
+		meta.RemoveStatusCondition(&ms.Status.Conditions, "StorageAlmostFull")
+		return
+	}
+
+	var full []string
+	for _, volume := range ms.Status.Volumes {
+		storage := ms.Spec.Storage
+		if volume.Component != "app" && ms.Spec.Database != nil {
+			storage = ms.Spec.Database.Storage
+		}
+		threshold := int32(defaultAlmostFullPercent)
+		if storage != nil && storage.AlmostFullPercent > 0 {
+			threshold = storage.AlmostFullPercent
+		}
+		if volume.UsedPercent >= threshold {
+			full = append(full, fmt.Sprintf("%s (%d%% used)", volume.PVC, volume.UsedPercent))
+		}
+	}
+
+	if len(full) > 0 {
+		m.recordCondition(ms, metav1.Condition{
+			Type:               "StorageAlmostFull",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ms.Generation,
+			Reason:             "UsageAboveThreshold",
+			Message:            fmt.Sprintf("Volumes almost full: %s", strings.Join(full, ", ")),
+		})
+		return
+	}
+	m.recordCondition(ms, metav1.Condition{
+		Type:               "StorageAlmostFull",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: ms.Generation,
+		Reason:             "UsageBelowThreshold",
+		Message:            "All volumes are below the almost-full threshold",
+	})
+}
+
+func hasPVCCondition(pvc *corev1.PersistentVolumeClaim, conditionType corev1.PersistentVolumeClaimConditionType) bool {
+	for _, cond := range pvc.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) storageClassAllowsExpansion(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+	sc := &storagev1.StorageClass{}
+	if err := m.client.Get(ctx, types.NamespacedName{Name: *pvc.Spec.StorageClassName}, sc); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion, nil
 }
 
 func (m *Manager) updateStorageWarnings(ctx context.Context, ms *musicv1.MusicService, sts *appsv1.StatefulSet, claimName, appName, desiredSize, conditionType string) {
@@ -206,7 +894,7 @@ func (m *Manager) updateStorageWarnings(ctx context.Context, ms *musicv1.MusicSe
 		desired, err := resource.ParseQuantity(desiredSize)
 		if err == nil {
 			if desired.Cmp(currentSize) < 0 {
-				setCondition(&ms.Status.Conditions, metav1.Condition{
+				m.recordCondition(ms, metav1.Condition{
 					Type:               conditionType,
 					Status:             metav1.ConditionFalse,
 					ObservedGeneration: ms.Generation,
@@ -218,10 +906,10 @@ func (m *Manager) updateStorageWarnings(ctx context.Context, ms *musicv1.MusicSe
 		}
 	}
 
-	if pvcs, err := m.listPVCsByPrefix(ctx, claimName, appName, ms.Namespace); err == nil {
+	if pvcs, err := m.listPVCsByPrefix(ctx, claimName, appName, builder.WorkloadNamespace(ms)); err == nil {
 		for _, pvc := range pvcs {
 			if pvc.Status.Phase != corev1.ClaimBound {
-				setCondition(&ms.Status.Conditions, metav1.Condition{
+				m.recordCondition(ms, metav1.Condition{
 					Type:               conditionType,
 					Status:             metav1.ConditionFalse,
 					ObservedGeneration: ms.Generation,
@@ -233,7 +921,7 @@ func (m *Manager) updateStorageWarnings(ctx context.Context, ms *musicv1.MusicSe
 		}
 	}
 
-	setCondition(&ms.Status.Conditions, metav1.Condition{
+	m.recordCondition(ms, metav1.Condition{
 		Type:               conditionType,
 		Status:             metav1.ConditionTrue,
 		ObservedGeneration: ms.Generation,
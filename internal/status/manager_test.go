@@ -18,16 +18,27 @@ package status
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
+	restfake "k8s.io/client-go/rest/fake"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 
 	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/internal/appmetrics"
+	"github.com/example/managedapp-operator/pkg/builder"
 )
 
 // newValidMusicService creates a MusicService with valid required fields
@@ -41,7 +52,7 @@ func newValidMusicService(name string) *musicv1.MusicService {
 			Replicas: 1,
 			Image:    "test:latest",
 			Port:     8080,
-			Storage: musicv1.StorageSpec{
+			Storage: &musicv1.StorageSpec{
 				Size: "10Gi",
 			},
 			Streaming: musicv1.StreamingSpec{
@@ -132,6 +143,422 @@ func TestSetCondition(t *testing.T) {
 	}
 }
 
+func TestRecordConditionEvents(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	manager := NewManager(nil, nil, recorder, nil)
+	ms := newValidMusicService("events")
+
+	steps := []struct {
+		status    metav1.ConditionStatus
+		reason    string
+		message   string
+		wantEvent string
+	}{
+		{status: metav1.ConditionTrue, reason: "PodsReady", message: "All replicas are ready", wantEvent: "Normal ConditionChanged Available: Unknown -> True (PodsReady)"},
+		{status: metav1.ConditionTrue, reason: "PodsReady", message: "still ready"},
+		{status: metav1.ConditionFalse, reason: "PodsNotReady", message: "Waiting for pods", wantEvent: "Warning ConditionChanged Available: True -> False (PodsNotReady)"},
+		{status: metav1.ConditionFalse, reason: "PodsProgressing", message: "1/2 ready", wantEvent: "Normal ConditionChanged Available: False -> False (PodsProgressing)"},
+	}
+	for _, step := range steps {
+		manager.recordCondition(ms, metav1.Condition{Type: "Available", Status: step.status, Reason: step.reason, Message: step.message})
+
+		select {
+		case event := <-recorder.Events:
+			if event != step.wantEvent {
+				t.Errorf("got event %q, want %q", event, step.wantEvent)
+			}
+		default:
+			if step.wantEvent != "" {
+				t.Errorf("expected event %q, got none", step.wantEvent)
+			}
+		}
+	}
+}
+
+func TestUpdateAutoscalingRequests(t *testing.T) {
+	memoryTarget := int32(80)
+	tests := []struct {
+		name       string
+		resources  *corev1.ResourceRequirements
+		requests   corev1.ResourceList
+		memory     *int32
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "no requests",
+			wantStatus: metav1.ConditionFalse,
+			wantReason: "RequestsMissing",
+		},
+		{
+			name:       "operator default requests",
+			requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: "DefaultRequests",
+		},
+		{
+			name: "memory target without memory request",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			},
+			requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			memory:     &memoryTarget,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: "RequestsMissing",
+		},
+		{
+			name: "explicit requests",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			},
+			requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: "RequestsSet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newValidMusicService("autoscaling-requests")
+			ms.Spec.Resources = tt.resources
+			ms.Spec.Autoscaling = &musicv1.AutoscalingSpec{
+				MinReplicas:                       1,
+				MaxReplicas:                       3,
+				TargetCPUUtilizationPercentage:    70,
+				TargetMemoryUtilizationPercentage: tt.memory,
+			}
+			podSpec := &corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "music-service", Resources: corev1.ResourceRequirements{Requests: tt.requests}},
+				},
+			}
+
+			(&Manager{}).updateAutoscalingRequests(ms, podSpec)
+
+			condition := meta.FindStatusCondition(ms.Status.Conditions, "AutoscalingRequests")
+			if condition == nil {
+				t.Fatal("AutoscalingRequests condition not set")
+			}
+			if condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("got %s/%s, want %s/%s", condition.Status, condition.Reason, tt.wantStatus, tt.wantReason)
+			}
+
+			ms.Spec.Autoscaling = nil
+			(&Manager{}).updateAutoscalingRequests(ms, podSpec)
+			if meta.FindStatusCondition(ms.Status.Conditions, "AutoscalingRequests") != nil {
+				t.Error("expected condition to be removed when autoscaling is disabled")
+			}
+		})
+	}
+}
+
+func TestUpdateStorageUsage(t *testing.T) {
+	tests := []struct {
+		name       string
+		volumes    []musicv1.VolumeUsageStatus
+		dbPercent  int32
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "below default threshold",
+			volumes:    []musicv1.VolumeUsageStatus{{Component: "app", PVC: "music-data-usage-0", UsedPercent: 89}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: "UsageBelowThreshold",
+		},
+		{
+			name:       "app above default threshold",
+			volumes:    []musicv1.VolumeUsageStatus{{Component: "app", PVC: "music-data-usage-0", UsedPercent: 90}},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: "UsageAboveThreshold",
+		},
+		{
+			name:       "database uses its own threshold",
+			volumes:    []musicv1.VolumeUsageStatus{{Component: "db-master", PVC: "db-data-usage-db-master-0", UsedPercent: 75}},
+			dbPercent:  70,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: "UsageAboveThreshold",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newValidMusicService("usage")
+			ms.Spec.Database = &musicv1.DatabaseSpec{
+				Enabled: true,
+				Storage: &musicv1.StorageSpec{Size: "5Gi", AlmostFullPercent: tt.dbPercent},
+			}
+			ms.Status.Volumes = tt.volumes
+
+			(&Manager{}).UpdateStorageUsage(ms)
+
+			condition := meta.FindStatusCondition(ms.Status.Conditions, "StorageAlmostFull")
+			if condition == nil {
+				t.Fatal("StorageAlmostFull condition not set")
+			}
+			if condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("got %s/%s, want %s/%s", condition.Status, condition.Reason, tt.wantStatus, tt.wantReason)
+			}
+
+			ms.Status.Volumes = nil
+			(&Manager{}).UpdateStorageUsage(ms)
+			if meta.FindStatusCondition(ms.Status.Conditions, "StorageAlmostFull") != nil {
+				t.Error("expected condition to be removed when no usage is reported")
+			}
+		})
+	}
+}
+
+func TestRecordFailover(t *testing.T) {
+	db := &musicv1.DatabaseStatus{}
+
+	// First time the master becomes ready is not a failover
+	db.MasterReady = true
+	recordMasterFailover(db, false, "radio-db-master-0")
+	if len(db.FailoverHistory) != 0 {
+		t.Fatalf("expected no history on first readiness, got %+v", db.FailoverHistory)
+	}
+
+	for i := 0; i < maxFailoverHistory; i++ {
+		db.MasterReady = false
+		recordMasterFailover(db, true, "radio-db-master-0")
+		db.MasterReady = true
+		recordMasterFailover(db, false, "radio-db-master-0")
+	}
+	if len(db.FailoverHistory) != maxFailoverHistory {
+		t.Fatalf("expected history bounded to %d entries, got %d", maxFailoverHistory, len(db.FailoverHistory))
+	}
+	last := db.FailoverHistory[len(db.FailoverHistory)-1]
+	if last.Reason != "MasterRecovered" || last.To != "radio-db-master-0" || last.From != "" {
+		t.Errorf("unexpected newest entry %+v", last)
+	}
+
+	galera := &musicv1.DatabaseStatus{GaleraClusterStatus: "NonPrimary"}
+	recordGaleraFailover(galera, "Primary")
+	galera.GaleraClusterStatus = "Primary"
+	recordGaleraFailover(galera, "NonPrimary")
+	galera.GaleraClusterStatus = "Primary"
+	recordGaleraFailover(galera, "")
+	if len(galera.FailoverHistory) != 2 ||
+		galera.FailoverHistory[0].Reason != "QuorumLost" || galera.FailoverHistory[1].Reason != "QuorumRestored" {
+		t.Errorf("unexpected Galera history %+v", galera.FailoverHistory)
+	}
+}
+
+func TestUpdateDegraded(t *testing.T) {
+	tests := []struct {
+		name       string
+		phase      string
+		galera     bool
+		status     *musicv1.DatabaseStatus
+		wantPhase  string
+		wantReason string
+	}{
+		{
+			name:       "healthy database",
+			phase:      "Available",
+			status:     &musicv1.DatabaseStatus{MasterReady: true, ReplicationReady: true, ReplicasReady: 2},
+			wantPhase:  "Available",
+			wantReason: "Healthy",
+		},
+		{
+			name:       "master down",
+			phase:      "Available",
+			status:     &musicv1.DatabaseStatus{ReplicasReady: 2},
+			wantPhase:  "Degraded",
+			wantReason: "DatabaseUnavailable",
+		},
+		{
+			name:       "no replica replicating",
+			phase:      "Available",
+			status:     &musicv1.DatabaseStatus{MasterReady: true},
+			wantPhase:  "Degraded",
+			wantReason: "ReplicationBroken",
+		},
+		{
+			name:       "replicas partially ready",
+			phase:      "Available",
+			status:     &musicv1.DatabaseStatus{MasterReady: true, ReplicationReady: true, ReplicasReady: 1},
+			wantPhase:  "Degraded",
+			wantReason: "DatabaseReplicasNotReady",
+		},
+		{
+			name:       "recovers from degraded",
+			phase:      "Degraded",
+			status:     &musicv1.DatabaseStatus{MasterReady: true, ReplicationReady: true, ReplicasReady: 2},
+			wantPhase:  "Available",
+			wantReason: "Healthy",
+		},
+		{
+			name:       "galera missing a node",
+			phase:      "Available",
+			galera:     true,
+			status:     &musicv1.DatabaseStatus{MasterReady: true, GaleraClusterStatus: "Primary", GaleraReadyNodes: 2},
+			wantPhase:  "Degraded",
+			wantReason: "DatabaseNodesNotReady",
+		},
+		{
+			name:      "failed is left alone",
+			phase:     "Failed",
+			status:    &musicv1.DatabaseStatus{},
+			wantPhase: "Failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newValidMusicService("degraded")
+			ms.Spec.Database = &musicv1.DatabaseSpec{Enabled: true, Replicas: 2}
+			if tt.galera {
+				ms.Spec.Database.HighAvailability = &musicv1.DatabaseHighAvailabilitySpec{Enabled: true}
+			}
+			ms.Status.Phase = tt.phase
+			ms.Status.Database = tt.status
+
+			(&Manager{}).updateDegraded(ms)
+
+			if ms.Status.Phase != tt.wantPhase {
+				t.Errorf("phase = %s, want %s", ms.Status.Phase, tt.wantPhase)
+			}
+			condition := meta.FindStatusCondition(ms.Status.Conditions, "Degraded")
+			if tt.wantReason == "" {
+				if condition != nil {
+					t.Errorf("expected no Degraded condition, got %+v", condition)
+				}
+				return
+			}
+			if condition == nil || condition.Reason != tt.wantReason {
+				t.Errorf("got condition %+v, want reason %s", condition, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestDatabaseNode(t *testing.T) {
+	running := func(ready corev1.ConditionStatus) corev1.PodStatus {
+		return corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "mariadb", RestartCount: 2, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		}
+	}
+	crashing := running(corev1.ConditionFalse)
+	crashing.ContainerStatuses[0].State = corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}
+
+	tests := []struct {
+		name      string
+		role      string
+		status    corev1.PodStatus
+		wantReady bool
+		wantState string
+	}{
+		{name: "ready master", role: "master", status: running(corev1.ConditionTrue), wantReady: true, wantState: "Ready"},
+		{name: "lagging replica", role: "replica", status: running(corev1.ConditionFalse), wantState: "NotReplicating"},
+		{name: "synced galera member", role: "galera", status: running(corev1.ConditionTrue), wantReady: true, wantState: "Synced"},
+		{name: "crashing replica", role: "replica", status: crashing, wantState: "CrashLoopBackOff"},
+		{name: "unscheduled master", role: "master", status: corev1.PodStatus{Phase: corev1.PodPending}, wantState: "Pending"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "radio-db-0"}, Status: tt.status}
+			node := databaseNode(pod, tt.role, "mariadb")
+			if node.Ready != tt.wantReady || node.State != tt.wantState {
+				t.Errorf("got ready=%v state=%s, want ready=%v state=%s", node.Ready, node.State, tt.wantReady, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestGaleraBootstrapNode(t *testing.T) {
+	galeraPod := func(name, message string, finished time.Time) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status: corev1.PodStatus{InitContainerStatuses: []corev1.ContainerStatus{{
+				Name: builder.GaleraConfigContainerName,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					Message: message + "\n", FinishedAt: metav1.NewTime(finished),
+				}},
+			}}},
+		}
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		pods []corev1.Pod
+		want string
+	}{
+		{
+			name: "ordinal 0 bootstrapped",
+			pods: []corev1.Pod{galeraPod("radio-db-galera-0", builder.GaleraBootstrapMessage, now), galeraPod("radio-db-galera-1", builder.GaleraJoinMessage, now)},
+			want: "radio-db-galera-0",
+		},
+		{
+			name: "ordinal 0 rejoined with its grastate",
+			pods: []corev1.Pod{galeraPod("radio-db-galera-0", builder.GaleraJoinMessage, now)},
+		},
+		{
+			name: "latest bootstrap wins",
+			pods: []corev1.Pod{galeraPod("radio-db-galera-0", builder.GaleraBootstrapMessage, now.Add(-time.Hour)), galeraPod("radio-db-galera-2", builder.GaleraBootstrapMessage, now)},
+			want: "radio-db-galera-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := galeraBootstrapNode(tt.pods); got != tt.want {
+				t.Errorf("got bootstrap node %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGaleraClusterSize(t *testing.T) {
+	galeraPod := func(name string, ready corev1.ConditionStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}}},
+		}
+	}
+	// radio-db-galera-2 is still receiving an SST, so it counts towards wsrep_cluster_size but is not Ready
+	pods := []corev1.Pod{
+		galeraPod("radio-db-galera-0", corev1.ConditionTrue),
+		galeraPod("radio-db-galera-1", corev1.ConditionTrue),
+		galeraPod("radio-db-galera-2", corev1.ConditionFalse),
+	}
+
+	var scraped []string
+	metricsClient := &restfake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         corev1.SchemeGroupVersion,
+		VersionedAPIPath:     "/api/v1",
+		Client: restfake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			scraped = append(scraped, req.URL.Path)
+			if strings.Contains(req.URL.Path, "radio-db-galera-1") {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(builder.GaleraClusterSizeMetric + " 3\n")),
+			}, nil
+		}),
+	}
+
+	manager := NewManager(nil, nil, nil, appmetrics.NewScraperForClient(metricsClient))
+	if got := manager.galeraClusterSize(context.Background(), pods); got != 3 {
+		t.Errorf("got cluster size %d, want 3", got)
+	}
+	if len(scraped) != 2 || !strings.Contains(scraped[0], "radio-db-galera-0:9104") {
+		t.Errorf("expected only ready pods to be scraped on the exporter port, got %v", scraped)
+	}
+	if got := NewManager(nil, nil, nil, nil).galeraClusterSize(context.Background(), pods); got != 0 {
+		t.Errorf("expected no cluster size without a scraper, got %d", got)
+	}
+}
+
 func TestStatusManager(t *testing.T) {
 	testEnv := &envtest.Environment{
 		CRDDirectoryPaths: []string{"../../config/crd/bases"},
@@ -157,7 +584,7 @@ func TestStatusManager(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	manager := NewManager(k8sClient)
+	manager := NewManager(k8sClient, nil, nil, nil)
 
 	t.Run("UpdateReconciled should set Reconciled condition", func(t *testing.T) {
 		ms := newValidMusicService("test-reconciled")
@@ -190,6 +617,10 @@ func TestStatusManager(t *testing.T) {
 		if !found {
 			t.Error("Reconciled condition not found")
 		}
+
+		if updated.Status.LastAppliedSpecHash != builder.SpecHash(ms) {
+			t.Errorf("expected lastAppliedSpecHash %q, got %q", builder.SpecHash(ms), updated.Status.LastAppliedSpecHash)
+		}
 	})
 
 	t.Run("UpdateError should set Reconciled condition to False", func(t *testing.T) {
@@ -224,6 +655,10 @@ func TestStatusManager(t *testing.T) {
 		if !found {
 			t.Error("Failed Reconciled condition not found")
 		}
+
+		if updated.Status.LastAppliedSpecHash != "" {
+			t.Errorf("expected lastAppliedSpecHash to stay empty after a failed reconcile, got %q", updated.Status.LastAppliedSpecHash)
+		}
 	})
 
 	t.Run("UpdateFromAppStatefulSet should update replica status", func(t *testing.T) {
@@ -274,6 +709,66 @@ func TestStatusManager(t *testing.T) {
 			t.Errorf("expected phase Progressing, got %s", updated.Status.Phase)
 		}
 	})
+
+	t.Run("UpdateStorageResizing should report expansion progress", func(t *testing.T) {
+		ms := newValidMusicService("test-resize")
+		ms.Spec.Storage.Size = "10Gi"
+
+		createPVC := func(name, storageClass string, conditions []corev1.PersistentVolumeClaimCondition) {
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: &storageClass,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}
+			if err := k8sClient.Create(ctx, pvc); err != nil {
+				t.Fatalf("failed to create PVC: %v", err)
+			}
+			pvc.Status = corev1.PersistentVolumeClaimStatus{
+				Phase:      corev1.ClaimBound,
+				Capacity:   corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+				Conditions: conditions,
+			}
+			if err := k8sClient.Status().Update(ctx, pvc); err != nil {
+				t.Fatalf("failed to update PVC status: %v", err)
+			}
+		}
+		for name, expandable := range map[string]bool{"expandable": true, "fixed": false} {
+			sc := &storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: name},
+				Provisioner:          "example.com/test",
+				AllowVolumeExpansion: &expandable,
+			}
+			if err := k8sClient.Create(ctx, sc); err != nil {
+				t.Fatalf("failed to create StorageClass: %v", err)
+			}
+		}
+
+		createPVC("music-data-test-resize-0", "expandable", []corev1.PersistentVolumeClaimCondition{
+			{Type: corev1.PersistentVolumeClaimFileSystemResizePending, Status: corev1.ConditionTrue},
+		})
+		resizing, err := manager.UpdateStorageResizing(ctx, ms)
+		if err != nil {
+			t.Fatalf("UpdateStorageResizing failed: %v", err)
+		}
+		cond := meta.FindStatusCondition(ms.Status.Conditions, "StorageResizing")
+		if !resizing || cond == nil || cond.Reason != "FileSystemResizePending" {
+			t.Errorf("expected FileSystemResizePending while the node expands the filesystem, got %v", cond)
+		}
+
+		createPVC("music-data-test-resize-1", "fixed", nil)
+		if _, err := manager.UpdateStorageResizing(ctx, ms); err != nil {
+			t.Fatalf("UpdateStorageResizing failed: %v", err)
+		}
+		cond = meta.FindStatusCondition(ms.Status.Conditions, "StorageResizing")
+		if cond == nil || cond.Reason != "ExpansionNotSupported" {
+			t.Errorf("expected ExpansionNotSupported for a StorageClass without allowVolumeExpansion, got %v", cond)
+		}
+	})
 }
 
 func int32Ptr(i int32) *int32 {
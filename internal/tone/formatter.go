@@ -17,6 +17,11 @@ limitations under the License.
 package tone
 
 import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
 	musicv1 "github.com/example/managedapp-operator/api/v1"
 )
 
@@ -35,3 +40,14 @@ func NewFormatter() *Formatter {
 func (f *Formatter) Format(_ *musicv1.MusicService, message string) string {
 	return message
 }
+
+// Logger returns the reconcile logger with the fields shared by every log line of a MusicService:
+// musicservice and component, plus tenantNamespace for dedicated tenants.
+// The namespace field is already attached by controller-runtime for each reconcile request
+func (f *Formatter) Logger(ctx context.Context, ms *musicv1.MusicService, component string) logr.Logger {
+	logger := log.FromContext(ctx).WithValues("musicservice", ms.Name, "component", component)
+	if ms.Status.TenantNamespace != "" {
+		logger = logger.WithValues("tenantNamespace", ms.Status.TenantNamespace)
+	}
+	return logger
+}
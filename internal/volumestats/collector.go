@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumestats
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Usage is the used and total space of a mounted PVC as reported by the kubelet
+type Usage struct {
+	UsedBytes     int64
+	CapacityBytes int64
+}
+
+// Percent returns the used share of the volume, rounded down
+func (u Usage) Percent() int32 {
+	if u.CapacityBytes <= 0 {
+		return 0
+	}
+	return int32(u.UsedBytes * 100 / u.CapacityBytes)
+}
+
+// Collector reads PVC usage from the kubelet summary API through the API server node proxy
+// (GET /api/v1/nodes/<node>/proxy/stats/summary), which needs get on nodes/proxy.
+// A nil Collector reports no usage
+type Collector struct {
+	rest rest.Interface
+}
+
+// NewCollector creates a Collector from the operator's REST config
+func NewCollector(cfg *rest.Config) (*Collector, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Collector{rest: clientset.CoreV1().RESTClient()}, nil
+}
+
+// NodeUsage returns the usage of every PVC mounted by a running pod on the node, keyed by namespace/name
+func (c *Collector) NodeUsage(ctx context.Context, node string) (map[types.NamespacedName]Usage, error) {
+	if c == nil {
+		return nil, nil
+	}
+	data, err := c.rest.Get().Resource("nodes").Name(node).SubResource("proxy").Suffix("stats/summary").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parseSummary(data)
+}
+
+// summary is the part of the kubelet stats/summary response that carries PVC usage
+type summary struct {
+	Pods []struct {
+		Volumes []struct {
+			PVCRef *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+			UsedBytes     *uint64 `json:"usedBytes"`
+			CapacityBytes *uint64 `json:"capacityBytes"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// parseSummary extracts PVC usage from a kubelet stats/summary response
+func parseSummary(data []byte) (map[types.NamespacedName]Usage, error) {
+	var s summary
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	usage := map[types.NamespacedName]Usage{}
+	for _, pod := range s.Pods {
+		for _, volume := range pod.Volumes {
+			if volume.PVCRef == nil || volume.UsedBytes == nil || volume.CapacityBytes == nil {
+				continue
+			}
+			usage[types.NamespacedName{Namespace: volume.PVCRef.Namespace, Name: volume.PVCRef.Name}] = Usage{
+				UsedBytes:     int64(*volume.UsedBytes),
+				CapacityBytes: int64(*volume.CapacityBytes),
+			}
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumestats
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestParseSummary(t *testing.T) {
+	data := []byte(`{
+  "node": {"nodeName": "node-a"},
+  "pods": [
+    {
+      "podRef": {"name": "demo-0", "namespace": "music"},
+      "volume": [
+        {"name": "kube-api-access", "usedBytes": 12, "capacityBytes": 100},
+        {"name": "music-data", "usedBytes": 8589934592, "capacityBytes": 10737418240,
+         "pvcRef": {"name": "music-data-demo-0", "namespace": "music"}}
+      ]
+    },
+    {
+      "podRef": {"name": "demo-db-master-0", "namespace": "music"},
+      "volume": [
+        {"name": "db-data", "pvcRef": {"name": "db-data-demo-db-master-0", "namespace": "music"}}
+      ]
+    }
+  ]
+}`)
+
+	usage, err := parseSummary(data)
+	if err != nil {
+		t.Fatalf("parseSummary: %v", err)
+	}
+	if len(usage) != 1 {
+		t.Fatalf("expected only the PVC with stats, got %v", usage)
+	}
+	got := usage[types.NamespacedName{Namespace: "music", Name: "music-data-demo-0"}]
+	if got.UsedBytes != 8589934592 || got.CapacityBytes != 10737418240 || got.Percent() != 80 {
+		t.Errorf("unexpected usage %+v (%d%%)", got, got.Percent())
+	}
+
+	if _, err := parseSummary([]byte("not json")); err == nil {
+		t.Error("expected an error for a malformed summary")
+	}
+	if (Usage{}).Percent() != 0 {
+		t.Error("expected 0% for an empty volume")
+	}
+}
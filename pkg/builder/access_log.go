@@ -0,0 +1,198 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	accessLogShipperImage = "fluent/fluent-bit:3.0"
+	// accessLogConfigImage là image init container ghi cấu hình Fluent Bit; image Fluent Bit không có shell
+	accessLogConfigImage = "busybox:1.36"
+	// defaultAccessLogPath là file access log ứng dụng ghi ra khi accessLogs.path chưa được đặt
+	defaultAccessLogPath = "/var/log/music/access.log"
+	// accessLogConfigDir chứa fluent-bit.conf do init container sinh ra
+	accessLogConfigDir = "/etc/access-log-shipper"
+	// accessLogSinkSecretUsernameKey và accessLogSinkSecretPasswordKey là key của accessLogs.sink.credentialsSecret
+	accessLogSinkSecretUsernameKey = "username"
+	accessLogSinkSecretPasswordKey = "password"
+)
+
+// accessLogsEnabled cho biết spec.accessLogs có được bật hay không
+func accessLogsEnabled(ms *musicv1.MusicService) bool {
+	return ms.Spec.AccessLogs != nil && ms.Spec.AccessLogs.Enabled
+}
+
+// accessLogPath trả về file access log trong container music-service
+func accessLogPath(logs *musicv1.AccessLogShippingSpec) string {
+	if logs.Path != "" {
+		return logs.Path
+	}
+	return defaultAccessLogPath
+}
+
+// buildAccessLogConfig sinh fluent-bit.conf đọc file access log và gửi tới sink.
+// File offset (DB) nằm cạnh file log trong emptyDir nên sidecar khởi động lại không gửi trùng bản ghi,
+// và mọi bản ghi mang tên MusicService, namespace, pod để đối soát bản quyền theo từng instance
+func buildAccessLogConfig(ms *musicv1.MusicService) string {
+	logs := ms.Spec.AccessLogs
+	logPath := accessLogPath(logs)
+	sink := logs.Sink
+
+	var b strings.Builder
+	b.WriteString("[SERVICE]\n")
+	b.WriteString("    Flush            5\n")
+	b.WriteString("    Log_Level        info\n\n")
+	b.WriteString("[INPUT]\n")
+	b.WriteString("    Name             tail\n")
+	fmt.Fprintf(&b, "    Path             %s\n", logPath)
+	fmt.Fprintf(&b, "    DB               %s\n", path.Join(path.Dir(logPath), "access-log-shipper.db"))
+	b.WriteString("    Tag              access\n")
+	b.WriteString("    Refresh_Interval 5\n")
+	b.WriteString("    Skip_Long_Lines  On\n\n")
+	b.WriteString("[FILTER]\n")
+	b.WriteString("    Name             record_modifier\n")
+	b.WriteString("    Match            access\n")
+	fmt.Fprintf(&b, "    Record           musicservice %s\n", ms.Name)
+	fmt.Fprintf(&b, "    Record           namespace %s\n", ms.Namespace)
+	b.WriteString("    Record           pod ${POD_NAME}\n\n")
+
+	b.WriteString("[OUTPUT]\n")
+	port := sink.Port
+	switch sink.Type {
+	case musicv1.AccessLogSinkElasticsearch:
+		if port == 0 {
+			port = 9200
+		}
+		index := sink.Index
+		if index == "" {
+			index = "music-access"
+		}
+		b.WriteString("    Name             es\n")
+		b.WriteString("    Match            access\n")
+		fmt.Fprintf(&b, "    Host             %s\n", sink.Host)
+		fmt.Fprintf(&b, "    Port             %d\n", port)
+		fmt.Fprintf(&b, "    Index            %s\n", index)
+		b.WriteString("    Suppress_Type_Name On\n")
+		if sink.CredentialsSecret != "" {
+			b.WriteString("    HTTP_User        ${LOG_SINK_USERNAME}\n")
+			b.WriteString("    HTTP_Passwd      ${LOG_SINK_PASSWORD}\n")
+		}
+	default:
+		if port == 0 {
+			port = 3100
+		}
+		labels := []string{"job=music-access", "musicservice=" + ms.Name, "namespace=" + ms.Namespace}
+		keys := make([]string, 0, len(sink.Labels))
+		for key := range sink.Labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			labels = append(labels, key+"="+sink.Labels[key])
+		}
+		b.WriteString("    Name             loki\n")
+		b.WriteString("    Match            access\n")
+		fmt.Fprintf(&b, "    Host             %s\n", sink.Host)
+		fmt.Fprintf(&b, "    Port             %d\n", port)
+		fmt.Fprintf(&b, "    Labels           %s\n", strings.Join(labels, ", "))
+		if sink.CredentialsSecret != "" {
+			b.WriteString("    HTTP_User        ${LOG_SINK_USERNAME}\n")
+			b.WriteString("    HTTP_Passwd      ${LOG_SINK_PASSWORD}\n")
+		}
+	}
+	if sink.TLS {
+		b.WriteString("    tls              On\n")
+	}
+	return b.String()
+}
+
+// applyAccessLogShipping mount emptyDir chứa access log vào container music-service (đường dẫn file qua
+// ACCESS_LOG_FILE), thêm init container ghi fluent-bit.conf và sidecar access-log-shipper chạy Fluent Bit
+func applyAccessLogShipping(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if !accessLogsEnabled(ms) {
+		return
+	}
+	logs := ms.Spec.AccessLogs
+	logPath := accessLogPath(logs)
+	// Access log nằm trong thư mục spec.logs thì dùng chung volume app-logs để được xoay vòng cùng các log khác
+	shared := appLogsEnabled(ms) && path.Dir(logPath) == appLogsDir(ms)
+	logMount := corev1.VolumeMount{Name: "access-log", MountPath: path.Dir(logPath)}
+	if shared {
+		logMount.Name = "app-logs"
+	}
+	configMount := corev1.VolumeMount{Name: "access-log-config", MountPath: accessLogConfigDir}
+	configFile := path.Join(accessLogConfigDir, "fluent-bit.conf")
+
+	if !shared {
+		spec.Volumes = append(spec.Volumes, corev1.Volume{Name: "access-log", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}})
+	}
+	spec.Volumes = append(spec.Volumes, corev1.Volume{Name: "access-log-config", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}})
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != "music-service" {
+			continue
+		}
+		spec.Containers[i].Env = append(spec.Containers[i].Env, corev1.EnvVar{Name: "ACCESS_LOG_FILE", Value: logPath})
+		if !shared {
+			spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, logMount)
+		}
+	}
+
+	spec.InitContainers = append(spec.InitContainers, corev1.Container{
+		Name:  "init-access-log-config",
+		Image: accessLogConfigImage,
+		// Heredoc có dấu nháy để shell không thay ${POD_NAME}; Fluent Bit tự thay biến môi trường khi đọc cấu hình
+		Command:      []string{"/bin/sh", "-c", fmt.Sprintf("cat > %s <<'EOF'\n%sEOF", configFile, buildAccessLogConfig(ms))},
+		VolumeMounts: []corev1.VolumeMount{configMount},
+	})
+
+	env := []corev1.EnvVar{
+		{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+	}
+	if secret := logs.Sink.CredentialsSecret; secret != "" {
+		env = append(env,
+			corev1.EnvVar{Name: "LOG_SINK_USERNAME", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: accessLogSinkSecretUsernameKey}}},
+			corev1.EnvVar{Name: "LOG_SINK_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: accessLogSinkSecretPasswordKey}}},
+		)
+	}
+
+	image := logs.Image
+	if image == "" {
+		image = accessLogShipperImage
+	}
+	shipper := corev1.Container{
+		Name:         "access-log-shipper",
+		Image:        image,
+		Command:      []string{"/fluent-bit/bin/fluent-bit", "-c", configFile},
+		Env:          env,
+		VolumeMounts: []corev1.VolumeMount{logMount, configMount},
+	}
+	if logs.Resources != nil {
+		shipper.Resources = *logs.Resources.DeepCopy()
+	}
+	spec.Containers = append(spec.Containers, shipper)
+}
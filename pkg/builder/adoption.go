@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// AdoptAnnotation đặt trên StatefulSet ứng dụng tự dựng để operator sinh MusicService tương đương và tiếp quản nó.
+	// "true" chỉ tiếp quản khi mọi cấu hình đều chuyển được sang spec, "force" tiếp quản kể cả khi có phần bị bỏ
+	AdoptAnnotation = "music.mixcorp.org/adopt"
+	// AdoptDatabaseAnnotation là tên StatefulSet MariaDB cùng namespace được chuyển thành spec.database
+	AdoptDatabaseAnnotation = "music.mixcorp.org/adopt-database"
+	// AdoptedFromAnnotation đặt trên MusicService sinh ra khi tiếp quản, ghi StatefulSet nguồn
+	AdoptedFromAnnotation = "music.mixcorp.org/adopted-from"
+
+	// AdoptForce là giá trị AdoptAnnotation cho phép bỏ qua cấu hình không chuyển được
+	AdoptForce = "force"
+
+	// adoptedBitrate và adoptedMaxConnections điền spec.streaming bắt buộc, StatefulSet tự dựng không có thông tin này
+	adoptedBitrate        = "320k"
+	adoptedMaxConnections = int32(1000)
+)
+
+// Adoption là MusicService sinh ra từ một bộ StatefulSet + Service + MariaDB tự dựng
+type Adoption struct {
+	// MusicService là tài nguyên cần tạo, cùng tên và namespace với StatefulSet ứng dụng
+	MusicService *musicv1.MusicService
+
+	// Unmapped liệt kê cấu hình không có field tương ứng trong MusicService và sẽ mất khi pod được cập nhật
+	Unmapped []string
+}
+
+// BuildAdoption sinh MusicService tương đương với StatefulSet ứng dụng sts, Service svc cùng tên (có thể nil)
+// và StatefulSet MariaDB db (có thể nil). Trả về lỗi khi tiếp quản sẽ làm mất dữ liệu, ví dụ PVC ứng dụng
+// không thể được StatefulSet mới dùng lại vì volumeClaimTemplate khác tên music-data
+func (b *ResourceBuilder) BuildAdoption(sts *appsv1.StatefulSet, svc *corev1.Service, db *appsv1.StatefulSet) (*Adoption, error) {
+	podSpec := sts.Spec.Template.Spec
+	if len(podSpec.Containers) == 0 {
+		return nil, fmt.Errorf("StatefulSet %s has no containers", sts.Name)
+	}
+	container := podSpec.Containers[0]
+
+	adoption := &Adoption{
+		MusicService: &musicv1.MusicService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        sts.Name,
+				Namespace:   sts.Namespace,
+				Annotations: map[string]string{AdoptedFromAnnotation: "StatefulSet/" + sts.Name},
+			},
+			Spec: musicv1.MusicServiceSpec{
+				Replicas:         1,
+				MinReadySeconds:  sts.Spec.MinReadySeconds,
+				Image:            container.Image,
+				ImagePullPolicy:  container.ImagePullPolicy,
+				Command:          container.Command,
+				Args:             container.Args,
+				RuntimeClassName: podSpec.RuntimeClassName,
+				Streaming: musicv1.StreamingSpec{
+					Bitrate:        adoptedBitrate,
+					MaxConnections: adoptedMaxConnections,
+				},
+			},
+		},
+	}
+	spec := &adoption.MusicService.Spec
+	unmapped := func(format string, args ...any) {
+		adoption.Unmapped = append(adoption.Unmapped, fmt.Sprintf(format, args...))
+	}
+
+	if sts.Spec.Replicas != nil && *sts.Spec.Replicas > 0 {
+		spec.Replicas = *sts.Spec.Replicas
+	}
+	if sts.Spec.PodManagementPolicy == appsv1.ParallelPodManagement {
+		spec.PodManagementPolicy = appsv1.ParallelPodManagement
+	}
+	if len(container.Resources.Limits) > 0 || len(container.Resources.Requests) > 0 {
+		spec.Resources = container.Resources.DeepCopy()
+	}
+	for _, extra := range podSpec.Containers[1:] {
+		unmapped("container %s", extra.Name)
+	}
+	if len(container.Env) > 0 || len(container.EnvFrom) > 0 {
+		unmapped("env of container %s", container.Name)
+	}
+
+	spec.ContainerPort = 80
+	if len(container.Ports) > 0 {
+		spec.ContainerPort = container.Ports[0].ContainerPort
+	}
+	spec.Port = spec.ContainerPort
+	if svc != nil && len(svc.Spec.Ports) > 0 {
+		spec.Port = svc.Spec.Ports[0].Port
+		if svc.Spec.Type != corev1.ServiceTypeClusterIP && svc.Spec.Type != "" {
+			spec.Service = &musicv1.ServiceOptionsSpec{Type: svc.Spec.Type, ExternalTrafficPolicy: svc.Spec.ExternalTrafficPolicy}
+		}
+	}
+
+	// StatefulSet mới chỉ nhận lại PVC music-data-<name>-N, nên volumeClaimTemplate khác tên sẽ làm mất dữ liệu
+	for _, claim := range sts.Spec.VolumeClaimTemplates {
+		if claim.Name != "music-data" {
+			return nil, fmt.Errorf("volumeClaimTemplate %s of StatefulSet %s would not be reused: MusicService keeps its data in music-data", claim.Name, sts.Name)
+		}
+		spec.Storage = adoptedStorage(&claim)
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == claim.Name && mount.MountPath != "/data" {
+				unmapped("mount path %s of %s (MusicService mounts it at /data)", mount.MountPath, claim.Name)
+			}
+		}
+	}
+
+	if db != nil {
+		database, err := adoptedDatabase(db)
+		if err != nil {
+			return nil, err
+		}
+		spec.Database = database
+	}
+
+	return adoption, nil
+}
+
+// adoptedDatabase chuyển StatefulSet MariaDB một instance sang spec.database không có replica
+func adoptedDatabase(db *appsv1.StatefulSet) (*musicv1.DatabaseSpec, error) {
+	if db.Spec.Replicas != nil && *db.Spec.Replicas > 1 {
+		return nil, fmt.Errorf("StatefulSet %s runs %d MariaDB pods; only a single instance can be adopted", db.Name, *db.Spec.Replicas)
+	}
+	if len(db.Spec.Template.Spec.Containers) == 0 || len(db.Spec.VolumeClaimTemplates) != 1 {
+		return nil, fmt.Errorf("StatefulSet %s must have a MariaDB container and exactly one volumeClaimTemplate", db.Name)
+	}
+	container := db.Spec.Template.Spec.Containers[0]
+
+	database := &musicv1.DatabaseSpec{
+		Enabled: true,
+		Image:   container.Image,
+		Storage: adoptedStorage(&db.Spec.VolumeClaimTemplates[0]),
+	}
+	if len(container.Resources.Limits) > 0 || len(container.Resources.Requests) > 0 {
+		database.Resources = container.Resources.DeepCopy()
+	}
+	for _, env := range container.Env {
+		if env.Name != "MYSQL_ROOT_PASSWORD" && env.Name != "MARIADB_ROOT_PASSWORD" {
+			continue
+		}
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+			database.RootPasswordSecretRef = env.ValueFrom.SecretKeyRef.DeepCopy()
+		} else {
+			database.RootPassword = env.Value
+		}
+	}
+	return database, nil
+}
+
+// adoptedStorage chép kích thước, storage class và volume mode của volumeClaimTemplate
+func adoptedStorage(claim *corev1.PersistentVolumeClaim) *musicv1.StorageSpec {
+	storage := &musicv1.StorageSpec{
+		StorageClassName: claim.Spec.StorageClassName,
+		VolumeMode:       claim.Spec.VolumeMode,
+	}
+	if size, ok := claim.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		storage.Size = size.String()
+	}
+	return storage
+}
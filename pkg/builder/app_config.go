@@ -0,0 +1,223 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// ConfigChecksumAnnotation là checksum các key cần khởi động lại pod, ghi trên pod template
+	ConfigChecksumAnnotation = "music.mixcorp.org/config-checksum"
+	// ConfigReloadChecksumAnnotation là checksum các key nạp lại được, ghi trực tiếp lên pod để
+	// sidecar config-reloader gửi tín hiệu mà không đổi pod template
+	ConfigReloadChecksumAnnotation = "music.mixcorp.org/config-reload-checksum"
+
+	configReloaderImage = "busybox:1.36"
+	// defaultAppConfigMountPath khớp với giá trị mặc định của config.mountPath trong CRD
+	defaultAppConfigMountPath = "/etc/music/config"
+	// podAnnotationsPath là file downward API chứa annotation của pod trong sidecar config-reloader
+	podAnnotationsPath = "/etc/podinfo"
+)
+
+// configReloadScript chờ annotation config-reload-checksum thay đổi, đợi kubelet cập nhật file trong volume
+// ConfigMap tới đúng checksum đó rồi mới gửi tín hiệu, để ứng dụng không nạp lại nội dung cũ
+const configReloadScript = `config_hash() {
+  (cd "$CONFIG_DIR" && for key in $RELOAD_KEYS; do [ -f "$key" ] && cat "$key"; done) | sha256sum | cut -d' ' -f1
+}
+applied=$(config_hash)
+while true; do
+  sleep 5
+  desired=$(sed -n "s|^$CHECKSUM_ANNOTATION=\"\(.*\)\"$|\1|p" "$ANNOTATIONS_FILE")
+  if [ -z "$desired" ] || [ "$desired" = "$applied" ] || [ "$(config_hash)" != "$desired" ]; then
+    continue
+  fi
+  if pkill "-$SIGNAL" -f "$PROCESS_NAME"; then
+    echo "sent SIG$SIGNAL to $PROCESS_NAME for config checksum $desired"
+    applied=$desired
+  fi
+done`
+
+// appConfigEnabled cho biết spec.config có được cấu hình hay không
+func appConfigEnabled(ms *musicv1.MusicService) bool {
+	return ms.Spec.Config != nil && ms.Spec.Config.ConfigMapName != ""
+}
+
+// AppConfigMapName trả về tên ConfigMap cấu hình của ứng dụng, rỗng khi spec.config chưa được đặt
+func AppConfigMapName(ms *musicv1.MusicService) string {
+	if !appConfigEnabled(ms) {
+		return ""
+	}
+	return ms.Spec.Config.ConfigMapName
+}
+
+// configReloadEnabled cho biết có key nào được nạp lại bằng tín hiệu thay vì khởi động lại pod
+func configReloadEnabled(ms *musicv1.MusicService) bool {
+	return appConfigEnabled(ms) && len(ms.Spec.Config.ReloadableKeys) > 0
+}
+
+// reloadableKeys trả về các key nạp lại được đã sắp xếp, cùng thứ tự với vòng lặp trong configReloadScript
+func reloadableKeys(ms *musicv1.MusicService) []string {
+	keys := append([]string(nil), ms.Spec.Config.ReloadableKeys...)
+	sort.Strings(keys)
+	return keys
+}
+
+// ConfigChecksums tính checksum của ConfigMap cấu hình: restart gồm các key thường (ghi lên pod template
+// nên thay đổi sẽ khởi động lại pod), reload là sha256 nội dung các key nạp lại được nối theo thứ tự tên,
+// khớp với config_hash trong sidecar config-reloader
+func ConfigChecksums(ms *musicv1.MusicService, cm *corev1.ConfigMap) (restart, reload string) {
+	values := map[string][]byte{}
+	for key, value := range cm.Data {
+		values[key] = []byte(value)
+	}
+	for key, value := range cm.BinaryData {
+		values[key] = value
+	}
+
+	reloadable := map[string]bool{}
+	reloadHash := sha256.New()
+	if configReloadEnabled(ms) {
+		for _, key := range reloadableKeys(ms) {
+			reloadable[key] = true
+			if value, ok := values[key]; ok {
+				_, _ = reloadHash.Write(value)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if !reloadable[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	restartHash := sha256.New()
+	for _, key := range keys {
+		_, _ = restartHash.Write([]byte(key))
+		_, _ = restartHash.Write([]byte{0})
+		_, _ = restartHash.Write(values[key])
+		_, _ = restartHash.Write([]byte{0})
+	}
+
+	restart = hex.EncodeToString(restartHash.Sum(nil))
+	if configReloadEnabled(ms) {
+		reload = hex.EncodeToString(reloadHash.Sum(nil))
+	}
+	return restart, reload
+}
+
+// applyAppConfig mount ConfigMap cấu hình vào container music-service (đường dẫn qua CONFIG_DIR). Khi có
+// reloadableKeys thì bật shareProcessNamespace và thêm sidecar config-reloader đọc annotation của pod qua
+// downward API; checksum khởi động lại do reconciler ghi lên pod template vì builder không đọc ConfigMap
+func applyAppConfig(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if !appConfigEnabled(ms) {
+		return
+	}
+	config := ms.Spec.Config
+
+	mountPath := config.MountPath
+	if mountPath == "" {
+		mountPath = defaultAppConfigMountPath
+	}
+	// Đặt sẵn các giá trị API server mặc định để podSpecNeedsUpdate không thấy khác biệt giả
+	defaultMode := corev1.ConfigMapVolumeSourceDefaultMode
+	mount := corev1.VolumeMount{Name: "app-config", MountPath: mountPath, ReadOnly: true}
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "app-config",
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: config.ConfigMapName},
+			DefaultMode:          &defaultMode,
+		}},
+	})
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != "music-service" {
+			continue
+		}
+		spec.Containers[i].Env = append(spec.Containers[i].Env, corev1.EnvVar{Name: "CONFIG_DIR", Value: mountPath})
+		spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, mount)
+	}
+
+	if !configReloadEnabled(ms) {
+		return
+	}
+
+	reload := config.Reload
+	if reload == nil {
+		reload = &musicv1.ConfigReloadSpec{}
+	}
+	signal := reload.Signal
+	if signal == "" {
+		signal = musicv1.ConfigReloadSignalHUP
+	}
+	processName := reload.ProcessName
+	if processName == "" {
+		processName = "music-service"
+	}
+	image := reload.Image
+	if image == "" {
+		image = configReloaderImage
+	}
+
+	// Sidecar cần thấy tiến trình ứng dụng để gửi tín hiệu
+	shareProcessNamespace := true
+	spec.ShareProcessNamespace = &shareProcessNamespace
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "pod-annotations",
+		VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+			Items: []corev1.DownwardAPIVolumeFile{{
+				Path:     "annotations",
+				FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.annotations"},
+			}},
+			DefaultMode: &defaultMode,
+		}},
+	})
+
+	reloader := corev1.Container{
+		Name:    "config-reloader",
+		Image:   image,
+		Command: []string{"/bin/sh", "-c", configReloadScript},
+		Env: []corev1.EnvVar{
+			{Name: "CONFIG_DIR", Value: mountPath},
+			{Name: "RELOAD_KEYS", Value: strings.Join(reloadableKeys(ms), " ")},
+			{Name: "ANNOTATIONS_FILE", Value: podAnnotationsPath + "/annotations"},
+			{Name: "CHECKSUM_ANNOTATION", Value: ConfigReloadChecksumAnnotation},
+			{Name: "SIGNAL", Value: string(signal)},
+			{Name: "PROCESS_NAME", Value: processName},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			mount,
+			{Name: "pod-annotations", MountPath: podAnnotationsPath, ReadOnly: true},
+		},
+		// Ứng dụng có thể chạy bằng user khác nên cần CAP_KILL để gửi tín hiệu
+		SecurityContext: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"KILL"}},
+		},
+	}
+	if reload.Resources != nil {
+		reloader.Resources = *reload.Resources.DeepCopy()
+	}
+	spec.Containers = append(spec.Containers, reloader)
+}
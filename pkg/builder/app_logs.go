@@ -0,0 +1,130 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	logRotatorImage = "busybox:1.36"
+	// defaultAppLogsPath là thư mục log của ứng dụng khi logs.path chưa được đặt
+	defaultAppLogsPath = "/var/log/music"
+	// defaultAppLogsVolumeSize là giới hạn emptyDir chứa log khi logs.volumeSize chưa được đặt
+	defaultAppLogsVolumeSize = "1Gi"
+	// defaultAppLogsMaxFileSize là kích thước xoay vòng khi logs.maxFileSize chưa được đặt
+	defaultAppLogsMaxFileSize = "100Mi"
+	// defaultAppLogsMaxFiles khớp với giá trị mặc định của logs.maxFiles trong CRD
+	defaultAppLogsMaxFiles = int32(5)
+)
+
+// appLogsEnabled cho biết spec.logs có được cấu hình hay không
+func appLogsEnabled(ms *musicv1.MusicService) bool {
+	return ms.Spec.Logs != nil
+}
+
+// appLogsDir trả về thư mục log của ứng dụng
+func appLogsDir(ms *musicv1.MusicService) string {
+	if ms.Spec.Logs.Path != "" {
+		return ms.Spec.Logs.Path
+	}
+	return defaultAppLogsPath
+}
+
+// buildLogRotateScript sinh vòng lặp xoay vòng kiểu copytruncate: chép file *.log sang <file>.<thời điểm>
+// rồi làm rỗng file gốc, để ứng dụng và Fluent Bit vẫn giữ file đang mở; sau đó chỉ giữ maxFiles bản
+// mới nhất và xóa bản cũ hơn retentionHours
+func buildLogRotateScript(ms *musicv1.MusicService) string {
+	logs := ms.Spec.Logs
+
+	maxFileSize := parseQuantity(defaultAppLogsMaxFileSize)
+	if logs.MaxFileSize != "" {
+		maxFileSize = parseQuantity(logs.MaxFileSize)
+	}
+	maxFiles := defaultAppLogsMaxFiles
+	if logs.MaxFiles > 0 {
+		maxFiles = logs.MaxFiles
+	}
+
+	script := fmt.Sprintf(`LOG_DIR=%q
+MAX_BYTES=%d
+MAX_AGE_SECONDS=%d
+MAX_FILES=%d
+last_rotation=$(date +%%s)
+while true; do
+  sleep 60
+  now=$(date +%%s)
+  rotate_all=0
+  if [ "$MAX_AGE_SECONDS" -gt 0 ] && [ $((now - last_rotation)) -ge "$MAX_AGE_SECONDS" ]; then
+    rotate_all=1
+    last_rotation=$now
+  fi
+  for f in "$LOG_DIR"/*.log; do
+    [ -f "$f" ] || continue
+    size=$(stat -c %%s "$f")
+    if [ "$size" -gt "$MAX_BYTES" ] || { [ "$rotate_all" = 1 ] && [ "$size" -gt 0 ]; }; then
+      cp "$f" "$f.$(date +%%Y%%m%%d-%%H%%M%%S)" && : > "$f"
+    fi
+    ls -1t "$f".* 2>/dev/null | tail -n +$((MAX_FILES + 1)) | xargs -r rm -f
+  done
+`, appLogsDir(ms), maxFileSize.Value(), int64(logs.MaxAgeHours)*3600, maxFiles)
+	if logs.RetentionHours > 0 {
+		script += fmt.Sprintf("  find \"$LOG_DIR\" -name '*.log.*' -mmin +%d -delete\n", int64(logs.RetentionHours)*60)
+	}
+	return script + "done"
+}
+
+// applyAppLogs mount emptyDir có giới hạn dung lượng làm thư mục log của container music-service
+// (đường dẫn qua LOG_DIR) và thêm sidecar log-rotator chạy buildLogRotateScript
+func applyAppLogs(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if !appLogsEnabled(ms) {
+		return
+	}
+	logs := ms.Spec.Logs
+
+	sizeLimit := parseQuantity(defaultAppLogsVolumeSize)
+	if logs.VolumeSize != "" {
+		sizeLimit = parseQuantity(logs.VolumeSize)
+	}
+	mount := corev1.VolumeMount{Name: "app-logs", MountPath: appLogsDir(ms)}
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name:         "app-logs",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &sizeLimit}},
+	})
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != "music-service" {
+			continue
+		}
+		spec.Containers[i].Env = append(spec.Containers[i].Env, corev1.EnvVar{Name: "LOG_DIR", Value: appLogsDir(ms)})
+		spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, mount)
+	}
+
+	rotator := corev1.Container{
+		Name:         "log-rotator",
+		Image:        logRotatorImage,
+		Command:      []string{"/bin/sh", "-c", buildLogRotateScript(ms)},
+		VolumeMounts: []corev1.VolumeMount{mount},
+	}
+	if logs.Resources != nil {
+		rotator.Resources = *logs.Resources.DeepCopy()
+	}
+	spec.Containers = append(spec.Containers, rotator)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// AppliedSpecHashAnnotation ghi mã băm spec của MusicService lần gần nhất reconcile thành công
+	AppliedSpecHashAnnotation = "music.mixcorp.org/applied-spec-hash"
+	// AppliedGenerationAnnotation ghi metadata.generation tương ứng với mã băm trên
+	AppliedGenerationAnnotation = "music.mixcorp.org/applied-generation"
+	// AppliedAtAnnotation ghi thời điểm (RFC3339, UTC) spec đó được áp dụng lên tài nguyên con
+	AppliedAtAnnotation = "music.mixcorp.org/applied-at"
+	// AppliedDesiredHashAnnotation ghi mã băm trạng thái mong muốn mà operator ghi lên tài nguyên con lần gần nhất,
+	// gồm cả đầu vào operator tự tính như checksum ConfigMap
+	AppliedDesiredHashAnnotation = "music.mixcorp.org/applied-desired-hash"
+)
+
+// SpecHash trả về mã băm ngắn của toàn bộ spec, dùng để đối chiếu tài nguyên con với generation của CR
+func SpecHash(ms *musicv1.MusicService) string {
+	data, _ := json.Marshal(ms.Spec)
+	h := fnv.New32a()
+	_, _ = h.Write(data)
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// DesiredHash trả về mã băm ngắn của tài nguyên con mong muốn do builder dựng ra, dùng để nhận biết
+// khác biệt giữa tài nguyên đang chạy và desired là do desired đổi hay do sửa ngoài operator
+func DesiredHash(desired any) string {
+	data, _ := json.Marshal(desired)
+	h := fnv.New32a()
+	_, _ = h.Write(data)
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// InstanceSelector trả về nhãn chung của mọi tài nguyên con thuộc MusicService
+func InstanceSelector(ms *musicv1.MusicService) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/instance":   ms.Name,
+		"app.kubernetes.io/managed-by": "music-operator",
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	auditLogShipperImage = "busybox:1.36"
+	// auditLogDir là emptyDir dùng chung giữa container mariadb và sidecar; không đặt trong /var/lib/mysql
+	// vì MariaDB coi mọi thư mục trong data directory là một database
+	auditLogDir  = "/var/log/mysql-audit"
+	auditLogFile = auditLogDir + "/server_audit.log"
+)
+
+// defaultAuditLogEvents ghi kết nối và truy vấn làm thay đổi schema hoặc dữ liệu
+var defaultAuditLogEvents = []musicv1.AuditLogEvent{"CONNECT", "QUERY_DDL", "QUERY_DML"}
+
+// auditLogEnabled cho biết spec.database.auditLog có được bật hay không
+func auditLogEnabled(audit *musicv1.DatabaseAuditLogSpec) bool {
+	return audit != nil && audit.Enabled
+}
+
+// buildAuditLogOptions sinh cấu hình nạp plugin server_audit và ghi log vào auditLogFile.
+// FORCE_PLUS_PERMANENT ngăn user có quyền SUPER gỡ plugin để tắt audit khi đang chạy
+func buildAuditLogOptions(audit *musicv1.DatabaseAuditLogSpec) string {
+	if !auditLogEnabled(audit) {
+		return ""
+	}
+	events := audit.Events
+	if len(events) == 0 {
+		events = defaultAuditLogEvents
+	}
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = string(event)
+	}
+
+	options := "plugin_load_add=server_audit\n" +
+		"server_audit=FORCE_PLUS_PERMANENT\n" +
+		"server_audit_logging=ON\n" +
+		"server_audit_output_type=file\n" +
+		fmt.Sprintf("server_audit_file_path=%s\n", auditLogFile) +
+		fmt.Sprintf("server_audit_events=%s\n", strings.Join(names, ","))
+	if len(audit.IncludeUsers) > 0 {
+		options += fmt.Sprintf("server_audit_incl_users=%s\n", strings.Join(audit.IncludeUsers, ","))
+	}
+	if len(audit.ExcludeUsers) > 0 {
+		options += fmt.Sprintf("server_audit_excl_users=%s\n", strings.Join(audit.ExcludeUsers, ","))
+	}
+	if audit.FileRotateSize != "" {
+		size := parseQuantity(audit.FileRotateSize)
+		options += fmt.Sprintf("server_audit_file_rotate_size=%d\n", size.Value())
+	}
+	if audit.FileRotations != nil {
+		options += fmt.Sprintf("server_audit_file_rotations=%d\n", *audit.FileRotations)
+	}
+	return options
+}
+
+// applyAuditLog mount emptyDir chứa file audit vào container mariadb và thêm sidecar audit-log-shipper
+// đọc file đó ra stdout; tail -F theo tên file nên vẫn đọc tiếp sau khi plugin xoay vòng log
+func applyAuditLog(audit *musicv1.DatabaseAuditLogSpec, spec *corev1.PodSpec) {
+	if !auditLogEnabled(audit) {
+		return
+	}
+
+	mount := corev1.VolumeMount{Name: "db-audit-log", MountPath: auditLogDir}
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "db-audit-log",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == "mariadb" {
+			spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, mount)
+		}
+	}
+
+	image := audit.ShipperImage
+	if image == "" {
+		image = auditLogShipperImage
+	}
+	shipper := corev1.Container{
+		Name:         "audit-log-shipper",
+		Image:        image,
+		Command:      []string{"/bin/sh", "-c", fmt.Sprintf("exec tail -n +1 -F %s", auditLogFile)},
+		VolumeMounts: []corev1.VolumeMount{mount},
+	}
+	if audit.ShipperResources != nil {
+		shipper.Resources = *audit.ShipperResources.DeepCopy()
+	}
+	spec.Containers = append(spec.Containers, shipper)
+}
@@ -0,0 +1,464 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	defaultBackupRetention = int32(7)
+	backupMountPath        = "/backup"
+	backupUploadImage      = "minio/mc:RELEASE.2024-06-12T14-34-03Z"
+)
+
+// BackupResult là termination message của container backup khi sao lưu thành công
+type BackupResult struct {
+	// File là tên file sao lưu trong PVC sao lưu
+	File string `json:"file"`
+	// SizeBytes là kích thước file sao lưu
+	SizeBytes int64 `json:"sizeBytes"`
+}
+
+// BackupName trả về tên chung của PVC và CronJob sao lưu
+func BackupName(ms *musicv1.MusicService) string {
+	return ms.Name + "-db-backup"
+}
+
+// BuildDatabaseBackupPVC xây dựng PVC lưu bản sao lưu của cơ sở dữ liệu
+func (b *ResourceBuilder) BuildDatabaseBackupPVC(ms *musicv1.MusicService) *corev1.PersistentVolumeClaim {
+	labels := b.getLabels(ms, "db-backup")
+	destination := ms.Spec.Database.Backup.Destination.PVC
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            BackupName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			StorageClassName: destination.StorageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: parseQuantity(destination.Size),
+				},
+			},
+		},
+	}
+}
+
+// BuildDatabaseBackupCronJob xây dựng CronJob sao lưu cơ sở dữ liệu vào PVC sao lưu
+// và xoay vòng các bản sao lưu cũ theo Retention
+func (b *ResourceBuilder) BuildDatabaseBackupCronJob(ms *musicv1.MusicService) *batchv1.CronJob {
+	labels := b.getLabels(ms, "db-backup")
+	backup := ms.Spec.Database.Backup
+	config := b.buildDatabaseConfig(ms)
+	method := backupMethod(backup)
+
+	retention := defaultBackupRetention
+	if backup.Retention != nil {
+		retention = *backup.Retention
+	}
+
+	historyLimit := int32(3)
+	backoffLimit := int32(1)
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyOnFailure,
+		ImagePullSecrets: buildImagePullSecrets(ms),
+		Containers: []corev1.Container{
+			{
+				Name:            "backup",
+				Image:           config.image,
+				ImagePullPolicy: config.imagePullPolicy,
+				Command:         []string{"/bin/sh", "-c", buildBackupScript(method, config.masterHost)},
+				Env: []corev1.EnvVar{
+					config.rootPasswordEnv(),
+					{Name: "BACKUP_PREFIX", Value: ms.Name},
+					{Name: "BACKUP_RETENTION", Value: fmt.Sprintf("%d", retention)},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "backup", MountPath: backupMountPath},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			backupVolume(ms),
+		},
+	}
+
+	if method == musicv1.BackupMethodPhysical {
+		// mariabackup phải đọc trực tiếp datadir nên Job cần mount PVC dữ liệu của primary
+		// và chạy cùng node với pod primary (PVC ReadWriteOnce)
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{Name: "db-data", MountPath: "/var/lib/mysql", ReadOnly: true})
+		podSpec.Volumes = append(podSpec.Volumes, primaryDataVolume(ms, true))
+		podSpec.Affinity = primaryPodAffinity(ms)
+	}
+
+	if destination := backup.Destination.ObjectStorage; destination != nil {
+		// Container upload chỉ chạy sau khi sao lưu vào PVC thành công nên backup chuyển thành init container
+		podSpec.InitContainers = podSpec.Containers
+		podSpec.Containers = []corev1.Container{buildBackupUploadContainer(ms, destination)}
+	}
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            BackupName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   backup.Schedule,
+			TimeZone:                   backup.TimeZone,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: &historyLimit,
+			FailedJobsHistoryLimit:     &historyLimit,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: &backoffLimit,
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: labels,
+						},
+						Spec: podSpec,
+					},
+				},
+			},
+		},
+	}
+	b.applyImageOverrides(&cronJob.Spec.JobTemplate.Spec.Template.Spec)
+	return cronJob
+}
+
+// buildBackupUploadContainer dựng container đồng bộ thư mục sao lưu lên bucket của MinIO trong cluster;
+// mc mirror --remove xóa khỏi bucket các bản đã bị xoay vòng khỏi PVC
+func buildBackupUploadContainer(ms *musicv1.MusicService, destination *musicv1.BackupObjectStorageDestination) corev1.Container {
+	prefix := destination.Prefix
+	if prefix == "" {
+		prefix = ms.Name
+	}
+
+	return corev1.Container{
+		Name:  "upload",
+		Image: backupUploadImage,
+		Command: []string{"/bin/sh", "-c", `set -e
+mc alias set dest "$AWS_ENDPOINT_URL" "$AWS_ACCESS_KEY_ID" "$AWS_SECRET_ACCESS_KEY"
+mc mb --ignore-existing "dest/$BACKUP_BUCKET"
+mc mirror --overwrite --remove --exclude "lost+found/*" ` + backupMountPath + ` "dest/$BACKUP_BUCKET/$BACKUP_BUCKET_PREFIX"`},
+		Env: []corev1.EnvVar{
+			{Name: "BACKUP_BUCKET", Value: destination.Bucket},
+			{Name: "BACKUP_BUCKET_PREFIX", Value: prefix},
+		},
+		EnvFrom: []corev1.EnvFromSource{
+			{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ObjectStorageSecretName(ms)},
+				},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "backup", MountPath: backupMountPath, ReadOnly: true},
+		},
+	}
+}
+
+// BuildDatabaseRestoreJob xây dựng Job khôi phục tương ứng với phương thức sao lưu đang cấu hình
+// Logical: nạp lại file SQL vào master đang chạy
+// Physical: giải nén và prepare bản mariabackup vào PVC dữ liệu của primary; primary phải được dừng trước
+func (b *ResourceBuilder) BuildDatabaseRestoreJob(ms *musicv1.MusicService, archive string) *batchv1.Job {
+	return b.buildRestoreJob(ms, ms, ms.Name+"-db-restore", archive)
+}
+
+// BuildDatabaseInitRestoreJob xây dựng Job khôi phục bản sao lưu của source vào cơ sở dữ liệu của ms,
+// dùng cho spec.database.initFrom; archive rỗng nghĩa là lấy bản mới nhất
+// Khi source ở namespace khác, Job chạy trong namespace của source (để mount PVC sao lưu)
+// và nạp dữ liệu qua mạng vào master của ms
+func (b *ResourceBuilder) BuildDatabaseInitRestoreJob(ms, source *musicv1.MusicService, archive string) *batchv1.Job {
+	name := ms.Name + "-db-init-restore"
+	if WorkloadNamespace(source) != WorkloadNamespace(ms) {
+		name = cloneJobName(ms)
+	}
+	return b.buildRestoreJob(ms, source, name, archive)
+}
+
+// cloneJobName trả về tên Job clone của ms trong namespace của source; tên có namespace của ms
+// để các bản clone cùng tên từ nhiều namespace không trùng nhau
+func cloneJobName(ms *musicv1.MusicService) string {
+	return fmt.Sprintf("%s-%s-db-clone", WorkloadNamespace(ms), ms.Name)
+}
+
+// CloneRootPasswordSecretName trả về tên Secret chứa bản sao mật khẩu root của ms trong namespace của source;
+// Job clone khác namespace không đọc được rootPasswordSecretRef của ms nên reconciler sao chép key đó sang đây
+func CloneRootPasswordSecretName(ms *musicv1.MusicService) string {
+	return cloneJobName(ms) + "-root-password"
+}
+
+// BuildDatabasePrimaryDataPVC xây dựng trước PVC dữ liệu của pod primary (ordinal 0) với đúng tên
+// mà volumeClaimTemplate sẽ dùng, để Job khôi phục physical ghi dữ liệu trước khi StatefulSet được tạo
+func (b *ResourceBuilder) BuildDatabasePrimaryDataPVC(ms *musicv1.MusicService) *corev1.PersistentVolumeClaim {
+	stsName, component := primaryStatefulSetName(ms)
+	config := b.buildDatabaseConfig(ms)
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("db-data-%s-0", stsName),
+			Namespace: WorkloadNamespace(ms),
+			Labels: map[string]string{
+				"app":       ms.Name,
+				"component": component,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: config.storageSize,
+				},
+			},
+		},
+	}
+}
+
+// buildRestoreJob dựng Job khôi phục vào ms từ PVC sao lưu của source, theo phương thức sao lưu của source
+func (b *ResourceBuilder) buildRestoreJob(ms, source *musicv1.MusicService, name, archive string) *batchv1.Job {
+	labels := b.getLabels(ms, "db-restore")
+	config := b.buildDatabaseConfig(ms)
+	method := backupMethod(source.Spec.Database.Backup)
+	backoffLimit := int32(2)
+
+	namespace := WorkloadNamespace(ms)
+	masterHost := config.masterHost
+	ownerReferences := b.OwnerReferences(ms)
+	pullSecrets := buildImagePullSecrets(ms)
+	rootPassword := config.rootPasswordEnv()
+	var ttlSecondsAfterFinished *int32
+	if WorkloadNamespace(source) != namespace {
+		// OwnerReference không được phép khác namespace nên Job clone tự dọn bằng TTL
+		masterHost = fmt.Sprintf("%s.%s.svc.cluster.local", config.masterHost, namespace)
+		labels["music.mixcorp.org/clone-target-namespace"] = namespace
+		namespace = WorkloadNamespace(source)
+		ownerReferences = nil
+		// Secret registry của ms không có trong namespace của source nên dùng Secret của source
+		pullSecrets = buildImagePullSecrets(source)
+		// Tương tự, rootPasswordSecretRef của ms được đọc từ bản sao trong namespace của source
+		if rootPassword.ValueFrom != nil {
+			rootPassword.ValueFrom.SecretKeyRef.Name = CloneRootPasswordSecretName(ms)
+		}
+		ttl := int32(3600)
+		ttlSecondsAfterFinished = &ttl
+	}
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyOnFailure,
+		ImagePullSecrets: pullSecrets,
+		Containers: []corev1.Container{
+			{
+				Name:            "restore",
+				Image:           config.image,
+				ImagePullPolicy: config.imagePullPolicy,
+				Command:         []string{"/bin/sh", "-c", buildRestoreScript(method, masterHost)},
+				Env: []corev1.EnvVar{
+					rootPassword,
+					{Name: "BACKUP_PREFIX", Value: source.Name},
+					{Name: "BACKUP_ARCHIVE", Value: archive},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "backup", MountPath: backupMountPath, ReadOnly: true},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			backupVolume(source),
+		},
+	}
+
+	if method == musicv1.BackupMethodPhysical {
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{Name: "db-data", MountPath: "/var/lib/mysql"})
+		podSpec.Volumes = append(podSpec.Volumes, primaryDataVolume(ms, false))
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			Labels:          labels,
+			OwnerReferences: ownerReferences,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: ttlSecondsAfterFinished,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+	b.applyImageOverrides(&job.Spec.Template.Spec)
+	return job
+}
+
+// BackupMethodOf trả về phương thức sao lưu hiệu lực của MusicService (mặc định Logical)
+func BackupMethodOf(ms *musicv1.MusicService) musicv1.BackupMethod {
+	if ms.Spec.Database == nil {
+		return musicv1.BackupMethodLogical
+	}
+	return backupMethod(ms.Spec.Database.Backup)
+}
+
+func backupMethod(backup *musicv1.DatabaseBackupSpec) musicv1.BackupMethod {
+	if backup == nil || backup.Method == "" {
+		return musicv1.BackupMethodLogical
+	}
+	return backup.Method
+}
+
+func backupArchiveExtension(method musicv1.BackupMethod) string {
+	if method == musicv1.BackupMethodPhysical {
+		return "xb.gz"
+	}
+	return "sql.gz"
+}
+
+func backupVolume(ms *musicv1.MusicService) corev1.Volume {
+	return corev1.Volume{
+		Name: "backup",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: BackupName(ms),
+			},
+		},
+	}
+}
+
+// primaryStatefulSetName trả về StatefulSet chứa node primary (master hoặc galera-0)
+func primaryStatefulSetName(ms *musicv1.MusicService) (string, string) {
+	if ms.Spec.Database.HighAvailability != nil && ms.Spec.Database.HighAvailability.Enabled {
+		return ms.Name + "-db-galera", "db-galera"
+	}
+	return ms.Name + "-db-master", "db-master"
+}
+
+func primaryDataVolume(ms *musicv1.MusicService, readOnly bool) corev1.Volume {
+	stsName, _ := primaryStatefulSetName(ms)
+	return corev1.Volume{
+		Name: "db-data",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: fmt.Sprintf("db-data-%s-0", stsName),
+				ReadOnly:  readOnly,
+			},
+		},
+	}
+}
+
+func primaryPodAffinity(ms *musicv1.MusicService) *corev1.Affinity {
+	stsName, component := primaryStatefulSetName(ms)
+	return &corev1.Affinity{
+		PodAffinity: &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+				{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app":                                ms.Name,
+							"component":                          component,
+							"statefulset.kubernetes.io/pod-name": stsName + "-0",
+						},
+					},
+					TopologyKey: "kubernetes.io/hostname",
+				},
+			},
+		},
+	}
+}
+
+// buildBackupScript tạo script sao lưu theo phương thức rồi xóa các bản vượt quá retention.
+// Tên và kích thước file được ghi vào termination message để operator cập nhật status.database.backup
+func buildBackupScript(method musicv1.BackupMethod, masterHost string) string {
+	dump := fmt.Sprintf(`mysqldump -h %s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} --all-databases --single-transaction --routines --triggers | gzip > "${TARGET}.tmp"`, masterHost)
+	if method == musicv1.BackupMethodPhysical {
+		dump = fmt.Sprintf(`mariabackup --backup --stream=xbstream --datadir=/var/lib/mysql --host=%s --port=3306 --user=root --password=${MYSQL_ROOT_PASSWORD} | gzip > "${TARGET}.tmp"`, masterHost)
+	}
+
+	return fmt.Sprintf(`
+set -e
+TS=$(date +%%Y%%m%%d-%%H%%M%%S)
+TARGET="%[1]s/${BACKUP_PREFIX}-${TS}.%[2]s"
+echo "Backing up databases to ${TARGET}..."
+%[3]s
+mv "${TARGET}.tmp" "${TARGET}"
+printf '{"file":"%%s","sizeBytes":%%s}' "$(basename "${TARGET}")" "$(stat -c %%s "${TARGET}")" > /dev/termination-log
+echo "Rotating backups, keeping ${BACKUP_RETENTION}..."
+ls -1t %[1]s/${BACKUP_PREFIX}-*.%[2]s | tail -n +$((BACKUP_RETENTION + 1)) | xargs -r rm -f
+echo "Backup complete."
+`, backupMountPath, backupArchiveExtension(method), dump)
+}
+
+// buildRestoreScript tạo script khôi phục đối ứng với buildBackupScript
+// Khi BACKUP_ARCHIVE rỗng, script chọn bản sao lưu mới nhất của BACKUP_PREFIX
+func buildRestoreScript(method musicv1.BackupMethod, masterHost string) string {
+	selectArchive := fmt.Sprintf(`
+if [ -z "${BACKUP_ARCHIVE}" ]; then
+	BACKUP_ARCHIVE=$(ls -1t %[1]s/${BACKUP_PREFIX}-*.%[2]s | head -n 1 | xargs -r basename)
+fi
+if [ -z "${BACKUP_ARCHIVE}" ]; then
+	echo "No backup archive found for ${BACKUP_PREFIX}"
+	exit 1
+fi`, backupMountPath, backupArchiveExtension(method))
+
+	if method == musicv1.BackupMethodPhysical {
+		return fmt.Sprintf(`
+set -e%[2]s
+echo "Restoring physical backup ${BACKUP_ARCHIVE} into data directory..."
+rm -rf /var/lib/mysql/*
+gunzip -c "%[1]s/${BACKUP_ARCHIVE}" | mbstream -x -C /var/lib/mysql
+mariabackup --prepare --target-dir=/var/lib/mysql
+chown -R 999:999 /var/lib/mysql
+echo "Restore complete."
+`, backupMountPath, selectArchive)
+	}
+
+	return fmt.Sprintf(`
+set -e%[3]s
+echo "Waiting for %[2]s to be ready..."
+until mysql -h %[2]s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SELECT 1" > /dev/null 2>&1; do
+	sleep 2
+done
+echo "Restoring logical backup ${BACKUP_ARCHIVE}..."
+gunzip -c "%[1]s/${BACKUP_ARCHIVE}" | mysql -h %[2]s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD}
+echo "Restore complete."
+`, backupMountPath, masterHost, selectArchive)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/database"
+)
+
+const (
+	// defaultDatabaseName là database được tạo sẵn cho ứng dụng
+	defaultDatabaseName = "musicdb"
+	// defaultDatabaseUser là user ứng dụng, chỉ có quyền trên defaultDatabaseName
+	defaultDatabaseUser = "music"
+
+	// Các key trong Secret thông tin kết nối
+	ConnectionHostKey     = "host"
+	ConnectionPortKey     = "port"
+	ConnectionDatabaseKey = "database"
+	ConnectionUsernameKey = "username"
+	ConnectionPasswordKey = "password"
+	ConnectionURLKey      = "url"
+)
+
+// DatabaseConnectionSecretName trả về tên Secret chứa thông tin kết nối cơ sở dữ liệu cho ứng dụng
+func DatabaseConnectionSecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-db-conn"
+}
+
+// BuildDatabaseConnectionSecret xây dựng Secret thông tin kết nối tới endpoint ghi với user ứng dụng
+// Mật khẩu do reconciler sinh một lần và truyền vào để không bị thay đổi giữa các lần reconcile
+func (b *ResourceBuilder) BuildDatabaseConnectionSecret(ms *musicv1.MusicService, password string) *corev1.Secret {
+	labels := b.getLabels(ms, "db-conn")
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            DatabaseConnectionSecretName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			ConnectionHostKey:     []byte(fmt.Sprintf("%s-db-master.%s.svc", ms.Name, WorkloadNamespace(ms))),
+			ConnectionPortKey:     []byte(strconv.Itoa(int(b.DatabaseProvider(ms).DefaultPort()))),
+			ConnectionDatabaseKey: []byte(defaultDatabaseName),
+			ConnectionUsernameKey: []byte(defaultDatabaseUser),
+			ConnectionPasswordKey: []byte(password),
+		},
+	}
+}
+
+// buildDatabaseUserEnv trả về biến môi trường user ứng dụng theo tên của provider
+// (MYSQL_USER/MYSQL_PASSWORD để image MariaDB tạo user khi khởi tạo data directory)
+func buildDatabaseUserEnv(ms *musicv1.MusicService, provider database.Provider) []corev1.EnvVar {
+	secretName := DatabaseConnectionSecretName(ms)
+	userEnv, passwordEnv := provider.UserEnv()
+
+	return []corev1.EnvVar{
+		{
+			Name: userEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  ConnectionUsernameKey,
+				},
+			},
+		},
+		{
+			Name: passwordEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  ConnectionPasswordKey,
+				},
+			},
+		},
+	}
+}
+
+// buildDatabaseConnectionEnv trả về biến môi trường DB_* cho container ứng dụng khi bật spec.database.injectEnv
+func buildDatabaseConnectionEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	if ms.Spec.Database == nil || !ms.Spec.Database.Enabled || !ms.Spec.Database.InjectEnv {
+		return nil
+	}
+
+	secretName := DatabaseConnectionSecretName(ms)
+	keys := []struct{ env, key string }{
+		{"DB_HOST", ConnectionHostKey},
+		{"DB_PORT", ConnectionPortKey},
+		{"DB_NAME", ConnectionDatabaseKey},
+		{"DB_USER", ConnectionUsernameKey},
+		{"DB_PASSWORD", ConnectionPasswordKey},
+	}
+
+	env := make([]corev1.EnvVar, 0, len(keys))
+	for _, k := range keys {
+		env = append(env, corev1.EnvVar{
+			Name: k.env,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  k.key,
+				},
+			},
+		})
+	}
+	return env
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// ReplicationUserAnnotation trên pod template của replica ghi user replication đã áp dụng;
+	// đổi giá trị này khiến StatefulSet khởi động lại lần lượt từng replica để chạy lại CHANGE MASTER
+	ReplicationUserAnnotation = "music.mixcorp.org/replication-user"
+
+	// CredentialsStepGrant tạo user replication mới trên master
+	CredentialsStepGrant = "grant"
+	// CredentialsStepRetire xóa user replication cũ khỏi master
+	CredentialsStepRetire = "retire"
+)
+
+// ReplicationCredentialsJobName trả về tên Job của một bước xoay vòng user replication
+func ReplicationCredentialsJobName(ms *musicv1.MusicService, step string) string {
+	return fmt.Sprintf("%s-db-credentials-%s", ms.Name, step)
+}
+
+// BuildReplicationCredentialsJob xây dựng Job chạy một bước xoay vòng trên master:
+// grant tạo (hoặc đặt lại mật khẩu) user trong Secret replication và cấp quyền REPLICATION SLAVE,
+// retire xóa previousUser sau khi mọi replica đã chuyển sang user mới
+func (b *ResourceBuilder) BuildReplicationCredentialsJob(ms *musicv1.MusicService, step, previousUser string) *batchv1.Job {
+	labels := b.getLabels(ms, "db-credentials")
+	config := b.buildDatabaseConfig(ms)
+	backoffLimit := int32(2)
+
+	env := []corev1.EnvVar{
+		{Name: "MASTER_HOST", Value: config.masterHost},
+		config.rootPasswordEnv(),
+	}
+	var sql string
+	switch step {
+	case CredentialsStepRetire:
+		env = append(env, corev1.EnvVar{Name: "PREVIOUS_USER", Value: previousUser})
+		sql = `DROP USER IF EXISTS '${PREVIOUS_USER}'@'%'; FLUSH PRIVILEGES;`
+	default:
+		env = append(env, replicationCredentialEnv(config.replicationSecret)...)
+		sql = `CREATE USER IF NOT EXISTS '${REPLICATION_USER}'@'%' IDENTIFIED BY '${REPLICATION_PASSWORD}'; ` +
+			`ALTER USER '${REPLICATION_USER}'@'%' IDENTIFIED BY '${REPLICATION_PASSWORD}'; ` +
+			`GRANT REPLICATION SLAVE ON *.* TO '${REPLICATION_USER}'@'%'; FLUSH PRIVILEGES;`
+	}
+	script := fmt.Sprintf(`set -e
+until mysql -h "$MASTER_HOST" -uroot -p"$MYSQL_ROOT_PASSWORD" -e "SELECT 1" > /dev/null 2>&1; do
+  sleep 2
+done
+mysql -h "$MASTER_HOST" -uroot -p"$MYSQL_ROOT_PASSWORD" -e "%s"`, sql)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ReplicationCredentialsJobName(ms, step),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					Containers: []corev1.Container{
+						{
+							Name:            "credentials-" + step,
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Command:         []string{"/bin/sh", "-c", script},
+							Env:             env,
+						},
+					},
+				},
+			},
+		},
+	}
+	b.applyImageOverrides(&job.Spec.Template.Spec)
+	return job
+}
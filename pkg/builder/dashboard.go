@@ -0,0 +1,179 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// DashboardConfigMapName trả về tên ConfigMap chứa dashboard Grafana của MusicService
+func DashboardConfigMapName(ms *musicv1.MusicService) string {
+	return ms.Name + "-dashboard"
+}
+
+// grafanaDashboard và grafanaPanel chỉ mô tả phần JSON dashboard mà operator cần sinh ra
+type grafanaDashboard struct {
+	UID           string            `json:"uid"`
+	Title         string            `json:"title"`
+	Tags          []string          `json:"tags"`
+	SchemaVersion int               `json:"schemaVersion"`
+	Refresh       string            `json:"refresh"`
+	Time          map[string]string `json:"time"`
+	Templating    map[string]any    `json:"templating"`
+	Panels        []grafanaPanel    `json:"panels"`
+}
+
+type grafanaPanel struct {
+	ID          int               `json:"id"`
+	Type        string            `json:"type"`
+	Title       string            `json:"title"`
+	Datasource  map[string]string `json:"datasource"`
+	GridPos     map[string]int    `json:"gridPos"`
+	FieldConfig map[string]any    `json:"fieldConfig"`
+	Targets     []grafanaTarget   `json:"targets"`
+}
+
+type grafanaTarget struct {
+	RefID        string `json:"refId"`
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+}
+
+// BuildDashboardConfigMap xây dựng ConfigMap chứa dashboard Grafana cho MusicService,
+// gắn nhãn labelKey=labelValue để sidecar của Grafana tự nạp.
+// Dashboard dùng metric của kube-state-metrics, kubelet, mysqld_exporter và metric
+// music_streaming_active_connections do ứng dụng xuất ra
+func (b *ResourceBuilder) BuildDashboardConfigMap(ms *musicv1.MusicService, labelKey, labelValue string) (*corev1.ConfigMap, error) {
+	labels := b.getLabels(ms, "dashboard")
+	labels[labelKey] = labelValue
+
+	dashboard := buildGrafanaDashboard(ms)
+	data, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            DashboardConfigMapName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Data: map[string]string{
+			DashboardConfigMapName(ms) + ".json": string(data),
+		},
+	}, nil
+}
+
+func buildGrafanaDashboard(ms *musicv1.MusicService) *grafanaDashboard {
+	namespace := WorkloadNamespace(ms)
+	selector := func(extra string) string {
+		return fmt.Sprintf(`namespace="%s",%s`, namespace, extra)
+	}
+
+	replicaTargets := []grafanaTarget{
+		{
+			Expr:         fmt.Sprintf("kube_statefulset_status_replicas_ready{%s}", selector(fmt.Sprintf(`statefulset="%s"`, ms.Name))),
+			LegendFormat: "app ready",
+		},
+		{
+			Expr:         fmt.Sprintf("kube_statefulset_replicas{%s}", selector(fmt.Sprintf(`statefulset="%s"`, ms.Name))),
+			LegendFormat: "app desired",
+		},
+	}
+	if ms.Spec.ReadPool != nil && ms.Spec.ReadPool.Enabled {
+		replicaTargets = append(replicaTargets, grafanaTarget{
+			Expr:         fmt.Sprintf("kube_deployment_status_replicas_available{%s}", selector(fmt.Sprintf(`deployment="%s"`, ReadPoolName(ms)))),
+			LegendFormat: "read pool available",
+		})
+	}
+
+	panels := []grafanaPanel{
+		newGrafanaPanel("Replicas", "short", replicaTargets),
+		newGrafanaPanel("Streaming connections", "short", []grafanaTarget{
+			{
+				Expr:         fmt.Sprintf("sum(music_streaming_active_connections{%s})", selector(fmt.Sprintf(`pod=~"%s-[0-9]+|%s-.*"`, ms.Name, ReadPoolName(ms)))),
+				LegendFormat: "active",
+			},
+			{
+				Expr:         fmt.Sprintf("%d * sum(kube_statefulset_replicas{%s})", ms.Spec.Streaming.MaxConnections, selector(fmt.Sprintf(`statefulset="%s"`, ms.Name))),
+				LegendFormat: "capacity",
+			},
+		}),
+		newGrafanaPanel("Storage usage", "percentunit", []grafanaTarget{
+			{
+				Expr: fmt.Sprintf("kubelet_volume_stats_used_bytes{%[1]s} / kubelet_volume_stats_capacity_bytes{%[1]s}",
+					selector(fmt.Sprintf(`persistentvolumeclaim=~"(music-data|db-data)-%s-.*"`, ms.Name))),
+				LegendFormat: "{{persistentvolumeclaim}}",
+			},
+		}),
+	}
+
+	if ms.Spec.Database != nil && ms.Spec.Database.Enabled {
+		lagTarget := grafanaTarget{
+			Expr:         fmt.Sprintf("mysql_slave_status_seconds_behind_master{%s}", selector(fmt.Sprintf(`pod=~"%s-db-replica-.*"`, ms.Name))),
+			LegendFormat: "{{pod}}",
+		}
+		unit := "s"
+		if ha := ms.Spec.Database.HighAvailability; ha != nil && ha.Enabled {
+			// Galera đồng bộ nên không có độ trễ theo giây, dùng tỉ lệ thời gian bị flow control tạm dừng
+			lagTarget.Expr = fmt.Sprintf("rate(mysql_global_status_wsrep_flow_control_paused_ns{%s}[5m]) / 1e9", selector(fmt.Sprintf(`pod=~"%s-db-galera-.*"`, ms.Name)))
+			unit = "percentunit"
+		}
+		panels = append(panels, newGrafanaPanel("DB replication lag", unit, []grafanaTarget{lagTarget}))
+	}
+
+	for i := range panels {
+		panels[i].ID = i + 1
+		panels[i].GridPos = map[string]int{"h": 8, "w": 12, "x": (i % 2) * 12, "y": (i / 2) * 8}
+	}
+
+	return &grafanaDashboard{
+		UID:           fmt.Sprintf("music-%s-%s", namespace, ms.Name),
+		Title:         fmt.Sprintf("MusicService %s/%s", namespace, ms.Name),
+		Tags:          []string{"music-service"},
+		SchemaVersion: 39,
+		Refresh:       "30s",
+		Time:          map[string]string{"from": "now-6h", "to": "now"},
+		Templating: map[string]any{
+			"list": []map[string]any{
+				{"name": "datasource", "type": "datasource", "query": "prometheus"},
+			},
+		},
+		Panels: panels,
+	}
+}
+
+func newGrafanaPanel(title, unit string, targets []grafanaTarget) grafanaPanel {
+	for i := range targets {
+		targets[i].RefID = string(rune('A' + i))
+	}
+	return grafanaPanel{
+		Type:        "timeseries",
+		Title:       title,
+		Datasource:  map[string]string{"type": "prometheus", "uid": "${datasource}"},
+		FieldConfig: map[string]any{"defaults": map[string]any{"unit": unit}},
+		Targets:     targets,
+	}
+}
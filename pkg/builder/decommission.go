@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// DatabaseReplicaName trả về tên StatefulSet replica của cơ sở dữ liệu
+func DatabaseReplicaName(ms *musicv1.MusicService) string {
+	return ms.Name + "-db-replica"
+}
+
+// ReplicaDecommissionJobName trả về tên Job gỡ replica theo ordinal
+func ReplicaDecommissionJobName(ms *musicv1.MusicService, ordinal int32) string {
+	return fmt.Sprintf("%s-%d-decommission", DatabaseReplicaName(ms), ordinal)
+}
+
+// DatabaseReplicaPVCName trả về tên PVC db-data của replica theo ordinal
+func DatabaseReplicaPVCName(ms *musicv1.MusicService, ordinal int32) string {
+	return fmt.Sprintf("db-data-%s-%d", DatabaseReplicaName(ms), ordinal)
+}
+
+// BuildReplicaDecommissionJob xây dựng Job gỡ replica trước khi giảm replicas:
+// chờ replica áp dụng hết relay log rồi dừng replication và bật read_only.
+// Khi replication dừng, readiness probe theo độ trễ thất bại nên replica rời Service db-read
+func (b *ResourceBuilder) BuildReplicaDecommissionJob(ms *musicv1.MusicService, ordinal int32, podIP string) *batchv1.Job {
+	labels := b.getLabels(ms, "db-decommission")
+	config := b.buildDatabaseConfig(ms)
+	backoffLimit := int32(2)
+
+	script := `set -e
+MYSQL="mysql -h $REPLICA_HOST -uroot -p$MYSQL_ROOT_PASSWORD"
+i=0
+while [ "$i" -lt 60 ]; do
+  status=$($MYSQL -e "SHOW SLAVE STATUS\G")
+  [ -z "$status" ] && break
+  io=$(echo "$status" | awk '/Slave_IO_Running:/ {print $2}')
+  lag=$(echo "$status" | awk '/Seconds_Behind_Master:/ {print $2}')
+  [ "$io" != "Yes" ] || [ "$lag" = "0" ] && break
+  i=$((i + 1))
+  sleep 5
+done
+$MYSQL -e "STOP SLAVE; RESET SLAVE ALL; SET GLOBAL read_only = ON;"
+echo "replica $REPLICA_HOST decommissioned"`
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ReplicaDecommissionJobName(ms, ordinal),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					Containers: []corev1.Container{
+						{
+							Name:            "decommission",
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Command:         []string{"/bin/sh", "-c", script},
+							Env: []corev1.EnvVar{
+								{Name: "REPLICA_HOST", Value: podIP},
+								config.rootPasswordEnv(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	b.applyImageOverrides(&job.Spec.Template.Spec)
+	return job
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// AppUsesDeployment cho biết ứng dụng chạy bằng Deployment thay vì StatefulSet
+func AppUsesDeployment(ms *musicv1.MusicService) bool {
+	return ms.Spec.WorkloadType == musicv1.WorkloadTypeDeployment
+}
+
+// AppWorkloadKind trả về kind của workload ứng dụng, dùng cho scaleTargetRef của HPA
+func AppWorkloadKind(ms *musicv1.MusicService) string {
+	if AppUsesDeployment(ms) {
+		return "Deployment"
+	}
+	return "StatefulSet"
+}
+
+// BuildAppDeployment xây dựng Deployment cho ứng dụng khi spec.workloadType là Deployment.
+// Pod template giống StatefulSet, nhưng music-data là emptyDir vì không có volumeClaimTemplates
+// (trừ khi spec.storage.accessMode là ReadWriteMany thì mọi pod mount PVC dùng chung),
+// và bỏ readiness gate rút kết nối vì luồng rút kết nối dựa vào ordinal của StatefulSet
+func (b *ResourceBuilder) BuildAppDeployment(ms *musicv1.MusicService) *appsv1.Deployment {
+	sts := b.BuildAppStatefulSet(ms)
+	template := *sts.Spec.Template.DeepCopy()
+	template.Spec.ReadinessGates = nil
+	if len(sts.Spec.VolumeClaimTemplates) > 0 {
+		template.Spec.Volumes = append([]corev1.Volume{musicDataEmptyDir()}, template.Spec.Volumes...)
+	}
+
+	// Tạo pod mới trước khi xóa pod cũ để rollout không làm giảm số pod phục vụ
+	maxSurge := intstr.FromString("25%")
+	maxUnavailable := intstr.FromInt32(0)
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, "app"),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas:        &ms.Spec.Replicas,
+			MinReadySeconds: ms.Spec.MinReadySeconds,
+			Selector:        sts.Spec.Selector,
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+			Template: template,
+		},
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// ServingConditionType là readiness gate của pod ứng dụng; operator đặt False để rút pod khỏi Service
+	ServingConditionType corev1.PodConditionType = "music.mixcorp.org/serving"
+	// DrainStartedAnnotation ghi thời điểm operator bắt đầu rút kết nối khỏi pod
+	DrainStartedAnnotation = "music.mixcorp.org/drain-started"
+	// ActiveConnectionsMetric là metric số kết nối đang phát do ứng dụng xuất ra ở /metrics
+	ActiveConnectionsMetric = "music_streaming_active_connections"
+
+	defaultDrainTimeoutSeconds = int32(300)
+)
+
+// DrainEnabled cho biết có rút kết nối trước khi giảm replica ứng dụng không
+func DrainEnabled(ms *musicv1.MusicService) bool {
+	return ms.Spec.Streaming.Drain != nil
+}
+
+// DrainTimeout trả về thời gian tối đa chờ kết nối kết thúc
+func DrainTimeout(ms *musicv1.MusicService) time.Duration {
+	return time.Duration(drainTimeoutSeconds(ms)) * time.Second
+}
+
+func drainTimeoutSeconds(ms *musicv1.MusicService) int32 {
+	if ms.Spec.Streaming.Drain == nil || ms.Spec.Streaming.Drain.TimeoutSeconds <= 0 {
+		return defaultDrainTimeoutSeconds
+	}
+	return ms.Spec.Streaming.Drain.TimeoutSeconds
+}
+
+// drainPreStopScript chờ /metrics của chính pod báo hết kết nối đang phát hoặc hết thời gian rút kết nối.
+// Hook này phủ các lần xóa pod không qua operator như HPA giảm replica: pod đang kết thúc đã bị gỡ khỏi Service
+// nên chỉ còn các kết nối cũ. Image cần wget (ví dụ busybox), không có thì pod kết thúc ngay
+const drainPreStopScript = `command -v wget > /dev/null || exit 0
+end=$(( $(date +%%s) + %d ))
+while [ "$(date +%%s)" -lt "$end" ]; do
+  active=$(wget -qO- "http://127.0.0.1:%d/metrics" 2>/dev/null | awk '/^%s[{ ]/ { sub(/^[^ {]*(\{[^}]*\})?/, ""); total += $1 } END { print total + 0 }')
+  [ "$active" = 0 ] && exit 0
+  sleep 2
+done`
+
+// applyDrainPodSpec thêm readiness gate để operator rút pod khỏi Service, kéo dài thời gian kết thúc pod
+// bằng thời gian rút kết nối và thêm preStop hook chờ hết kết nối cho các lần xóa pod không qua operator
+func applyDrainPodSpec(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if !DrainEnabled(ms) {
+		return
+	}
+	grace := int64(drainTimeoutSeconds(ms))
+	spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: ServingConditionType}}
+	spec.TerminationGracePeriodSeconds = &grace
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != "music-service" {
+			continue
+		}
+		spec.Containers[i].Lifecycle = &corev1.Lifecycle{
+			PreStop: &corev1.LifecycleHandler{
+				Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c",
+					fmt.Sprintf(drainPreStopScript, grace, AppContainerPort(ms), ActiveConnectionsMetric)}},
+			},
+		}
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import "strconv"
+
+// SafeToEvictAnnotation cho cluster-autoscaler biết có được evict pod khi thu hồi node hay không
+const SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+
+// safeToEvictAnnotations trả về annotation safe-to-evict cho pod template; nil khi không cấu hình
+// để cluster-autoscaler dùng quy tắc mặc định
+func safeToEvictAnnotations(safeToEvict *bool) map[string]string {
+	if safeToEvict == nil {
+		return nil
+	}
+	return map[string]string{SafeToEvictAnnotation: strconv.FormatBool(*safeToEvict)}
+}
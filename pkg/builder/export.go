@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// BuildManifests dựng mọi tài nguyên con mà các reconciler sẽ tạo cho ms ở trạng thái ổn định, theo thứ tự reconcile.
+// Không gồm các tài nguyên phụ thuộc trạng thái cluster: Secret chứa mật khẩu sinh ngẫu nhiên hoặc chép từ Secret khác,
+// Job một lần (seed, khôi phục, di chuyển dữ liệu), dashboard Grafana (bật bằng cờ của operator) và VolumeSnapshot
+func (b *ResourceBuilder) BuildManifests(ms *musicv1.MusicService) ([]client.Object, error) {
+	var objects []client.Object
+
+	if DedicatedTenancy(ms) {
+		objects = append(objects, b.BuildTenantNamespace(ms))
+		if len(ms.Spec.Tenancy.Quota) > 0 {
+			objects = append(objects, b.BuildTenantResourceQuota(ms))
+		}
+		for _, policy := range b.BuildTenantNetworkPolicies(ms) {
+			objects = append(objects, policy)
+		}
+	}
+
+	objects = append(objects, b.BuildAppService(ms))
+	if AppSharedStorage(ms) {
+		objects = append(objects, b.BuildAppSharedPVC(ms))
+	}
+	if QueueManaged(ms) {
+		objects = append(objects, b.BuildQueueService(ms), b.BuildQueueStatefulSet(ms))
+	}
+	if ms.Spec.Search != nil {
+		objects = append(objects, b.BuildSearchService(ms), b.BuildSearchStatefulSet(ms))
+	}
+	if ms.Spec.SessionStore != nil {
+		objects = append(objects, b.BuildSessionStoreService(ms), b.BuildSessionStoreStatefulSet(ms))
+	}
+	if ms.Spec.ObjectStorage != nil {
+		objects = append(objects, b.BuildObjectStorageService(ms), b.BuildObjectStorageStatefulSet(ms))
+	}
+
+	switch {
+	case ShardsEnabled(ms):
+		for i, shard := range ms.Spec.Shards {
+			objects = append(objects, b.BuildAppShardService(ms, i), b.BuildAppShardStatefulSet(ms, i))
+			if AutoscalingEnabled(shard.Autoscaling) {
+				objects = append(objects, b.BuildAppShardAutoscaler(ms, i))
+			}
+		}
+	case AppUsesDeployment(ms):
+		objects = append(objects, b.BuildAppDeployment(ms))
+	default:
+		objects = append(objects, b.BuildAppStatefulSet(ms))
+	}
+	if BuildPartitioningStatus(ms) != nil {
+		configMap, err := b.BuildPartitionsConfigMap(ms)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, configMap)
+	}
+	if AutoscalingEnabled(ms.Spec.Autoscaling) {
+		objects = append(objects, b.BuildAutoscaler(ms))
+	}
+
+	if pool := ms.Spec.ReadPool; pool != nil && pool.Enabled {
+		objects = append(objects, b.BuildReadPoolDeployment(ms), b.BuildReadPoolService(ms))
+		if AutoscalingEnabled(pool.Autoscaling) {
+			objects = append(objects, b.BuildReadPoolAutoscaler(ms))
+		}
+	}
+	if ms.Spec.Ingress != nil {
+		objects = append(objects, b.BuildIngress(ms))
+	}
+	if MonitoringEnabled(ms) {
+		objects = append(objects, b.BuildPodMonitor(ms))
+	}
+
+	if db := ms.Spec.Database; db != nil && db.Enabled {
+		if db.HighAvailability != nil && db.HighAvailability.Enabled {
+			objects = append(objects, b.BuildDatabaseGaleraStatefulSet(ms), b.BuildDatabaseGaleraService(ms),
+				b.BuildDatabaseGaleraPrimaryService(ms), b.BuildDatabaseGaleraReadService(ms))
+		} else {
+			objects = append(objects, b.BuildDatabaseMasterStatefulSet(ms), b.BuildDatabaseMasterService(ms))
+			if db.Replicas > 0 {
+				objects = append(objects, b.BuildDatabaseReplicaStatefulSet(ms))
+			}
+			if db.Replicas > 0 || db.ReadFallbackToMaster {
+				objects = append(objects, b.BuildDatabaseReadService(ms))
+			}
+			if db.Replicas > 0 && AutoscalingEnabled(db.Autoscaling) {
+				objects = append(objects, b.BuildDatabaseReplicaAutoscaler(ms))
+			}
+		}
+		if db.Backup != nil && db.Backup.Enabled && db.Backup.Destination.PVC != nil {
+			objects = append(objects, b.BuildDatabaseBackupPVC(ms), b.BuildDatabaseBackupCronJob(ms))
+		}
+	}
+
+	return objects, nil
+}
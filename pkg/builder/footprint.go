@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// ComputeFootprint tính tổng tài nguyên tối đa mà MusicService có thể chiếm dụng.
+// Số pod được tính theo MaxReplicas của HPA nếu có, để budget phản ánh trường hợp xấu nhất.
+func (b *ResourceBuilder) ComputeFootprint(ms *musicv1.MusicService) musicv1.ResourceFootprint {
+	footprint := musicv1.ResourceFootprint{}
+
+	appReplicas := ms.Spec.Replicas
+	if AutoscalingEnabled(ms.Spec.Autoscaling) && ms.Spec.Autoscaling.MaxReplicas > appReplicas {
+		appReplicas = ms.Spec.Autoscaling.MaxReplicas
+	}
+	if AppUsesDeployment(ms) {
+		deployment := b.BuildAppDeployment(ms)
+		addPodFootprint(&footprint, &deployment.Spec.Template.Spec, appReplicas)
+	} else {
+		addStatefulSetFootprint(&footprint, b.BuildAppStatefulSet(ms), appReplicas)
+	}
+	// PVC dùng chung chỉ tính một lần bất kể số replica
+	if AppSharedStorage(ms) {
+		pvc := b.BuildAppSharedPVC(ms)
+		footprint.Storage.Add(pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	}
+
+	if pool := ms.Spec.ReadPool; pool != nil && pool.Enabled {
+		poolReplicas := ReadPoolReplicas(ms)
+		if AutoscalingEnabled(pool.Autoscaling) && pool.Autoscaling.MaxReplicas > poolReplicas {
+			poolReplicas = pool.Autoscaling.MaxReplicas
+		}
+		deployment := b.BuildReadPoolDeployment(ms)
+		addPodFootprint(&footprint, &deployment.Spec.Template.Spec, poolReplicas)
+	}
+
+	// Broker, search engine, Redis lưu phiên và MinIO luôn chạy đúng một pod
+	if QueueManaged(ms) {
+		addStatefulSetFootprint(&footprint, b.BuildQueueStatefulSet(ms), 1)
+	}
+	if ms.Spec.Search != nil {
+		addStatefulSetFootprint(&footprint, b.BuildSearchStatefulSet(ms), 1)
+	}
+	if ms.Spec.SessionStore != nil {
+		addStatefulSetFootprint(&footprint, b.BuildSessionStoreStatefulSet(ms), 1)
+	}
+	if ms.Spec.ObjectStorage != nil {
+		addStatefulSetFootprint(&footprint, b.BuildObjectStorageStatefulSet(ms), 1)
+	}
+
+	db := ms.Spec.Database
+	if db == nil || !db.Enabled {
+		return footprint
+	}
+
+	if db.HighAvailability != nil && db.HighAvailability.Enabled {
+		galera := b.BuildDatabaseGaleraStatefulSet(ms)
+		addStatefulSetFootprint(&footprint, galera, *galera.Spec.Replicas)
+	} else {
+		addStatefulSetFootprint(&footprint, b.BuildDatabaseMasterStatefulSet(ms), 1)
+
+		if db.Replicas > 0 {
+			replicas := db.Replicas
+			if _, maxReplicas := DatabaseReplicaBounds(ms); AutoscalingEnabled(db.Autoscaling) && maxReplicas > replicas {
+				replicas = maxReplicas
+			}
+			addStatefulSetFootprint(&footprint, b.BuildDatabaseReplicaStatefulSet(ms), replicas)
+		}
+	}
+
+	if db.Backup != nil && db.Backup.Enabled && db.Backup.Destination.PVC != nil {
+		pvc := b.BuildDatabaseBackupPVC(ms)
+		footprint.Storage.Add(pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	}
+
+	return footprint
+}
+
+// addStatefulSetFootprint cộng tài nguyên của replicas pod và PVC tương ứng vào footprint
+func addStatefulSetFootprint(footprint *musicv1.ResourceFootprint, sts *appsv1.StatefulSet, replicas int32) {
+	addPodFootprint(footprint, &sts.Spec.Template.Spec, replicas)
+	for i := int32(0); i < replicas; i++ {
+		for _, claim := range sts.Spec.VolumeClaimTemplates {
+			footprint.Storage.Add(claim.Spec.Resources.Requests[corev1.ResourceStorage])
+		}
+	}
+}
+
+// addPodFootprint cộng CPU/memory request của replicas pod vào footprint
+func addPodFootprint(footprint *musicv1.ResourceFootprint, spec *corev1.PodSpec, replicas int32) {
+	requests := podRequests(spec)
+	for i := int32(0); i < replicas; i++ {
+		footprint.CPU.Add(requests[corev1.ResourceCPU])
+		footprint.Memory.Add(requests[corev1.ResourceMemory])
+	}
+}
+
+// podRequests trả về request hiệu dụng của pod giống cách scheduler tính:
+// tổng các container chính, nhưng không nhỏ hơn init container lớn nhất.
+// Container chỉ khai báo limits được coi như request bằng limits.
+func podRequests(spec *corev1.PodSpec) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, c := range spec.Containers {
+		for name, qty := range containerRequests(c) {
+			sum := total[name]
+			sum.Add(qty)
+			total[name] = sum
+		}
+	}
+
+	for _, c := range spec.InitContainers {
+		for name, qty := range containerRequests(c) {
+			if current, ok := total[name]; !ok || qty.Cmp(current) > 0 {
+				total[name] = qty.DeepCopy()
+			}
+		}
+	}
+
+	return total
+}
+
+func containerRequests(c corev1.Container) map[corev1.ResourceName]resource.Quantity {
+	requests := map[corev1.ResourceName]resource.Quantity{}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if qty, ok := c.Resources.Requests[name]; ok {
+			requests[name] = qty
+		} else if qty, ok := c.Resources.Limits[name]; ok {
+			requests[name] = qty
+		}
+	}
+	return requests
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// GaleraExporterContainerName là sidecar mysqld_exporter trên mỗi node Galera; operator đọc
+	// GaleraClusterSizeMetric từ cổng GaleraExporterPort qua pod proxy để báo galeraClusterSize
+	GaleraExporterContainerName = "galera-exporter"
+	GaleraExporterPort          = int32(9104)
+	// GaleraClusterSizeMetric là wsrep_cluster_size của node: số node trong component mà node đang thuộc,
+	// gồm cả node đang nhận SST/IST chưa Synced
+	GaleraClusterSizeMetric = "mysql_global_status_wsrep_cluster_size"
+	galeraExporterImage     = "prom/mysqld-exporter:v0.15.1"
+)
+
+// buildGaleraExporterContainer dựng sidecar mysqld_exporter kết nối vào server Galera trong cùng pod
+// bằng mật khẩu superuser của container server (cùng nguồn với rootPasswordEnv)
+func buildGaleraExporterContainer(config databaseConfig) corev1.Container {
+	password := config.rootPasswordEnv()
+	password.Name = "MYSQLD_EXPORTER_PASSWORD"
+
+	return corev1.Container{
+		Name:  GaleraExporterContainerName,
+		Image: galeraExporterImage,
+		Args:  []string{"--mysqld.address=127.0.0.1:3306", "--mysqld.username=root"},
+		Env:   []corev1.EnvVar{password},
+		Ports: []corev1.ContainerPort{
+			{Name: "exporter", ContainerPort: GaleraExporterPort, Protocol: corev1.ProtocolTCP},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// defaultGPUResourceName là extended resource của NVIDIA device plugin
+const defaultGPUResourceName = corev1.ResourceName("nvidia.com/gpu")
+
+// applyGPU thêm extended resource GPU vào container music-service cùng nodeSelector và tolerations của spec.gpu.
+// Extended resource được ghi vào cả requests lẫn limits vì API server bắt buộc hai giá trị bằng nhau
+func applyGPU(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	gpu := ms.Spec.GPU
+	if gpu == nil {
+		return
+	}
+
+	name := gpu.ResourceName
+	if name == "" {
+		name = defaultGPUResourceName
+	}
+	count := gpu.Count
+	if count <= 0 {
+		count = 1
+	}
+	// Parse từ chuỗi để quantity khớp với giá trị API server trả về khi so sánh pod template
+	quantity := resource.MustParse(strconv.Itoa(int(count)))
+
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != "music-service" {
+			continue
+		}
+		// resources có thể dùng chung map với spec của MusicService nên phải sao chép trước khi sửa
+		resources := spec.Containers[i].Resources.DeepCopy()
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Requests[name] = quantity.DeepCopy()
+		resources.Limits[name] = quantity.DeepCopy()
+		spec.Containers[i].Resources = *resources
+	}
+
+	if len(gpu.NodeSelector) > 0 {
+		spec.NodeSelector = maps.Clone(gpu.NodeSelector)
+	}
+	if len(gpu.Tolerations) > 0 {
+		spec.Tolerations = slices.Clone(gpu.Tolerations)
+	}
+}
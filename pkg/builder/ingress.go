@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	ingressAffinityAnnotation      = "nginx.ingress.kubernetes.io/affinity"
+	ingressAffinityModeAnnotation  = "nginx.ingress.kubernetes.io/affinity-mode"
+	ingressCookieNameAnnotation    = "nginx.ingress.kubernetes.io/session-cookie-name"
+	ingressCookieMaxAgeAnnotation  = "nginx.ingress.kubernetes.io/session-cookie-max-age"
+	ingressCookieExpiresAnnotation = "nginx.ingress.kubernetes.io/session-cookie-expires"
+	defaultStickySessionCookieName = "music-session"
+)
+
+// BuildIngress xây dựng Ingress trỏ host về port http của Service ứng dụng
+func (b *ResourceBuilder) BuildIngress(ms *musicv1.MusicService) *networkingv1.Ingress {
+	labels := b.getLabels(ms, "ingress")
+	spec := ms.Spec.Ingress
+	pathType := networkingv1.PathTypePrefix
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			Annotations:     getAnnotations(ms, buildStickySessionAnnotations(spec.StickySessions)),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: spec.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     "/",
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: ms.Name,
+											Port: networkingv1.ServiceBackendPort{Name: "http"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if spec.ClassName != "" {
+		className := spec.ClassName
+		ingress.Spec.IngressClassName = &className
+	}
+	// Ứng dụng tự kết thúc TLS: Ingress chỉ chuyển luồng TLS theo SNI tới port https, không cần Secret ở edge
+	if TLSPassthroughEnabled(ms) {
+		ingress.Annotations = getAnnotations(ms, buildTLSPassthroughAnnotations())
+		ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port = networkingv1.ServiceBackendPort{Name: "https"}
+		return ingress
+	}
+	if spec.TLSSecretName != "" {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{Hosts: []string{spec.Host}, SecretName: spec.TLSSecretName},
+		}
+	}
+
+	return ingress
+}
+
+// IngressOwnedAnnotations trả về các annotation ingress-nginx mà BuildIngress có thể đặt tùy theo spec;
+// reconciler xóa các key này khi desired không còn chúng, còn annotation của công cụ khác được giữ nguyên
+func IngressOwnedAnnotations() []string {
+	return []string{
+		ingressAffinityAnnotation,
+		ingressAffinityModeAnnotation,
+		ingressCookieNameAnnotation,
+		ingressCookieMaxAgeAnnotation,
+		ingressCookieExpiresAnnotation,
+		ingressSSLPassthroughAnnotation,
+		ingressBackendProtocolAnnotation,
+	}
+}
+
+// buildStickySessionAnnotations dựng annotation cookie affinity của ingress-nginx.
+// Chế độ persistent giữ phiên trên pod cũ cả khi HPA thêm pod mới
+func buildStickySessionAnnotations(sticky *musicv1.StickySessionSpec) map[string]string {
+	if sticky == nil || !sticky.Enabled {
+		return nil
+	}
+
+	cookieName := defaultStickySessionCookieName
+	if sticky.CookieName != "" {
+		cookieName = sticky.CookieName
+	}
+
+	annotations := map[string]string{
+		ingressAffinityAnnotation:     "cookie",
+		ingressAffinityModeAnnotation: "persistent",
+		ingressCookieNameAnnotation:   cookieName,
+	}
+	if sticky.MaxAgeSeconds != nil {
+		maxAge := fmt.Sprintf("%d", *sticky.MaxAgeSeconds)
+		annotations[ingressCookieMaxAgeAnnotation] = maxAge
+		annotations[ingressCookieExpiresAnnotation] = maxAge
+	}
+
+	return annotations
+}
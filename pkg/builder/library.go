@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const defaultBucketCapacity = "1Ti"
+
+// LibraryClaimName trả về tên PVC của MusicLibrary
+func LibraryClaimName(libraryName string) string {
+	return libraryName + "-library"
+}
+
+// LibraryPersistentVolumeName trả về tên PersistentVolume tĩnh của thư viện dạng bucket;
+// PV là tài nguyên cluster-scoped nên tên gồm cả namespace
+func LibraryPersistentVolumeName(lib *musicv1.MusicLibrary) string {
+	return fmt.Sprintf("musiclibrary-%s-%s", lib.Namespace, lib.Name)
+}
+
+// BuildLibraryPVC xây dựng PVC của MusicLibrary; với bucket, PVC được bind tĩnh vào PV của CSI driver
+func (b *ResourceBuilder) BuildLibraryPVC(lib *musicv1.MusicLibrary) *corev1.PersistentVolumeClaim {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LibraryClaimName(lib.Name),
+			Namespace: lib.Namespace,
+			Labels:    libraryLabels(lib),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(lib, musicv1.GroupVersion.WithKind("MusicLibrary")),
+			},
+		},
+	}
+
+	if source := lib.Spec.PVC; source != nil {
+		accessMode := source.AccessMode
+		if accessMode == "" {
+			accessMode = corev1.ReadWriteMany
+		}
+		pvc.Spec = corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{accessMode},
+			StorageClassName: source.StorageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: parseQuantity(source.Size),
+				},
+			},
+		}
+		return pvc
+	}
+
+	// StorageClassName rỗng để tránh dynamic provisioning, PVC chỉ bind vào PV tĩnh
+	emptyClass := ""
+	pvc.Spec = corev1.PersistentVolumeClaimSpec{
+		AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+		StorageClassName: &emptyClass,
+		VolumeName:       LibraryPersistentVolumeName(lib),
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: bucketCapacity(lib.Spec.Bucket),
+			},
+		},
+	}
+	return pvc
+}
+
+// BuildLibraryPersistentVolume xây dựng PersistentVolume tĩnh trỏ tới bucket qua CSI driver
+// PV cluster-scoped không thể có owner là MusicLibrary nên được xóa bằng finalizer của MusicLibrary
+func (b *ResourceBuilder) BuildLibraryPersistentVolume(lib *musicv1.MusicLibrary) *corev1.PersistentVolume {
+	bucket := lib.Spec.Bucket
+	emptyClass := ""
+
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   LibraryPersistentVolumeName(lib),
+			Labels: libraryLabels(lib),
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: bucketCapacity(bucket),
+			},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              emptyClass,
+			ClaimRef: &corev1.ObjectReference{
+				Namespace: lib.Namespace,
+				Name:      LibraryClaimName(lib.Name),
+			},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{
+					Driver:           bucket.Driver,
+					VolumeHandle:     bucket.Name,
+					VolumeAttributes: bucket.VolumeAttributes,
+				},
+			},
+		},
+	}
+}
+
+// LibraryMountPath trả về đường dẫn mount hiệu lực của thư viện trong container ứng dụng
+func LibraryMountPath(mount musicv1.LibraryMount) string {
+	if mount.MountPath != "" {
+		return mount.MountPath
+	}
+	return "/library/" + mount.Name
+}
+
+// buildLibraryVolumes trả về volume và volumeMount chỉ-đọc cho các thư viện mà MusicService tham chiếu
+func buildLibraryVolumes(ms *musicv1.MusicService) ([]corev1.Volume, []corev1.VolumeMount) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+
+	for _, lib := range ms.Spec.Libraries {
+		volumeName := "library-" + lib.Name
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: LibraryClaimName(lib.Name),
+					ReadOnly:  true,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: LibraryMountPath(lib),
+			ReadOnly:  true,
+		})
+	}
+
+	return volumes, mounts
+}
+
+func bucketCapacity(bucket *musicv1.LibraryBucketSource) resource.Quantity {
+	if bucket.Capacity != "" {
+		return parseQuantity(bucket.Capacity)
+	}
+	return resource.MustParse(defaultBucketCapacity)
+}
+
+func libraryLabels(lib *musicv1.MusicLibrary) map[string]string {
+	return map[string]string{
+		"app":                          lib.Name,
+		"component":                    "library",
+		"app.kubernetes.io/name":       "music-library",
+		"app.kubernetes.io/instance":   lib.Name,
+		"app.kubernetes.io/managed-by": "music-operator",
+	}
+}
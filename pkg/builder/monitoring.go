@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// PodMonitorGVK là kind PodMonitor của prometheus-operator; dùng unstructured để operator
+// không phụ thuộc vào client của CRD này
+var PodMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+
+const (
+	// defaultMonitoringPath là đường dẫn metrics khi spec.monitoring.path chưa được đặt
+	defaultMonitoringPath = "/metrics"
+	// defaultMonitoringInterval là chu kỳ scrape khi spec.monitoring.interval chưa được đặt
+	defaultMonitoringInterval = "30s"
+)
+
+// MonitoringEnabled cho biết có tạo PodMonitor cho pod ứng dụng hay không
+func MonitoringEnabled(ms *musicv1.MusicService) bool {
+	return ms.Spec.Monitoring != nil && ms.Spec.Monitoring.Enabled
+}
+
+// PodMonitorName trả về tên PodMonitor của MusicService
+func PodMonitorName(ms *musicv1.MusicService) string {
+	return ms.Name
+}
+
+// monitoringPortName trả về tên port container được scrape: port "metrics" riêng khi
+// spec.monitoring.port khác spec.containerPort, ngược lại là port "http"
+func monitoringPortName(ms *musicv1.MusicService) string {
+	if port := ms.Spec.Monitoring.Port; port > 0 && port != AppContainerPort(ms) {
+		return "metrics"
+	}
+	return "http"
+}
+
+// applyMonitoringPort mở port "metrics" trên container music-service khi metrics phục vụ ở cổng riêng
+func applyMonitoringPort(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if !MonitoringEnabled(ms) || monitoringPortName(ms) != "metrics" {
+		return
+	}
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != "music-service" {
+			continue
+		}
+		spec.Containers[i].Ports = append(spec.Containers[i].Ports, corev1.ContainerPort{
+			Name:          "metrics",
+			ContainerPort: ms.Spec.Monitoring.Port,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+}
+
+// BuildPodMonitor xây dựng PodMonitor scrape pod ứng dụng và read pool; relabeling gắn tên và namespace
+// của MusicService vào mọi series để truy vấn theo instance mà không phải suy ra từ tên pod
+func (b *ResourceBuilder) BuildPodMonitor(ms *musicv1.MusicService) *unstructured.Unstructured {
+	monitoring := ms.Spec.Monitoring
+
+	labels := b.getLabels(ms, "monitoring")
+	// Nhãn riêng của PodMonitor để khớp podMonitorSelector của Prometheus, không ghi đè nhãn nội bộ
+	for key, value := range monitoring.Labels {
+		if _, reserved := labels[key]; !reserved {
+			labels[key] = value
+		}
+	}
+
+	path := defaultMonitoringPath
+	if monitoring.Path != "" {
+		path = monitoring.Path
+	}
+	interval := defaultMonitoringInterval
+	if monitoring.Interval != "" {
+		interval = monitoring.Interval
+	}
+
+	podMonitor := &unstructured.Unstructured{}
+	podMonitor.SetGroupVersionKind(PodMonitorGVK)
+	podMonitor.SetName(PodMonitorName(ms))
+	podMonitor.SetNamespace(WorkloadNamespace(ms))
+	podMonitor.SetLabels(labels)
+	podMonitor.SetAnnotations(getAnnotations(ms, nil))
+	podMonitor.SetOwnerReferences(b.OwnerReferences(ms))
+	podMonitor.Object["spec"] = map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{"app": ms.Name},
+			"matchExpressions": []interface{}{
+				map[string]interface{}{
+					"key":      "component",
+					"operator": "In",
+					"values":   []interface{}{"music-service", "read-pool"},
+				},
+			},
+		},
+		"podMetricsEndpoints": []interface{}{
+			map[string]interface{}{
+				"port":     monitoringPortName(ms),
+				"path":     path,
+				"interval": interval,
+				"relabelings": []interface{}{
+					map[string]interface{}{
+						"action":      "replace",
+						"targetLabel": "musicservice",
+						"replacement": ms.Name,
+					},
+					map[string]interface{}{
+						"action":      "replace",
+						"targetLabel": "musicservice_namespace",
+						"replacement": ms.Namespace,
+					},
+					map[string]interface{}{
+						"action":       "replace",
+						"sourceLabels": []interface{}{"__meta_kubernetes_pod_label_component"},
+						"targetLabel":  "component",
+					},
+				},
+			},
+		},
+	}
+	return podMonitor
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	defaultMinIOImage = "minio/minio:RELEASE.2024-06-13T22-53-53Z"
+	minioAPIPort      = int32(9000)
+	minioConsolePort  = int32(9001)
+
+	// Các key trong Secret thông tin đăng nhập MinIO, đặt theo tên biến môi trường của AWS CLI/SDK
+	// để Secret dùng trực tiếp được qua envFrom (ví dụ: spec.seed.credentialsSecret)
+	ObjectStorageAccessKeyKey = "AWS_ACCESS_KEY_ID"
+	ObjectStorageSecretKeyKey = "AWS_SECRET_ACCESS_KEY"
+	ObjectStorageEndpointKey  = "AWS_ENDPOINT_URL"
+)
+
+// minioBucketsScript tạo các bucket trong MINIO_BUCKETS sau khi MinIO nhận kết nối; chạy trong postStart
+const minioBucketsScript = `until mc alias set local http://127.0.0.1:9000 "$MINIO_ROOT_USER" "$MINIO_ROOT_PASSWORD" >/dev/null 2>&1; do sleep 1; done
+for bucket in $MINIO_BUCKETS; do mc mb --ignore-existing "local/$bucket"; done`
+
+// ObjectStorageName trả về tên chung của StatefulSet và Service MinIO
+func ObjectStorageName(ms *musicv1.MusicService) string {
+	return ms.Name + "-minio"
+}
+
+// ObjectStorageSecretName trả về tên Secret chứa access key, secret key và endpoint của MinIO
+func ObjectStorageSecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-minio-credentials"
+}
+
+// ObjectStorageEndpoint trả về endpoint S3 của MinIO trong cluster
+func ObjectStorageEndpoint(ms *musicv1.MusicService) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d", ObjectStorageName(ms), WorkloadNamespace(ms), minioAPIPort)
+}
+
+// BuildObjectStorageSecret xây dựng Secret thông tin đăng nhập MinIO
+// Access key và secret key do reconciler sinh một lần và truyền vào
+func (b *ResourceBuilder) BuildObjectStorageSecret(ms *musicv1.MusicService, accessKey, secretKey string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ObjectStorageSecretName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, "minio-credentials"),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			ObjectStorageAccessKeyKey: []byte(accessKey),
+			ObjectStorageSecretKeyKey: []byte(secretKey),
+			ObjectStorageEndpointKey:  []byte(ObjectStorageEndpoint(ms)),
+		},
+	}
+}
+
+// BuildObjectStorageService xây dựng Service headless cho API S3 của MinIO
+func (b *ResourceBuilder) BuildObjectStorageService(ms *musicv1.MusicService) *corev1.Service {
+	return b.buildSingleNodeService(ms, ObjectStorageName(ms), "minio", "s3", minioAPIPort)
+}
+
+// BuildObjectStorageStatefulSet xây dựng StatefulSet MinIO một node trên PVC minio-data.
+// Tài khoản root lấy từ Secret thông tin đăng nhập; bucket trong spec.objectStorage.buckets được tạo bằng mc
+// trong postStart, danh sách bucket nằm trong env để thay đổi danh sách sẽ khởi động lại pod
+func (b *ResourceBuilder) BuildObjectStorageStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	objectStorage := ms.Spec.ObjectStorage
+
+	image := objectStorage.Image
+	if image == "" {
+		image = defaultMinIOImage
+	}
+	var resources corev1.ResourceRequirements
+	if objectStorage.Resources != nil {
+		resources = *objectStorage.Resources.DeepCopy()
+	}
+	secretName := ObjectStorageSecretName(ms)
+
+	podSpec := corev1.PodSpec{
+		ImagePullSecrets: buildImagePullSecrets(ms),
+		Containers: []corev1.Container{
+			{
+				Name:      "minio",
+				Image:     image,
+				Resources: resources,
+				Args:      []string{"server", "/data", "--console-address", fmt.Sprintf(":%d", minioConsolePort)},
+				Env: []corev1.EnvVar{
+					{
+						Name: "MINIO_ROOT_USER",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+								Key:                  ObjectStorageAccessKeyKey,
+							},
+						},
+					},
+					{
+						Name: "MINIO_ROOT_PASSWORD",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+								Key:                  ObjectStorageSecretKeyKey,
+							},
+						},
+					},
+					{Name: "MINIO_BUCKETS", Value: strings.Join(objectStorage.Buckets, " ")},
+				},
+				Ports: []corev1.ContainerPort{
+					{Name: "s3", ContainerPort: minioAPIPort, Protocol: corev1.ProtocolTCP},
+					{Name: "console", ContainerPort: minioConsolePort, Protocol: corev1.ProtocolTCP},
+				},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path:   "/minio/health/ready",
+							Port:   intstr.FromInt32(minioAPIPort),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+					InitialDelaySeconds: 5,
+					PeriodSeconds:       10,
+					TimeoutSeconds:      5,
+					SuccessThreshold:    1,
+					FailureThreshold:    3,
+				},
+				Lifecycle: &corev1.Lifecycle{
+					PostStart: &corev1.LifecycleHandler{
+						Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", minioBucketsScript}},
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "minio-data", MountPath: "/data"}},
+			},
+		},
+	}
+
+	return b.buildSingleNodeStatefulSet(ms, ObjectStorageName(ms), "minio", podSpec, &objectStorage.Storage)
+}
+
+// buildObjectStorageConnectionEnv trả về biến môi trường OBJECT_STORAGE_* cho container ứng dụng khi có spec.objectStorage
+func buildObjectStorageConnectionEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	if ms.Spec.ObjectStorage == nil {
+		return nil
+	}
+
+	secretName := ObjectStorageSecretName(ms)
+	keys := []struct{ env, key string }{
+		{"OBJECT_STORAGE_ENDPOINT", ObjectStorageEndpointKey},
+		{"OBJECT_STORAGE_ACCESS_KEY", ObjectStorageAccessKeyKey},
+		{"OBJECT_STORAGE_SECRET_KEY", ObjectStorageSecretKeyKey},
+	}
+
+	env := make([]corev1.EnvVar, 0, len(keys))
+	for _, k := range keys {
+		env = append(env, corev1.EnvVar{
+			Name: k.env,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  k.key,
+				},
+			},
+		})
+	}
+	return env
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/database"
+)
+
+// Option tùy biến ResourceBuilder khi khởi tạo bằng NewResourceBuilder
+type Option func(*ResourceBuilder)
+
+// WithScheme đặt scheme cho builder, thay cho scheme mặc định gồm các kiểu client-go và music.mixcorp.org/v1
+func WithScheme(scheme *runtime.Scheme) Option {
+	return func(b *ResourceBuilder) {
+		b.scheme = scheme
+	}
+}
+
+// WithLabels thêm nhãn vào metadata của mọi tài nguyên được dựng.
+// Nhãn trong spec.labels và nhãn do operator quản lý được ưu tiên khi trùng key; selector không bị ảnh hưởng
+func WithLabels(labels map[string]string) Option {
+	return func(b *ResourceBuilder) {
+		if b.extraLabels == nil {
+			b.extraLabels = map[string]string{}
+		}
+		maps.Copy(b.extraLabels, labels)
+	}
+}
+
+// WithImageOverride thay image from bằng to trong mọi container và init container được dựng,
+// ví dụ để trỏ sang registry mirror. from không có tag hoặc digest khớp mọi tag của repository đó
+// và tag gốc được giữ khi to cũng không có tag hoặc digest
+func WithImageOverride(from, to string) Option {
+	return func(b *ResourceBuilder) {
+		if b.imageOverrides == nil {
+			b.imageOverrides = map[string]string{}
+		}
+		b.imageOverrides[from] = to
+	}
+}
+
+// WithDefaultResources tương đương gọi SetDefaultResources sau khi khởi tạo
+func WithDefaultResources(resources corev1.ResourceRequirements) Option {
+	return func(b *ResourceBuilder) {
+		b.SetDefaultResources(resources)
+	}
+}
+
+// WithDatabaseProvider dùng provider cho spec.database.engine bằng engine thay cho provider đăng ký trong
+// pkg/database, ví dụ để đổi image hoặc script của một engine mà không ảnh hưởng builder khác
+func WithDatabaseProvider(engine string, provider database.Provider) Option {
+	return func(b *ResourceBuilder) {
+		if b.databaseProviders == nil {
+			b.databaseProviders = map[string]database.Provider{}
+		}
+		b.databaseProviders[engine] = provider
+	}
+}
+
+// DatabaseProvider trả về provider của spec.database.engine (mặc định mariadb):
+// provider truyền qua WithDatabaseProvider trước, sau đó tới provider đăng ký trong pkg/database
+func (b *ResourceBuilder) DatabaseProvider(ms *musicv1.MusicService) database.Provider {
+	engine := string(musicv1.DatabaseEngineMariaDB)
+	if ms.Spec.Database != nil && ms.Spec.Database.Engine != "" {
+		engine = string(ms.Spec.Database.Engine)
+	}
+	if provider, ok := b.databaseProviders[engine]; ok {
+		return provider
+	}
+	return database.GetProvider(engine)
+}
+
+// DefaultScheme trả về scheme gồm các kiểu client-go và music.mixcorp.org/v1, dùng khi không truyền scheme
+func DefaultScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(musicv1.AddToScheme(scheme))
+	return scheme
+}
+
+// Scheme trả về scheme builder đang dùng
+func (b *ResourceBuilder) Scheme() *runtime.Scheme {
+	return b.scheme
+}
+
+// overrideImage áp dụng WithImageOverride cho một image reference
+func (b *ResourceBuilder) overrideImage(image string) string {
+	if to, ok := b.imageOverrides[image]; ok {
+		return to
+	}
+	repository, suffix := splitImageReference(image)
+	to, ok := b.imageOverrides[repository]
+	if !ok || suffix == "" {
+		return image
+	}
+	if _, toSuffix := splitImageReference(to); toSuffix != "" {
+		return to
+	}
+	return to + suffix
+}
+
+// applyImageOverrides áp dụng WithImageOverride cho mọi container và init container của pod
+func (b *ResourceBuilder) applyImageOverrides(spec *corev1.PodSpec) {
+	if len(b.imageOverrides) == 0 {
+		return
+	}
+	for i := range spec.InitContainers {
+		spec.InitContainers[i].Image = b.overrideImage(spec.InitContainers[i].Image)
+	}
+	for i := range spec.Containers {
+		spec.Containers[i].Image = b.overrideImage(spec.Containers[i].Image)
+	}
+}
+
+// splitImageReference tách image thành repository và phần ":tag" hoặc "@digest" phía sau (có thể rỗng).
+// Dấu ":" của port registry (ví dụ registry:5000/app) không bị coi là tag
+func splitImageReference(image string) (string, string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[:i], image[i:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i:]
+	}
+	return image, ""
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// defaultPartitionHashBuckets khớp với giá trị mặc định của partitioning.hashBuckets trong CRD
+const defaultPartitionHashBuckets = int32(1024)
+
+// PartitionsConfigMapName trả về tên ConfigMap chứa bảng phân chia nội dung cho lớp định tuyến
+func PartitionsConfigMapName(ms *musicv1.MusicService) string {
+	return ms.Name + "-partitions"
+}
+
+// BuildPartitioningStatus render spec.partitioning thành phần nội dung của từng shard, nil khi không bật.
+// Với HashRange, shard thứ i nhận các bucket [i*B/N, (i+1)*B/N-1] nên mọi bucket đều có chủ
+func BuildPartitioningStatus(ms *musicv1.MusicService) *musicv1.PartitioningStatus {
+	partitioning := ms.Spec.Partitioning
+	if partitioning == nil || !ShardsEnabled(ms) {
+		return nil
+	}
+
+	status := &musicv1.PartitioningStatus{Strategy: partitioning.Strategy}
+	shardCount := int64(len(ms.Spec.Shards))
+	buckets := partitioning.HashBuckets
+	if buckets == 0 {
+		buckets = defaultPartitionHashBuckets
+	}
+	defaultShard := partitioning.DefaultShard
+	if defaultShard == "" {
+		defaultShard = ms.Spec.Shards[0].Name
+	}
+	if partitioning.Strategy == musicv1.PartitionStrategyHashRange {
+		status.HashBuckets = buckets
+	}
+
+	for i, shard := range ms.Spec.Shards {
+		assignment := musicv1.ShardPartition{Shard: shard.Name, Service: ShardName(ms, shard.Name)}
+		switch partitioning.Strategy {
+		case musicv1.PartitionStrategyGenre:
+			assignment.Genres = append([]string(nil), shard.Genres...)
+			assignment.Default = shard.Name == defaultShard
+		case musicv1.PartitionStrategyHashRange:
+			assignment.HashRange = &musicv1.HashRange{
+				Start: int32(int64(i) * int64(buckets) / shardCount),
+				End:   int32((int64(i)+1)*int64(buckets)/shardCount) - 1,
+			}
+		}
+		status.Assignments = append(status.Assignments, assignment)
+	}
+	return status
+}
+
+// shardPartitionEnv trả về biến môi trường mô tả phần nội dung của shard thứ index cho container music-service
+func shardPartitionEnv(partitioning *musicv1.PartitioningStatus, index int) []corev1.EnvVar {
+	if partitioning == nil {
+		return nil
+	}
+	assignment := partitioning.Assignments[index]
+	env := []corev1.EnvVar{{Name: "PARTITION_STRATEGY", Value: string(partitioning.Strategy)}}
+	switch partitioning.Strategy {
+	case musicv1.PartitionStrategyGenre:
+		env = append(env,
+			corev1.EnvVar{Name: "PARTITION_GENRES", Value: strings.Join(assignment.Genres, ",")},
+			corev1.EnvVar{Name: "PARTITION_DEFAULT", Value: strconv.FormatBool(assignment.Default)},
+		)
+	case musicv1.PartitionStrategyHashRange:
+		env = append(env,
+			corev1.EnvVar{Name: "PARTITION_HASH_BUCKETS", Value: strconv.Itoa(int(partitioning.HashBuckets))},
+			corev1.EnvVar{Name: "PARTITION_HASH_RANGE", Value: fmt.Sprintf("%d-%d", assignment.HashRange.Start, assignment.HashRange.End)},
+		)
+	}
+	return env
+}
+
+// BuildPartitionsConfigMap xây dựng ConfigMap partitions.json chứa bảng phân chia nội dung,
+// cùng nội dung với status.partitioning, để lớp định tuyến trong cluster đọc mà không cần quyền đọc MusicService
+func (b *ResourceBuilder) BuildPartitionsConfigMap(ms *musicv1.MusicService) (*corev1.ConfigMap, error) {
+	data, err := json.MarshalIndent(BuildPartitioningStatus(ms), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            PartitionsConfigMapName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, "partitions"),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Data: map[string]string{
+			"partitions.json": string(data),
+		},
+	}, nil
+}
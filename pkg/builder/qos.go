@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// guaranteedResources là các tài nguyên phải có requests bằng limits để pod thuộc lớp QoS Guaranteed
+var guaranteedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// applyQoS chuẩn hóa requests bằng limits cho mọi container và init container khi spec.qos là Guaranteed
+func (b *ResourceBuilder) applyQoS(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if ms.Spec.QoS != musicv1.QoSGuaranteed {
+		return
+	}
+	for i := range spec.InitContainers {
+		b.guaranteeResources(&spec.InitContainers[i].Resources)
+	}
+	for i := range spec.Containers {
+		b.guaranteeResources(&spec.Containers[i].Resources)
+	}
+}
+
+// guaranteeResources lấy limits, thiếu thì lấy requests rồi tới requests mặc định của operator,
+// và ghi cùng giá trị vào cả requests lẫn limits; không có giá trị nào thì để nguyên tài nguyên đó
+func (b *ResourceBuilder) guaranteeResources(resources *corev1.ResourceRequirements) {
+	// resources có thể dùng chung map với spec của MusicService nên phải sao chép trước khi sửa
+	normalized := resources.DeepCopy()
+	for _, name := range guaranteedResources {
+		quantity, ok := normalized.Limits[name]
+		if !ok {
+			quantity, ok = normalized.Requests[name]
+		}
+		if !ok {
+			quantity, ok = b.defaultResources.Requests[name]
+		}
+		if !ok {
+			continue
+		}
+		if normalized.Requests == nil {
+			normalized.Requests = corev1.ResourceList{}
+		}
+		if normalized.Limits == nil {
+			normalized.Limits = corev1.ResourceList{}
+		}
+		normalized.Requests[name] = quantity.DeepCopy()
+		normalized.Limits[name] = quantity.DeepCopy()
+	}
+	*resources = *normalized
+}
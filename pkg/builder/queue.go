@@ -0,0 +1,338 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	defaultRabbitMQImage = "rabbitmq:3.13-alpine"
+	defaultKafkaImage    = "apache/kafka:3.7.0"
+	// defaultQueueUser là user mà operator tạo trên broker RabbitMQ do operator triển khai
+	defaultQueueUser = "music"
+
+	// Các key riêng của Secret kết nối broker, username/password dùng chung key với Secret cơ sở dữ liệu
+	QueueEngineKey  = "engine"
+	QueueBrokersKey = "brokers"
+	QueueURLKey     = "url"
+)
+
+// QueueName trả về tên chung của StatefulSet và Service broker do operator triển khai
+func QueueName(ms *musicv1.MusicService) string {
+	return ms.Name + "-queue"
+}
+
+// QueueConnectionSecretName trả về tên Secret chứa thông tin kết nối broker cho ứng dụng
+func QueueConnectionSecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-queue-conn"
+}
+
+// QueueManaged cho biết operator có tự triển khai broker hay không
+func QueueManaged(ms *musicv1.MusicService) bool {
+	return ms.Spec.Queue != nil && ms.Spec.Queue.External == nil
+}
+
+// queuePort trả về port client của broker theo engine
+func queuePort(engine musicv1.QueueEngine) int32 {
+	if engine == musicv1.QueueEngineKafka {
+		return 9092
+	}
+	return 5672
+}
+
+// QueueBrokers trả về danh sách host:port mà ứng dụng dùng để kết nối broker
+func QueueBrokers(ms *musicv1.MusicService) string {
+	queue := ms.Spec.Queue
+	if queue.External != nil {
+		return queue.External.Brokers
+	}
+	return fmt.Sprintf("%s.%s.svc:%d", QueueName(ms), WorkloadNamespace(ms), queuePort(queue.Engine))
+}
+
+// BuildQueueConnectionSecret xây dựng Secret thông tin kết nối broker.
+// Với broker của operator, mật khẩu do reconciler sinh một lần; với broker bên ngoài, username/password
+// được chép từ credentialsSecret (có thể rỗng khi broker không yêu cầu xác thực)
+func (b *ResourceBuilder) BuildQueueConnectionSecret(ms *musicv1.MusicService, username, password string) *corev1.Secret {
+	queue := ms.Spec.Queue
+	brokers := QueueBrokers(ms)
+
+	// Kafka không có URL chuẩn, client nhận trực tiếp danh sách bootstrap server
+	connURL := brokers
+	if queue.Engine == musicv1.QueueEngineRabbitMQ {
+		amqp := url.URL{Scheme: "amqp", Host: strings.TrimSpace(strings.Split(brokers, ",")[0]), Path: "/"}
+		if username != "" {
+			amqp.User = url.UserPassword(username, password)
+		}
+		connURL = amqp.String()
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            QueueConnectionSecretName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, "queue-conn"),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			QueueEngineKey:        []byte(queue.Engine),
+			QueueBrokersKey:       []byte(brokers),
+			QueueURLKey:           []byte(connURL),
+			ConnectionUsernameKey: []byte(username),
+			ConnectionPasswordKey: []byte(password),
+		},
+	}
+}
+
+// QueueUsername trả về user mà reconciler ghi vào Secret kết nối của broker do operator triển khai;
+// Kafka chạy listener PLAINTEXT nên không có user
+func QueueUsername(ms *musicv1.MusicService) string {
+	if ms.Spec.Queue.Engine == musicv1.QueueEngineRabbitMQ {
+		return defaultQueueUser
+	}
+	return ""
+}
+
+// BuildQueueService xây dựng Service headless cho broker do operator triển khai
+func (b *ResourceBuilder) BuildQueueService(ms *musicv1.MusicService) *corev1.Service {
+	return b.buildSingleNodeService(ms, QueueName(ms), "queue", "broker", queuePort(ms.Spec.Queue.Engine))
+}
+
+// BuildQueueStatefulSet xây dựng StatefulSet một node của broker.
+// RabbitMQ tạo user ứng dụng từ Secret kết nối; Kafka chạy chế độ KRaft (broker kiêm controller)
+// nên không cần ZooKeeper, replication factor của topic nội bộ là 1
+func (b *ResourceBuilder) BuildQueueStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	queue := ms.Spec.Queue
+	port := queuePort(queue.Engine)
+
+	var resources corev1.ResourceRequirements
+	if queue.Resources != nil {
+		resources = *queue.Resources.DeepCopy()
+	}
+
+	container := corev1.Container{
+		Name:      "broker",
+		Image:     queue.Image,
+		Resources: resources,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "broker",
+				ContainerPort: port,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+	}
+	podSpec := corev1.PodSpec{ImagePullSecrets: buildImagePullSecrets(ms)}
+
+	switch queue.Engine {
+	case musicv1.QueueEngineKafka:
+		if container.Image == "" {
+			container.Image = defaultKafkaImage
+		}
+		container.Env = []corev1.EnvVar{
+			{Name: "KAFKA_NODE_ID", Value: "1"},
+			{Name: "KAFKA_PROCESS_ROLES", Value: "broker,controller"},
+			{Name: "KAFKA_LISTENERS", Value: fmt.Sprintf("PLAINTEXT://:%d,CONTROLLER://:9093", port)},
+			{Name: "KAFKA_ADVERTISED_LISTENERS", Value: "PLAINTEXT://" + QueueBrokers(ms)},
+			{Name: "KAFKA_LISTENER_SECURITY_PROTOCOL_MAP", Value: "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT"},
+			{Name: "KAFKA_CONTROLLER_LISTENER_NAMES", Value: "CONTROLLER"},
+			{Name: "KAFKA_CONTROLLER_QUORUM_VOTERS", Value: "1@localhost:9093"},
+			{Name: "KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR", Value: "1"},
+			{Name: "KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR", Value: "1"},
+			{Name: "KAFKA_TRANSACTION_STATE_LOG_MIN_ISR", Value: "1"},
+			{Name: "KAFKA_LOG_DIRS", Value: "/var/lib/kafka/data"},
+		}
+		container.VolumeMounts = []corev1.VolumeMount{{Name: "queue-data", MountPath: "/var/lib/kafka/data"}}
+		container.ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(port)},
+			},
+			InitialDelaySeconds: 10,
+			PeriodSeconds:       10,
+			TimeoutSeconds:      1,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		}
+		// Image apache/kafka chạy bằng uid 1000, cần quyền ghi vào volume dữ liệu
+		fsGroup := int64(1000)
+		podSpec.SecurityContext = &corev1.PodSecurityContext{FSGroup: &fsGroup}
+	default:
+		if container.Image == "" {
+			container.Image = defaultRabbitMQImage
+		}
+		secretName := QueueConnectionSecretName(ms)
+		container.Env = []corev1.EnvVar{
+			{
+				Name: "RABBITMQ_DEFAULT_USER",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						Key:                  ConnectionUsernameKey,
+					},
+				},
+			},
+			{
+				Name: "RABBITMQ_DEFAULT_PASS",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						Key:                  ConnectionPasswordKey,
+					},
+				},
+			},
+		}
+		container.VolumeMounts = []corev1.VolumeMount{{Name: "queue-data", MountPath: "/var/lib/rabbitmq"}}
+		container.ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				Exec: &corev1.ExecAction{Command: []string{"rabbitmq-diagnostics", "-q", "ping"}},
+			},
+			InitialDelaySeconds: 10,
+			PeriodSeconds:       10,
+			TimeoutSeconds:      5,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		}
+	}
+	podSpec.Containers = []corev1.Container{container}
+
+	return b.buildSingleNodeStatefulSet(ms, QueueName(ms), "queue", podSpec, queue.Storage)
+}
+
+// buildSingleNodeStatefulSet dựng StatefulSet một pod cho thành phần phụ (broker, search engine) cùng tên với
+// Service headless của nó. Dữ liệu nằm trên PVC <component>-data khi có storage, ngược lại trên emptyDir cùng tên
+func (b *ResourceBuilder) buildSingleNodeStatefulSet(ms *musicv1.MusicService, name, component string,
+	podSpec corev1.PodSpec, storage *musicv1.StorageSpec) *appsv1.StatefulSet {
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": component,
+	}
+	replicas := int32(1)
+	claimName := component + "-data"
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, component),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: name,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec:       podSpec,
+			},
+		},
+	}
+
+	if storage != nil {
+		sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: volumeClaimMeta(ms, claimName, storage),
+				Spec:       volumeClaimSpec(storage, parseQuantity(storage.Size)),
+			},
+		}
+	} else {
+		sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name:         claimName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+	b.applyQoS(ms, &sts.Spec.Template.Spec)
+	b.applyImageOverrides(&sts.Spec.Template.Spec)
+	return sts
+}
+
+// buildSingleNodeService dựng Service headless trỏ tới pod của thành phần phụ
+func (b *ResourceBuilder) buildSingleNodeService(ms *musicv1.MusicService, name, component, portName string, port int32) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, component),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": component,
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       portName,
+					Port:       port,
+					TargetPort: intstr.FromInt32(port),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: "None",
+		},
+	}
+}
+
+// buildQueueConnectionEnv trả về biến môi trường QUEUE_* cho container ứng dụng khi có spec.queue.
+// QUEUE_URL, QUEUE_USERNAME và QUEUE_PASSWORD là optional vì broker Kafka hoặc broker bên ngoài
+// không xác thực sẽ có giá trị rỗng
+func buildQueueConnectionEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	if ms.Spec.Queue == nil {
+		return nil
+	}
+
+	secretName := QueueConnectionSecretName(ms)
+	keys := []struct {
+		env, key string
+		optional bool
+	}{
+		{"QUEUE_ENGINE", QueueEngineKey, false},
+		{"QUEUE_BROKERS", QueueBrokersKey, false},
+		{"QUEUE_URL", QueueURLKey, true},
+		{"QUEUE_USERNAME", ConnectionUsernameKey, true},
+		{"QUEUE_PASSWORD", ConnectionPasswordKey, true},
+	}
+
+	env := make([]corev1.EnvVar, 0, len(keys))
+	for _, k := range keys {
+		selector := &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+			Key:                  k.key,
+		}
+		if k.optional {
+			optional := true
+			selector.Optional = &optional
+		}
+		env = append(env, corev1.EnvVar{
+			Name:      k.env,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: selector},
+		})
+	}
+	return env
+}
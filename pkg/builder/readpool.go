@@ -0,0 +1,209 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const defaultReadPoolReplicas = int32(2)
+
+// ReadPoolName trả về tên chung của Deployment, Service và tiền tố HPA của read pool
+func ReadPoolName(ms *musicv1.MusicService) string {
+	return ms.Name + "-read"
+}
+
+// ReadPoolReplicas trả về số pod mong muốn của read pool khi không có HPA
+func ReadPoolReplicas(ms *musicv1.MusicService) int32 {
+	if ms.Spec.ReadPool.Replicas > 0 {
+		return ms.Spec.ReadPool.Replicas
+	}
+	return defaultReadPoolReplicas
+}
+
+// BuildReadPoolDeployment xây dựng Deployment chỉ-đọc cho read pool
+// Pod không có PVC riêng, chỉ đọc cơ sở dữ liệu qua Service db-read và các thư viện dùng chung
+func (b *ResourceBuilder) BuildReadPoolDeployment(ms *musicv1.MusicService) *appsv1.Deployment {
+	labels := b.getLabels(ms, "read-pool")
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": "read-pool",
+	}
+	pool := ms.Spec.ReadPool
+
+	// spec.command/spec.args chỉ áp dụng khi read pool chạy cùng image với ứng dụng
+	image, command, args := ms.Spec.Image, ms.Spec.Command, ms.Spec.Args
+	if pool.Image != "" {
+		image, command, args = pool.Image, nil, nil
+	}
+	readinessProbe, livenessProbe := buildAppProbes(ms)
+
+	resources := b.appResources(pool.Resources)
+
+	env := []corev1.EnvVar{
+		{
+			Name:  "STREAMING_BITRATE",
+			Value: ms.Spec.Streaming.Bitrate,
+		},
+		{
+			Name:  "MAX_CONNECTIONS",
+			Value: fmt.Sprintf("%d", ms.Spec.Streaming.MaxConnections),
+		},
+		{
+			Name:  "READ_ONLY",
+			Value: "true",
+		},
+	}
+	if ms.Spec.Database != nil && ms.Spec.Database.Enabled {
+		env = append(env,
+			corev1.EnvVar{Name: "DATABASE_HOST", Value: ms.Name + "-db-read"},
+			corev1.EnvVar{Name: "DATABASE_PORT", Value: fmt.Sprintf("%d", b.DatabaseProvider(ms).DefaultPort())},
+		)
+	}
+	// Pod read pool cũng phục vụ stream, tìm kiếm và kiểm tra phiên nên cần cùng các kết nối này
+	env = append(env, buildServiceConnectionEnv(ms)...)
+
+	libraryVolumes, libraryMounts := buildLibraryVolumes(ms)
+	replicas := ReadPoolReplicas(ms)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ReadPoolName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: safeToEvictAnnotations(ms.Spec.SafeToEvict),
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					RuntimeClassName: ms.Spec.RuntimeClassName,
+					Containers: []corev1.Container{
+						{
+							Name:            "music-service",
+							Image:           image,
+							ImagePullPolicy: ms.Spec.ImagePullPolicy,
+							Command:         command,
+							Args:            args,
+							StartupProbe:    buildAppStartupProbe(ms),
+							ReadinessProbe:  readinessProbe,
+							LivenessProbe:   livenessProbe,
+							Resources:       resources,
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "http",
+									ContainerPort: AppContainerPort(ms),
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							Env:          env,
+							VolumeMounts: libraryMounts,
+						},
+					},
+					Volumes: libraryVolumes,
+				},
+			},
+		},
+	}
+	applyMonitoringPort(ms, &deployment.Spec.Template.Spec)
+	applyAppConfig(ms, &deployment.Spec.Template.Spec)
+	applyAppLogs(ms, &deployment.Spec.Template.Spec)
+	applyAccessLogShipping(ms, &deployment.Spec.Template.Spec)
+	b.applyQoS(ms, &deployment.Spec.Template.Spec)
+	b.applyImageOverrides(&deployment.Spec.Template.Spec)
+	return deployment
+
+}
+
+// BuildReadPoolService xây dựng Service ClusterIP cho lưu lượng duyệt catalog của read pool
+func (b *ResourceBuilder) BuildReadPoolService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "read-pool")
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ReadPoolName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "read-pool",
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       ms.Spec.Port,
+					TargetPort: AppServiceTargetPort(ms),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	applyServiceRouting(ms, svc)
+	return svc
+}
+
+// BuildReadPoolAutoscaler xây dựng HorizontalPodAutoscaler cho Deployment của read pool
+func (b *ResourceBuilder) BuildReadPoolAutoscaler(ms *musicv1.MusicService) *autoscalingv2.HorizontalPodAutoscaler {
+	labels := b.getLabels(ms, "read-pool-autoscaler")
+	autoscaling := ms.Spec.ReadPool.Autoscaling
+	metrics := []autoscalingv2.MetricSpec{
+		buildResourceMetric(corev1.ResourceCPU, autoscaling.TargetCPUUtilizationPercentage),
+	}
+
+	if autoscaling.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, buildResourceMetric(corev1.ResourceMemory, *autoscaling.TargetMemoryUtilizationPercentage))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ReadPoolName(ms) + "-autoscaler",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       ReadPoolName(ms),
+			},
+			MinReplicas: &autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// RegistrySecretName trả về tên Secret dockerconfigjson mà operator gắn vào imagePullSecrets
+func RegistrySecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-registry"
+}
+
+// BuildRegistrySecret xây dựng Secret dockerconfigjson trong namespace chạy workload
+// Nội dung được reconciler chép từ secretName hoặc dựng từ server/username/password
+func (b *ResourceBuilder) BuildRegistrySecret(ms *musicv1.MusicService, dockerConfigJSON []byte) *corev1.Secret {
+	labels := b.getLabels(ms, "registry")
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            RegistrySecretName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfigJSON,
+		},
+	}
+}
+
+// DockerConfigJSON dựng nội dung .dockerconfigjson cho một registry
+func DockerConfigJSON(server, username, password string) ([]byte, error) {
+	type authEntry struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	}
+	config := struct {
+		Auths map[string]authEntry `json:"auths"`
+	}{
+		Auths: map[string]authEntry{
+			server: {
+				Username: username,
+				Password: password,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	}
+	return json.Marshal(config)
+}
+
+// buildImagePullSecrets trả về imagePullSecrets cho pod khi có spec.registryCredentials
+func buildImagePullSecrets(ms *musicv1.MusicService) []corev1.LocalObjectReference {
+	if ms.Spec.RegistryCredentials == nil {
+		return nil
+	}
+	return []corev1.LocalObjectReference{{Name: RegistrySecretName(ms)}}
+}
@@ -0,0 +1,1572 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/database"
+)
+
+// Quick navigation for understanding the builder:
+// - For field specifications, see api/v1/musicservice_types.go
+// - For builder usage, see internal/reconciler/app.go and database.go
+// - For overall flow, see internal/controller/musicservice_controller.go
+
+// ResourceBuilder constructs Kubernetes resources from MusicService specifications
+type ResourceBuilder struct {
+	scheme           *runtime.Scheme
+	defaultResources corev1.ResourceRequirements
+	extraLabels      map[string]string
+	imageOverrides   map[string]string
+	// databaseProviders ghi đè provider theo engine, xem WithDatabaseProvider
+	databaseProviders map[string]database.Provider
+}
+
+// NewResourceBuilder tạo một ResourceBuilder mới; scheme nil nghĩa là dùng DefaultScheme
+func NewResourceBuilder(scheme *runtime.Scheme, opts ...Option) *ResourceBuilder {
+	b := &ResourceBuilder{
+		scheme: scheme,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	if b.scheme == nil {
+		b.scheme = DefaultScheme()
+	}
+	return b
+}
+
+// SetDefaultResources đặt tài nguyên dùng cho container ứng dụng và read pool khi không khai báo resources,
+// tránh pod BestEffort làm HPA không tính được mức sử dụng
+func (b *ResourceBuilder) SetDefaultResources(resources corev1.ResourceRequirements) {
+	b.defaultResources = corev1.ResourceRequirements{
+		Requests: canonicalResources(resources.Requests),
+		Limits:   canonicalResources(resources.Limits),
+	}
+}
+
+// canonicalResources đưa quantity về dạng API server trả về (ví dụ 0.1 thành 100m)
+// để pod template hiện tại so sánh khớp với pod template mong muốn
+func canonicalResources(list corev1.ResourceList) corev1.ResourceList {
+	if len(list) == 0 {
+		return nil
+	}
+	canonical := corev1.ResourceList{}
+	for name, quantity := range list {
+		canonical[name] = resource.MustParse(quantity.String())
+	}
+	return canonical
+}
+
+// appResources trả về resources khai báo hoặc tài nguyên mặc định của operator
+func (b *ResourceBuilder) appResources(resources *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if resources != nil {
+		return *resources.DeepCopy()
+	}
+	return *b.defaultResources.DeepCopy()
+}
+
+// BuildAppService xây dựng Service cho ứng dụng
+func (b *ResourceBuilder) BuildAppService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "app")
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "music-service",
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       ms.Spec.Port,
+					TargetPort: AppServiceTargetPort(ms),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	if TLSPassthroughEnabled(ms) {
+		svc.Spec.Ports = append(svc.Spec.Ports, buildTLSPassthroughServicePort(ms))
+	}
+
+	applyServiceTrafficPolicy(ms, svc)
+	applyServiceRouting(ms, svc)
+	return svc
+}
+
+// BuildAppStatefulSet xây dựng StatefulSet cho ứng dụng
+func (b *ResourceBuilder) BuildAppStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	labels := b.getLabels(ms, "app")
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": "music-service",
+	}
+
+	resources := b.appResources(ms.Spec.Resources)
+	reserveCacheStorage(ms, &resources)
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "music-data",
+			MountPath: "/data",
+		},
+	}
+	libraryVolumes, libraryMounts := buildLibraryVolumes(ms)
+	volumeMounts = append(volumeMounts, libraryMounts...)
+	cacheVolumes, cacheMounts := buildCacheVolumes(ms)
+	volumeMounts = append(volumeMounts, cacheMounts...)
+	readinessProbe, livenessProbe := buildAppProbes(ms)
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:            &ms.Spec.Replicas,
+			ServiceName:         ms.Name,
+			PodManagementPolicy: appPodManagementPolicy(ms),
+			MinReadySeconds:     ms.Spec.MinReadySeconds,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: safeToEvictAnnotations(ms.Spec.SafeToEvict),
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					RuntimeClassName: ms.Spec.RuntimeClassName,
+					InitContainers:   append(b.buildWaitForDatabaseContainers(ms), buildSeedInitContainers(ms)...),
+					Containers: []corev1.Container{
+						{
+							Name:            "music-service",
+							Image:           ms.Spec.Image,
+							ImagePullPolicy: ms.Spec.ImagePullPolicy,
+							Command:         ms.Spec.Command,
+							Args:            ms.Spec.Args,
+							StartupProbe:    buildAppStartupProbe(ms),
+							ReadinessProbe:  readinessProbe,
+							LivenessProbe:   livenessProbe,
+							Resources:       resources,
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "http",
+									ContainerPort: AppContainerPort(ms),
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							Env: append(append([]corev1.EnvVar{
+								{
+									Name:  "STREAMING_BITRATE",
+									Value: ms.Spec.Streaming.Bitrate,
+								},
+								{
+									Name:  "MAX_CONNECTIONS",
+									Value: fmt.Sprintf("%d", ms.Spec.Streaming.MaxConnections),
+								},
+							}, buildDatabaseConnectionEnv(ms)...), buildServiceConnectionEnv(ms)...),
+							VolumeMounts: volumeMounts,
+						},
+					},
+					Volumes: append(libraryVolumes, cacheVolumes...),
+				},
+			},
+		},
+	}
+
+	if AppSharedStorage(ms) {
+		sts.Spec.Template.Spec.Volumes = append([]corev1.Volume{sharedDataVolume(ms)}, sts.Spec.Template.Spec.Volumes...)
+	} else if storage := ms.Spec.Storage; storage != nil {
+		sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: volumeClaimMeta(ms, "music-data", storage),
+				Spec:       volumeClaimSpec(storage, parseQuantity(storage.Size)),
+			},
+		}
+	} else {
+		// Không có spec.storage thì music-data chỉ là thư mục tạm, dữ liệu nhạc nằm ngoài pod
+		sts.Spec.Template.Spec.Volumes = append([]corev1.Volume{musicDataEmptyDir()}, sts.Spec.Template.Spec.Volumes...)
+	}
+
+	applyDrainPodSpec(ms, &sts.Spec.Template.Spec)
+	applyTLSPassthrough(ms, &sts.Spec.Template.Spec)
+	applyMonitoringPort(ms, &sts.Spec.Template.Spec)
+	applyAppConfig(ms, &sts.Spec.Template.Spec)
+	applyAppLogs(ms, &sts.Spec.Template.Spec)
+	applyAccessLogShipping(ms, &sts.Spec.Template.Spec)
+	applyGPU(ms, &sts.Spec.Template.Spec)
+	b.applyQoS(ms, &sts.Spec.Template.Spec)
+	b.applyImageOverrides(&sts.Spec.Template.Spec)
+	return sts
+}
+
+// musicDataEmptyDir trả về volume music-data dạng emptyDir cho pod không có PVC riêng
+func musicDataEmptyDir() corev1.Volume {
+	return corev1.Volume{
+		Name:         "music-data",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}
+}
+
+// parseQuantity đọc kích thước dạng chuỗi đã được kiểm tra bởi ValidateQuantities;
+// giá trị không hợp lệ trả về quantity 0 thay vì panic như resource.MustParse
+func parseQuantity(value string) resource.Quantity {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}
+	}
+	return quantity
+}
+
+// storageCache trả về spec.storage.cache, nil khi không khai báo spec.storage
+func storageCache(ms *musicv1.MusicService) *musicv1.CacheVolumeSpec {
+	if ms.Spec.Storage == nil {
+		return nil
+	}
+	return ms.Spec.Storage.Cache
+}
+
+// buildCacheVolumes dựng volume emptyDir cho spec.storage.cache cùng volumeMount tương ứng
+func buildCacheVolumes(ms *musicv1.MusicService) ([]corev1.Volume, []corev1.VolumeMount) {
+	cache := storageCache(ms)
+	if cache == nil {
+		return nil, nil
+	}
+
+	emptyDir := &corev1.EmptyDirVolumeSource{}
+	if cache.Medium == musicv1.CacheMediumMemory {
+		emptyDir.Medium = corev1.StorageMediumMemory
+	}
+	if cache.Size != "" {
+		size := parseQuantity(cache.Size)
+		emptyDir.SizeLimit = &size
+	}
+
+	mountPath := defaultCacheMountPath
+	if cache.MountPath != "" {
+		mountPath = cache.MountPath
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name:         "cache",
+			VolumeSource: corev1.VolumeSource{EmptyDir: emptyDir},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: "cache", MountPath: mountPath},
+	}
+	return volumes, mounts
+}
+
+// reserveCacheStorage thêm request ephemeral-storage bằng kích thước cache trên đĩa khi chưa khai báo,
+// để scheduler chỉ đặt pod lên node còn đủ chỗ cho dữ liệu transcode tạm
+func reserveCacheStorage(ms *musicv1.MusicService, resources *corev1.ResourceRequirements) {
+	cache := storageCache(ms)
+	if cache == nil || cache.Medium == musicv1.CacheMediumMemory || cache.Size == "" {
+		return
+	}
+	if _, ok := resources.Requests[corev1.ResourceEphemeralStorage]; ok {
+		return
+	}
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	resources.Requests[corev1.ResourceEphemeralStorage] = parseQuantity(cache.Size)
+}
+
+// buildWaitForDatabaseContainers dựng init container chờ endpoint ghi của cơ sở dữ liệu nhận kết nối,
+// tránh ứng dụng crash loop trong lần triển khai đầu khi database chưa khởi động xong.
+// Lệnh chờ của provider không cần mật khẩu
+func (b *ResourceBuilder) buildWaitForDatabaseContainers(ms *musicv1.MusicService) []corev1.Container {
+	if ms.Spec.Database == nil || !ms.Spec.Database.Enabled {
+		return nil
+	}
+
+	config := b.buildDatabaseConfig(ms)
+	script := `echo "Waiting for database at $DB_HOST..."
+until ` + config.provider.WaitCommand() + `; do
+  sleep 2
+done`
+
+	return []corev1.Container{
+		{
+			Name:            "wait-for-database",
+			Image:           config.image,
+			ImagePullPolicy: config.imagePullPolicy,
+			Command:         []string{"/bin/sh", "-c", script},
+			Env: []corev1.EnvVar{
+				{Name: "DB_HOST", Value: config.masterHost},
+			},
+		},
+	}
+}
+
+// BuildDatabaseMasterStatefulSet xây dựng StatefulSet master của cơ sở dữ liệu
+func (b *ResourceBuilder) BuildDatabaseMasterStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	labels := b.getLabels(ms, "db-master")
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": "db-master",
+	}
+
+	config := b.buildDatabaseConfig(ms)
+	replicas := int32(1)
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-master",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:        &replicas,
+			ServiceName:     ms.Name + "-db-master",
+			MinReadySeconds: config.minReadySeconds,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: safeToEvictAnnotations(ms.Spec.Database.SafeToEvict),
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					InitContainers: []corev1.Container{
+						{
+							Name:            "init-db-config",
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Command:         []string{"/bin/sh", "-c", config.provider.ConfigScript(database.RoleMaster, config.serverOptions(buildAuditLogOptions(config.auditLog)))},
+							Resources:       *config.resources.DeepCopy(),
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "db-config",
+									MountPath: "/db-config",
+								},
+							},
+						},
+					},
+					Containers: append([]corev1.Container{
+						{
+							Name:            config.provider.ContainerName(),
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Args:            config.provider.ServerArgs(),
+							Resources:       *config.resources.DeepCopy(),
+							Env:             config.serverEnv(ms),
+							Ports:           config.containerPorts(),
+							ReadinessProbe:  buildDatabaseReadinessProbe(config),
+							StartupProbe:    buildDatabaseStartupProbe(config),
+							LivenessProbe:   buildDatabaseLivenessProbe(config),
+							VolumeMounts:    config.serverVolumeMounts(),
+						},
+					}, buildBootstrapContainer(ms, config)...),
+					Volumes: []corev1.Volume{
+						{
+							Name: "db-config",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: volumeClaimMeta(ms, "db-data", config.storage),
+					Spec:       volumeClaimSpec(config.storage, config.storageSize),
+				},
+			},
+		},
+	}
+	addInitScriptsVolume(&sts.Spec.Template.Spec, config)
+	applyAuditLog(config.auditLog, &sts.Spec.Template.Spec)
+	b.applyQoS(ms, &sts.Spec.Template.Spec)
+	b.applyImageOverrides(&sts.Spec.Template.Spec)
+	return sts
+
+}
+
+// addInitScriptsVolume mount ConfigMap initScriptsConfigMap vào thư mục script khởi tạo của container server
+func addInitScriptsVolume(podSpec *corev1.PodSpec, config databaseConfig) {
+	configMapName := config.initScripts
+	if configMapName == "" {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: "db-init-scripts",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+			},
+		},
+	})
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name != config.provider.ContainerName() {
+			continue
+		}
+		podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      "db-init-scripts",
+			MountPath: config.provider.InitScriptsDir(),
+			ReadOnly:  true,
+		})
+	}
+}
+
+// BuildDatabaseReplicaStatefulSet xây dựng StatefulSet replica của cơ sở dữ liệu
+func (b *ResourceBuilder) BuildDatabaseReplicaStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	labels := b.getLabels(ms, "db-replica")
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": "db-replica",
+	}
+
+	config := b.buildDatabaseConfig(ms)
+	initContainers := []corev1.Container{
+		{
+			Name:            "init-db-config",
+			Image:           config.image,
+			ImagePullPolicy: config.imagePullPolicy,
+			Command: []string{"/bin/sh", "-c", config.provider.ConfigScript(database.RoleReplica, config.serverOptions(
+				buildAuditLogOptions(config.auditLog)+buildParallelApplyOptions(config)+buildReplicationFilterOptions(config.replicationFilters)))},
+			Resources: *config.resources.DeepCopy(),
+			Env: []corev1.EnvVar{
+				{
+					Name: "POD_NAME",
+					ValueFrom: &corev1.EnvVarSource{
+						FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+					},
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "db-config",
+					MountPath: "/db-config",
+				},
+			},
+		},
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: "db-config",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	replicaEnv := config.serverEnv(ms)
+	if config.replicationEnabled {
+		replicaEnv = append(replicaEnv, replicationCredentialEnv(config.replicationSecret)...)
+	}
+	replicaSetup := config.provider.ReplicaSetup(config.masterHost)
+	initContainers = append(initContainers, buildReplicaInitContainer(config, replicaSetup.InitScript)...)
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-replica",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:        &config.replicas,
+			ServiceName:     ms.Name + "-db-replica",
+			MinReadySeconds: config.minReadySeconds,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: safeToEvictAnnotations(ms.Spec.Database.SafeToEvict),
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					InitContainers:   initContainers,
+					Containers: append([]corev1.Container{
+						{
+							Name:            config.provider.ContainerName(),
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Args:            config.provider.ServerArgs(),
+							Resources:       *config.resources.DeepCopy(),
+							Env:             replicaEnv,
+							Ports:           config.containerPorts(),
+							ReadinessProbe:  buildReplicaReadinessProbe(config),
+							StartupProbe:    buildDatabaseStartupProbe(config),
+							LivenessProbe:   buildDatabaseLivenessProbe(config),
+							VolumeMounts:    config.serverVolumeMounts(),
+						},
+					},
+						buildReplicaSetupContainer(config, replicaSetup.SidecarScript)...),
+					Volumes: volumes,
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: volumeClaimMeta(ms, "db-data", config.storage),
+					Spec:       volumeClaimSpec(config.storage, config.storageSize),
+				},
+			},
+		},
+	}
+	applyAuditLog(config.auditLog, &sts.Spec.Template.Spec)
+	b.applyQoS(ms, &sts.Spec.Template.Spec)
+	b.applyImageOverrides(&sts.Spec.Template.Spec)
+	return sts
+
+}
+
+// BuildDatabaseGaleraStatefulSet xây dựng StatefulSet Galera Cluster, nơi tất cả các node ngang hàng
+// Tất cả replicas đều có thể được đưa lên làm primary khi node hiện tại chết
+func (b *ResourceBuilder) BuildDatabaseGaleraStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	labels := b.getLabels(ms, "db-galera")
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": "db-galera",
+	}
+
+	config := b.buildDatabaseConfig(ms)
+	// 1 initial primary node + configured replica count
+	totalReplicas := config.replicas + 1
+	stsName := ms.Name + "-db-galera"
+
+	configScript := buildGaleraConfigScript(stsName, WorkloadNamespace(ms), int(totalReplicas),
+		database.MySQLServerOptions(config.serverOptions(buildAuditLogOptions(config.auditLog))))
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            stsName,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:        &totalReplicas,
+			ServiceName:     stsName,
+			MinReadySeconds: config.minReadySeconds,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: safeToEvictAnnotations(ms.Spec.Database.SafeToEvict),
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: buildImagePullSecrets(ms),
+					InitContainers: []corev1.Container{
+						{
+							Name:            GaleraConfigContainerName,
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Command:         []string{"/bin/sh", "-c", configScript},
+							Resources:       *config.resources.DeepCopy(),
+							Env: []corev1.EnvVar{
+								{
+									Name: "POD_NAME",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+									},
+								},
+								{
+									Name: "POD_IP",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"},
+									},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "db-config", MountPath: "/db-config"},
+								{Name: "db-data", MountPath: "/var/lib/mysql"},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:            "mariadb",
+							Image:           config.image,
+							ImagePullPolicy: config.imagePullPolicy,
+							Resources:       *config.resources.DeepCopy(),
+							Env:             config.serverEnv(ms),
+							Ports: []corev1.ContainerPort{
+								{Name: "mysql", ContainerPort: 3306, Protocol: corev1.ProtocolTCP},
+								{Name: "galera-repl", ContainerPort: 4444, Protocol: corev1.ProtocolTCP},
+								{Name: "galera-ist", ContainerPort: 4568, Protocol: corev1.ProtocolTCP},
+								{Name: "galera-sst", ContainerPort: 4567, Protocol: corev1.ProtocolTCP},
+							},
+							ReadinessProbe: buildGaleraReadinessProbe(config),
+							StartupProbe:   buildDatabaseStartupProbe(config),
+							LivenessProbe:  buildDatabaseLivenessProbe(config),
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "db-data", MountPath: "/var/lib/mysql"},
+								{Name: "db-config", MountPath: "/etc/mysql/conf.d"},
+							},
+						},
+						buildGaleraExporterContainer(config),
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "db-config",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: volumeClaimMeta(ms, "db-data", config.storage),
+					Spec:       volumeClaimSpec(config.storage, config.storageSize),
+				},
+			},
+		},
+	}
+	applyAuditLog(config.auditLog, &sts.Spec.Template.Spec)
+	b.applyQoS(ms, &sts.Spec.Template.Spec)
+	b.applyImageOverrides(&sts.Spec.Template.Spec)
+	return sts
+
+}
+
+// BuildDatabaseGaleraService xây dựng Headless Service cho Galera Cluster (dùng cho pod discovery)
+func (b *ResourceBuilder) BuildDatabaseGaleraService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "db-galera")
+	stsName := ms.Name + "-db-galera"
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            stsName,
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "db-galera",
+			},
+			Ports: []corev1.ServicePort{
+				{Name: "mysql", Port: 3306, Protocol: corev1.ProtocolTCP},
+				{Name: "galera-repl", Port: 4444, Protocol: corev1.ProtocolTCP},
+				{Name: "galera-ist", Port: 4568, Protocol: corev1.ProtocolTCP},
+				{Name: "galera-sst", Port: 4567, Protocol: corev1.ProtocolTCP},
+			},
+			Type:                     corev1.ServiceTypeClusterIP,
+			ClusterIP:                "None",
+			PublishNotReadyAddresses: true,
+		},
+	}
+}
+
+// BuildDatabaseGaleraPrimaryService xây dựng Service write endpoint cho Galera Cluster
+// Service này trỏ đến tất cả các galera node, đảm bảo không gián đoạn khi có node chết
+func (b *ResourceBuilder) BuildDatabaseGaleraPrimaryService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "db-primary")
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-master",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "db-galera",
+			},
+			Ports: []corev1.ServicePort{
+				{Name: "mysql", Port: 3306, Protocol: corev1.ProtocolTCP},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
+// BuildDatabaseGaleraReadService xây dựng Service đọc cho Galera Cluster
+func (b *ResourceBuilder) BuildDatabaseGaleraReadService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "db-read")
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-read",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "db-galera",
+			},
+			Ports: []corev1.ServicePort{
+				{Name: "mysql", Port: 3306, Protocol: corev1.ProtocolTCP},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	applyServiceRouting(ms, svc)
+	return svc
+}
+
+func (b *ResourceBuilder) BuildDatabaseMasterService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "db-master")
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-master",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "db-master",
+			},
+			Ports:     b.databaseServicePorts(ms),
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: "None",
+		},
+	}
+}
+
+// databaseServicePorts trả về cổng Service master/đọc theo provider của spec.database.engine
+func (b *ResourceBuilder) databaseServicePorts(ms *musicv1.MusicService) []corev1.ServicePort {
+	provider := b.DatabaseProvider(ms)
+	return []corev1.ServicePort{
+		{
+			Name:     provider.PortName(),
+			Port:     provider.DefaultPort(),
+			Protocol: corev1.ProtocolTCP,
+		},
+	}
+}
+
+// BuildDatabaseReadService xây dựng Service đọc của cơ sở dữ liệu
+func (b *ResourceBuilder) BuildDatabaseReadService(ms *musicv1.MusicService) *corev1.Service {
+	labels := b.getLabels(ms, "db-read")
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-read",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":       ms.Name,
+				"component": "db-replica",
+			},
+			Ports: b.databaseServicePorts(ms),
+			Type:  corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	applyServiceRouting(ms, svc)
+	return svc
+}
+
+// BuildDatabaseReadFallbackService xây dựng Service db-read trỏ về pod master
+// Dùng khi spec.database.readFallbackToMaster bật và không có replica nào sẵn sàng
+func (b *ResourceBuilder) BuildDatabaseReadFallbackService(ms *musicv1.MusicService) *corev1.Service {
+	svc := b.BuildDatabaseReadService(ms)
+	svc.Spec.Selector = map[string]string{
+		"app":       ms.Name,
+		"component": "db-master",
+	}
+	return svc
+}
+
+// BuildAutoscaler xây dựng HorizontalPodAutoscaler cho StatefulSet hoặc Deployment của ứng dụng
+func (b *ResourceBuilder) BuildAutoscaler(ms *musicv1.MusicService) *autoscalingv2.HorizontalPodAutoscaler {
+	labels := b.getLabels(ms, "autoscaler")
+	metrics := []autoscalingv2.MetricSpec{
+		buildResourceMetric(corev1.ResourceCPU, ms.Spec.Autoscaling.TargetCPUUtilizationPercentage),
+	}
+
+	if ms.Spec.Autoscaling.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, buildResourceMetric(corev1.ResourceMemory, *ms.Spec.Autoscaling.TargetMemoryUtilizationPercentage))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-autoscaler",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       AppWorkloadKind(ms),
+				Name:       ms.Name,
+			},
+			MinReplicas: &ms.Spec.Autoscaling.MinReplicas,
+			MaxReplicas: ms.Spec.Autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+// BuildDatabaseReplicaAutoscaler xây dựng HorizontalPodAutoscaler cho StatefulSet replica của cơ sở dữ liệu
+func (b *ResourceBuilder) BuildDatabaseReplicaAutoscaler(ms *musicv1.MusicService) *autoscalingv2.HorizontalPodAutoscaler {
+	labels := b.getLabels(ms, "db-autoscaler")
+	autoscaling := ms.Spec.Database.Autoscaling
+	minReplicas, maxReplicas := DatabaseReplicaBounds(ms)
+	metrics := []autoscalingv2.MetricSpec{
+		buildResourceMetric(corev1.ResourceCPU, autoscaling.TargetCPUUtilizationPercentage),
+	}
+
+	if autoscaling.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, buildResourceMetric(corev1.ResourceMemory, *autoscaling.TargetMemoryUtilizationPercentage))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ms.Name + "-db-replica-autoscaler",
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       ms.Name + "-db-replica",
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: maxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+// DatabaseReplicaBounds trả về giới hạn min/max của HPA replica cơ sở dữ liệu
+// Giá trị trong database.replication được ưu tiên, thiếu thì lấy từ database.autoscaling
+func DatabaseReplicaBounds(ms *musicv1.MusicService) (int32, int32) {
+	var minReplicas, maxReplicas int32
+	if autoscaling := ms.Spec.Database.Autoscaling; autoscaling != nil {
+		minReplicas, maxReplicas = autoscaling.MinReplicas, autoscaling.MaxReplicas
+	}
+	if replication := ms.Spec.Database.Replication; replication != nil {
+		if replication.MinReplicas != nil {
+			minReplicas = *replication.MinReplicas
+		}
+		if replication.MaxReplicas != nil {
+			maxReplicas = *replication.MaxReplicas
+		}
+	}
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+	return minReplicas, maxReplicas
+}
+
+// Helper functions for building labels and metrics
+
+// getLabels trả về nhãn của WithLabels, spec.labels rồi các nhãn nội bộ của operator; nhãn sau thắng khi trùng key
+// vì selector và InstanceSelector dựa vào chúng
+func (b *ResourceBuilder) getLabels(ms *musicv1.MusicService, component string) map[string]string {
+	labels := maps.Clone(b.extraLabels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, ms.Spec.Labels)
+	maps.Copy(labels, map[string]string{
+		"app":                          ms.Name,
+		"component":                    component,
+		"app.kubernetes.io/name":       "music-service",
+		"app.kubernetes.io/instance":   ms.Name,
+		"app.kubernetes.io/managed-by": "music-operator",
+	})
+
+	if DedicatedTenancy(ms) {
+		labels[TenantOwnerNamespaceLabel] = ms.Namespace
+		labels[TenantOwnerNameLabel] = ms.Name
+	}
+
+	return labels
+}
+
+// getAnnotations trả về spec.annotations cộng các annotation riêng của tài nguyên (ghi đè key trùng), nil nếu cả hai trống
+func getAnnotations(ms *musicv1.MusicService, own map[string]string) map[string]string {
+	if len(ms.Spec.Annotations) == 0 && len(own) == 0 {
+		return nil
+	}
+	annotations := maps.Clone(ms.Spec.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	maps.Copy(annotations, own)
+	return annotations
+}
+
+// AutoscalingEnabled cho biết khối autoscaling có được áp dụng hay không; Enabled bỏ trống nghĩa là bật
+func AutoscalingEnabled(autoscaling *musicv1.AutoscalingSpec) bool {
+	return autoscaling != nil && (autoscaling.Enabled == nil || *autoscaling.Enabled)
+}
+
+func buildResourceMetric(resourceName corev1.ResourceName, targetUtilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resourceName,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &targetUtilization,
+			},
+		},
+	}
+}
+
+// defaultCacheMountPath là đường dẫn mount mặc định của volume cache
+const defaultCacheMountPath = "/cache"
+
+// appPodManagementPolicy trả về podManagementPolicy của StatefulSet ứng dụng (mặc định OrderedReady như Kubernetes)
+func appPodManagementPolicy(ms *musicv1.MusicService) appsv1.PodManagementPolicyType {
+	if ms.Spec.PodManagementPolicy != "" {
+		return ms.Spec.PodManagementPolicy
+	}
+	return appsv1.OrderedReadyPodManagement
+}
+
+// defaultAppContainerPort là cổng container ứng dụng khi spec.containerPort chưa được đặt
+const defaultAppContainerPort = int32(80)
+
+// AppContainerPort trả về cổng container ứng dụng, dùng cho container, probe và targetPort của Service
+func AppContainerPort(ms *musicv1.MusicService) int32 {
+	if ms.Spec.ContainerPort > 0 {
+		return ms.Spec.ContainerPort
+	}
+	return defaultAppContainerPort
+}
+
+// AppServiceTargetPort trả về targetPort của Service ứng dụng và read pool
+// Mặc định dùng port "http" theo tên để Service luôn theo đúng spec.containerPort của từng pod,
+// kể cả trong lúc rolling update đổi cổng
+func AppServiceTargetPort(ms *musicv1.MusicService) intstr.IntOrString {
+	if ms.Spec.Service != nil && ms.Spec.Service.TargetPort != nil {
+		return *ms.Spec.Service.TargetPort
+	}
+	return intstr.FromString("http")
+}
+
+// defaultReplicationMaxLagSeconds là ngưỡng trễ replication mặc định để replica còn nhận lưu lượng đọc
+const defaultReplicationMaxLagSeconds = int32(30)
+
+type databaseConfig struct {
+	provider           database.Provider
+	image              string
+	imagePullPolicy    corev1.PullPolicy
+	startupTimeout     int32
+	minReadySeconds    int32
+	storageSize        resource.Quantity
+	storage            *musicv1.StorageSpec
+	resources          corev1.ResourceRequirements
+	rootPassword       string
+	rootPasswordSecret *corev1.SecretKeySelector
+	replicas           int32
+	masterHost         string
+	replicationEnabled bool
+	replicationGTID    bool
+	replicationSecret  string
+	maxLagSeconds      int32
+	replicationFilters *musicv1.ReplicationFiltersSpec
+	parallelThreads    int32
+	parallelMode       string
+	probes             *musicv1.DatabaseProbesSpec
+	initScripts        string
+	characterSet       string
+	collation          string
+	timeZone           string
+	auditLog           *musicv1.DatabaseAuditLogSpec
+}
+
+// rootPasswordEnv trả về biến mật khẩu superuser của provider (MYSQL_ROOT_PASSWORD với MariaDB),
+// đọc từ Secret khi có rootPasswordSecretRef
+func (c databaseConfig) rootPasswordEnv() corev1.EnvVar {
+	if c.rootPasswordSecret != nil {
+		return corev1.EnvVar{
+			Name:      c.provider.RootPasswordEnv(),
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: c.rootPasswordSecret.DeepCopy()},
+		}
+	}
+	return corev1.EnvVar{Name: c.provider.RootPasswordEnv(), Value: c.rootPassword}
+}
+
+// serverEnv trả về biến môi trường của container server: mật khẩu superuser, database, user ứng dụng
+// và biến cố định của provider
+func (c databaseConfig) serverEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		c.rootPasswordEnv(),
+		{
+			Name:  c.provider.DatabaseEnv(),
+			Value: defaultDatabaseName,
+		},
+	}
+	env = append(env, buildDatabaseUserEnv(ms, c.provider)...)
+	return append(env, c.provider.ServerEnv()...)
+}
+
+// containerPorts trả về cổng của container server theo provider
+func (c databaseConfig) containerPorts() []corev1.ContainerPort {
+	return []corev1.ContainerPort{
+		{
+			Name:          c.provider.PortName(),
+			ContainerPort: c.provider.DefaultPort(),
+			Protocol:      corev1.ProtocolTCP,
+		},
+	}
+}
+
+// serverVolumeMounts mount PVC db-data và cấu hình do init container sinh ra vào thư mục của provider
+func (c databaseConfig) serverVolumeMounts() []corev1.VolumeMount {
+	return []corev1.VolumeMount{
+		{
+			Name:      "db-data",
+			MountPath: c.provider.DataDir(),
+		},
+		{
+			Name:      "db-config",
+			MountPath: c.provider.ConfigDir(),
+		},
+	}
+}
+
+// serverOptions gom bộ nhớ container (limit, nếu không có thì request) và locale cho ConfigScript của provider;
+// extra là các dòng cấu hình riêng của engine
+func (c databaseConfig) serverOptions(extra string) database.ServerOptions {
+	options := database.ServerOptions{
+		CharacterSet: c.characterSet,
+		Collation:    c.collation,
+		TimeZone:     c.timeZone,
+		Extra:        extra,
+	}
+	memory, ok := c.resources.Limits[corev1.ResourceMemory]
+	if !ok {
+		memory, ok = c.resources.Requests[corev1.ResourceMemory]
+	}
+	if ok {
+		options.MemoryBytes = memory.Value()
+	}
+	return options
+}
+
+// buildDatabaseConfig gom cấu hình cơ sở dữ liệu từ spec.database, giá trị mặc định lấy từ provider của engine
+func (b *ResourceBuilder) buildDatabaseConfig(ms *musicv1.MusicService) databaseConfig {
+	provider := b.DatabaseProvider(ms)
+	config := databaseConfig{
+		provider:           provider,
+		image:              provider.DefaultImage(),
+		storageSize:        resource.MustParse(provider.DefaultStorageSize()),
+		rootPassword:       provider.DefaultRootPassword(),
+		replicas:           0,
+		masterHost:         ms.Name + "-db-master",
+		replicationEnabled: true,
+		replicationGTID:    true,
+		replicationSecret:  replicationSecretName(ms),
+		maxLagSeconds:      defaultReplicationMaxLagSeconds,
+	}
+
+	if ms.Spec.Database == nil {
+		return config
+	}
+
+	config.replicas = ms.Spec.Database.Replicas
+	if ms.Spec.Database.Image != "" {
+		config.image = ms.Spec.Database.Image
+	}
+	config.imagePullPolicy = ms.Spec.Database.ImagePullPolicy
+	config.startupTimeout = ms.Spec.Database.StartupTimeoutSeconds
+	config.probes = ms.Spec.Database.Probes
+	config.initScripts = ms.Spec.Database.InitScriptsConfigMap
+	config.characterSet = ms.Spec.Database.CharacterSet
+	config.collation = ms.Spec.Database.Collation
+	config.timeZone = ms.Spec.Database.TimeZone
+	config.auditLog = ms.Spec.Database.AuditLog
+	config.minReadySeconds = ms.Spec.Database.MinReadySeconds
+	config.storage = ms.Spec.Database.Storage
+	if ms.Spec.Database.Resources != nil {
+		config.resources = *ms.Spec.Database.Resources
+	}
+	if ms.Spec.Database.Storage != nil {
+		config.storageSize = parseQuantity(ms.Spec.Database.Storage.Size)
+	}
+	if ms.Spec.Database.RootPassword != "" {
+		config.rootPassword = ms.Spec.Database.RootPassword
+	}
+	config.rootPasswordSecret = ms.Spec.Database.RootPasswordSecretRef
+	if ms.Spec.Database.Replication != nil {
+		if ms.Spec.Database.Replication.Enabled != nil {
+			config.replicationEnabled = *ms.Spec.Database.Replication.Enabled
+		}
+		if ms.Spec.Database.Replication.GTID != nil {
+			config.replicationGTID = *ms.Spec.Database.Replication.GTID
+		}
+		if ms.Spec.Database.Replication.MaxLagSeconds != nil {
+			config.maxLagSeconds = *ms.Spec.Database.Replication.MaxLagSeconds
+		}
+		config.replicationFilters = ms.Spec.Database.Replication.Filters
+		if ms.Spec.Database.Replication.ParallelThreads != nil {
+			config.parallelThreads = *ms.Spec.Database.Replication.ParallelThreads
+		}
+		config.parallelMode = ms.Spec.Database.Replication.ParallelMode
+	}
+
+	return config
+}
+
+func replicationSecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-db-replication"
+}
+
+// buildReplicaReadinessProbe chỉ báo replica sẵn sàng khi replication đang chạy và
+// độ trễ không vượt ngưỡng, để Service db-read loại các replica trễ nhiều
+func buildReplicaReadinessProbe(config databaseConfig) *corev1.Probe {
+	if !config.replicationEnabled {
+		return buildDatabaseReadinessProbe(config)
+	}
+	command := config.provider.ReplicaReadyCommand(config.maxLagSeconds)
+
+	handler := corev1.ProbeHandler{
+		Exec: &corev1.ExecAction{
+			Command: []string{"/bin/sh", "-c", command},
+		},
+	}
+	return databaseProbe(handler, 10, 10, databaseProbeTiming(config, false))
+}
+
+// buildGaleraReadinessProbe chỉ coi node Galera là Ready khi node đã Synced (wsrep_local_state 4) trong
+// primary component; operator dựa vào số pod Ready để báo galeraReadyNodes và galeraClusterStatus,
+// còn galeraClusterSize đọc từ sidecar GaleraExporterContainerName
+func buildGaleraReadinessProbe(config databaseConfig) *corev1.Probe {
+	command := `mysql -uroot -p$MYSQL_ROOT_PASSWORD -N -e "SHOW GLOBAL STATUS WHERE Variable_name IN ('wsrep_cluster_status','wsrep_ready','wsrep_local_state')" |
+awk '{s[$1]=$2} END {exit !(s["wsrep_cluster_status"]=="Primary" && s["wsrep_ready"]=="ON" && s["wsrep_local_state"]==4)}'`
+
+	handler := corev1.ProbeHandler{
+		Exec: &corev1.ExecAction{
+			Command: []string{"/bin/sh", "-c", command},
+		},
+	}
+	return databaseProbe(handler, 10, 10, databaseProbeTiming(config, false))
+}
+
+// buildDatabaseReadinessProbe dựng readiness probe của container server theo spec.database.probes
+func buildDatabaseReadinessProbe(config databaseConfig) *corev1.Probe {
+	return databaseProbe(databaseProbeHandler(config), 10, 10, databaseProbeTiming(config, false))
+}
+
+// buildDatabaseLivenessProbe dựng liveness probe của container server theo spec.database.probes
+func buildDatabaseLivenessProbe(config databaseConfig) *corev1.Probe {
+	return databaseProbe(databaseProbeHandler(config), 30, 20, databaseProbeTiming(config, true))
+}
+
+// databaseProbeHandler trả về cách kiểm tra server theo spec.database.probes.handler (mặc định lệnh ping của provider)
+func databaseProbeHandler(config databaseConfig) corev1.ProbeHandler {
+	handler := musicv1.DatabaseProbePing
+	if config.probes != nil && config.probes.Handler != "" {
+		handler = config.probes.Handler
+	}
+	switch handler {
+	case musicv1.DatabaseProbeTCP:
+		return corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(config.provider.DefaultPort())},
+		}
+	case musicv1.DatabaseProbeQuery:
+		return corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"/bin/sh", "-c", config.provider.HealthCommand(true)},
+			},
+		}
+	default:
+		return corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"/bin/sh", "-c", config.provider.HealthCommand(false)},
+			},
+		}
+	}
+}
+
+// databaseProbeTiming trả về phần ghi đè thời gian của liveness hoặc readiness probe
+func databaseProbeTiming(config databaseConfig, liveness bool) *musicv1.ProbeTimingSpec {
+	if config.probes == nil {
+		return nil
+	}
+	if liveness {
+		return config.probes.Liveness
+	}
+	return config.probes.Readiness
+}
+
+// databaseProbe ghép handler với thời gian mặc định và phần ghi đè
+// Các ngưỡng được ghi rõ bằng giá trị mặc định của API server để so sánh khi cập nhật không bị lệch
+func databaseProbe(handler corev1.ProbeHandler, initialDelay, period int32, timing *musicv1.ProbeTimingSpec) *corev1.Probe {
+	probe := &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: initialDelay,
+		PeriodSeconds:       period,
+		TimeoutSeconds:      1,
+		SuccessThreshold:    1,
+		FailureThreshold:    3,
+	}
+	if timing == nil {
+		return probe
+	}
+	if timing.InitialDelaySeconds != nil {
+		probe.InitialDelaySeconds = *timing.InitialDelaySeconds
+	}
+	if timing.PeriodSeconds != nil {
+		probe.PeriodSeconds = *timing.PeriodSeconds
+	}
+	if timing.TimeoutSeconds != nil {
+		probe.TimeoutSeconds = *timing.TimeoutSeconds
+	}
+	if timing.FailureThreshold != nil {
+		probe.FailureThreshold = *timing.FailureThreshold
+	}
+	return probe
+}
+
+// buildParallelApplyOptions sinh slave_parallel_threads/slave_parallel_mode khi bật áp dụng song song trên replica
+func buildParallelApplyOptions(config databaseConfig) string {
+	if config.parallelThreads == 0 {
+		return ""
+	}
+	options := fmt.Sprintf("slave_parallel_threads=%d\n", config.parallelThreads)
+	if config.parallelMode != "" {
+		options += fmt.Sprintf("slave_parallel_mode=%s\n", config.parallelMode)
+	}
+	return options
+}
+
+// buildReplicationFilterOptions sinh các dòng replicate_* cho replica, mỗi giá trị một dòng
+// Tên database/bảng đã được CRD giới hạn ở chữ, số, _ và % nên có thể ghi thẳng vào heredoc
+func buildReplicationFilterOptions(filters *musicv1.ReplicationFiltersSpec) string {
+	if filters == nil {
+		return ""
+	}
+	var options strings.Builder
+	for _, group := range []struct {
+		option string
+		values []string
+	}{
+		{"replicate_do_db", filters.DoDB},
+		{"replicate_ignore_db", filters.IgnoreDB},
+		{"replicate_do_table", filters.DoTable},
+		{"replicate_ignore_table", filters.IgnoreTable},
+		{"replicate_wild_do_table", filters.WildDoTable},
+		{"replicate_wild_ignore_table", filters.WildIgnoreTable},
+	} {
+		for _, value := range group.values {
+			fmt.Fprintf(&options, "%s=%s\n", group.option, value)
+		}
+	}
+	return options.String()
+}
+
+// buildReplicaSetupContainer dựng sidecar replication-setup chạy SidecarScript của provider
+func buildReplicaSetupContainer(config databaseConfig, script string) []corev1.Container {
+	if !config.replicationEnabled || script == "" {
+		return nil
+	}
+
+	return []corev1.Container{
+		{
+			Name:            "replication-setup",
+			Image:           config.image,
+			ImagePullPolicy: config.imagePullPolicy,
+			Command:         []string{"/bin/sh", "-c", script},
+			Env: append([]corev1.EnvVar{
+				config.rootPasswordEnv(),
+			}, replicationCredentialEnv(config.replicationSecret)...),
+		},
+	}
+}
+
+// buildReplicaInitContainer dựng init container replication-init chạy InitScript của provider trên data directory
+// của replica trước khi server khởi động, ví dụ sao chép base backup từ master; POD_NAME cho script đặt tên
+// tài nguyên riêng của replica như replication slot
+func buildReplicaInitContainer(config databaseConfig, script string) []corev1.Container {
+	if !config.replicationEnabled || script == "" {
+		return nil
+	}
+
+	env := append([]corev1.EnvVar{
+		config.rootPasswordEnv(),
+		{
+			Name: "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		},
+	}, replicationCredentialEnv(config.replicationSecret)...)
+	return []corev1.Container{
+		{
+			Name:            "replication-init",
+			Image:           config.image,
+			ImagePullPolicy: config.imagePullPolicy,
+			Command:         []string{"/bin/sh", "-c", script},
+			Env:             append(env, config.provider.ServerEnv()...),
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "db-data", MountPath: config.provider.DataDir()},
+			},
+		},
+	}
+}
+
+// buildBootstrapContainer dựng sidecar db-bootstrap của master khi provider cần tự tạo user ứng dụng
+func buildBootstrapContainer(ms *musicv1.MusicService, config databaseConfig) []corev1.Container {
+	script := config.provider.BootstrapScript()
+	if script == "" {
+		return nil
+	}
+
+	return []corev1.Container{
+		{
+			Name:            "db-bootstrap",
+			Image:           config.image,
+			ImagePullPolicy: config.imagePullPolicy,
+			Command:         []string{"/bin/sh", "-c", script},
+			Env:             config.serverEnv(ms),
+		},
+	}
+}
+
+// replicationCredentialEnv đọc REPLICATION_USER/REPLICATION_PASSWORD từ Secret replication
+func replicationCredentialEnv(secretName string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name: "REPLICATION_USER",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Key: "username",
+				},
+			},
+		},
+		{
+			Name: "REPLICATION_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Key: "password",
+				},
+			},
+		},
+	}
+}
+
+const (
+	// GaleraConfigContainerName là init container sinh galera.cnf cho node Galera
+	GaleraConfigContainerName = "init-galera-config"
+	// GaleraBootstrapMessage là termination message của GaleraConfigContainerName khi node khởi tạo cluster mới
+	// vì chưa có grastate.dat; GaleraJoinMessage khi node gia nhập cluster đã có
+	GaleraBootstrapMessage = "bootstrap"
+	GaleraJoinMessage      = "join"
+)
+
+// buildGaleraConfigScript tạo script init container để cấu hình Galera Cluster cho mỗi pod
+// Pod-0 sẽ bootstrap cluster khi chưa có data; các pod khác luôn join cluster hiện có
+// extraOptions (mỗi dòng kết thúc bằng \n) được thêm vào cuối nhóm [mysqld]
+func buildGaleraConfigScript(stsName, namespace string, totalReplicas int, extraOptions string) string {
+	members := make([]string, totalReplicas)
+	for i := 0; i < totalReplicas; i++ {
+		members[i] = fmt.Sprintf("%s-%d.%s.%s.svc.cluster.local", stsName, i, stsName, namespace)
+	}
+	clusterMembers := strings.Join(members, ",")
+
+	return fmt.Sprintf(`
+set -e
+ORDINAL=${POD_NAME##*-}
+SERVER_ID=$((100 + ORDINAL))
+GRASTATE_FILE="/var/lib/mysql/grastate.dat"
+
+if [ "$ORDINAL" = "0" ] && [ ! -f "$GRASTATE_FILE" ]; then
+  WSREP_CLUSTER_ADDRESS="gcomm://"
+  echo %s > /dev/termination-log
+else
+  WSREP_CLUSTER_ADDRESS="gcomm://%s"
+  echo %s > /dev/termination-log
+fi
+
+cat <<EOF > /db-config/galera.cnf
+[mysqld]
+server-id=${SERVER_ID}
+wsrep_on=ON
+wsrep_provider=/usr/lib/galera/libgalera_smm.so
+wsrep_cluster_name=%s
+wsrep_cluster_address=${WSREP_CLUSTER_ADDRESS}
+wsrep_node_name=${POD_NAME}
+wsrep_node_address=${POD_IP}
+wsrep_sst_method=rsync
+binlog_format=ROW
+default_storage_engine=InnoDB
+innodb_autoinc_lock_mode=2
+log_bin=mysql-bin
+gtid_strict_mode=ON
+log_slave_updates=ON
+%sEOF
+`, GaleraBootstrapMessage, clusterMembers, GaleraJoinMessage, stsName, extraOptions)
+}
+
+// buildAppProbes dựng readiness/liveness probe HTTP cho container music-service từ spec.healthCheck
+// Liveness chịu lỗi lâu hơn readiness để pod đang quá tải chỉ bị rút khỏi Service chứ không bị khởi động lại
+// Các ngưỡng được ghi rõ bằng giá trị mặc định của API server để so sánh khi cập nhật không bị lệch
+func buildAppProbes(ms *musicv1.MusicService) (*corev1.Probe, *corev1.Probe) {
+	check := ms.Spec.HealthCheck
+	if check == nil {
+		return nil, nil
+	}
+
+	handler := corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path:   "/healthz",
+			Port:   intstr.FromString("http"),
+			Scheme: corev1.URISchemeHTTP,
+		},
+	}
+	if check.Path != "" {
+		handler.HTTPGet.Path = check.Path
+	}
+	if check.Port != nil {
+		handler.HTTPGet.Port = *check.Port
+	}
+	if check.Scheme != "" {
+		handler.HTTPGet.Scheme = check.Scheme
+	}
+
+	readiness := &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: check.InitialDelaySeconds,
+		PeriodSeconds:       10,
+		TimeoutSeconds:      1,
+		SuccessThreshold:    1,
+		FailureThreshold:    3,
+	}
+	liveness := &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: check.InitialDelaySeconds,
+		PeriodSeconds:       20,
+		TimeoutSeconds:      1,
+		SuccessThreshold:    1,
+		FailureThreshold:    6,
+	}
+	return readiness, liveness
+}
+
+// buildAppStartupProbe dựng startupProbe trên endpoint health khi có spec.healthCheck.startupTimeoutSeconds
+func buildAppStartupProbe(ms *musicv1.MusicService) *corev1.Probe {
+	check := ms.Spec.HealthCheck
+	if check == nil || check.StartupTimeoutSeconds <= 0 {
+		return nil
+	}
+	readiness, _ := buildAppProbes(ms)
+	return startupProbe(readiness.ProbeHandler, check.StartupTimeoutSeconds, 1)
+}
+
+// buildDatabaseStartupProbe dựng startupProbe theo spec.database.probes.handler khi có spec.database.startupTimeoutSeconds
+func buildDatabaseStartupProbe(config databaseConfig) *corev1.Probe {
+	if config.startupTimeout <= 0 {
+		return nil
+	}
+	return startupProbe(databaseProbeHandler(config), config.startupTimeout, 5)
+}
+
+// startupProbe chia thời gian khởi động tối đa thành các lần probe cách nhau 10 giây
+func startupProbe(handler corev1.ProbeHandler, timeoutSeconds, probeTimeout int32) *corev1.Probe {
+	const period = int32(10)
+	return &corev1.Probe{
+		ProbeHandler:     handler,
+		PeriodSeconds:    period,
+		TimeoutSeconds:   probeTimeout,
+		SuccessThreshold: 1,
+		FailureThreshold: (timeoutSeconds + period - 1) / period,
+	}
+}
+
+// volumeClaimSpec dựng spec PVC ReadWriteOnce với kích thước cho trước và các field volumeMode/dataSource/selector
+// của storage, để PVC có thể bind vào PV dựng sẵn hoặc khôi phục từ VolumeSnapshot
+func volumeClaimSpec(storage *musicv1.StorageSpec, size resource.Quantity) corev1.PersistentVolumeClaimSpec {
+	spec := corev1.PersistentVolumeClaimSpec{
+		AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+		},
+	}
+	if storage != nil {
+		spec.VolumeMode = storage.VolumeMode
+		spec.DataSource = storage.DataSource.DeepCopy()
+		spec.Selector = storage.Selector.DeepCopy()
+	}
+	return spec
+}
+
+// volumeClaimMeta dựng metadata của volumeClaimTemplate từ spec.labels/spec.annotations,
+// claimLabels/claimAnnotations của storage ghi đè key trùng
+func volumeClaimMeta(ms *musicv1.MusicService, name string, storage *musicv1.StorageSpec) metav1.ObjectMeta {
+	var claimLabels, claimAnnotations map[string]string
+	if storage != nil {
+		claimLabels = storage.ClaimLabels
+		claimAnnotations = storage.ClaimAnnotations
+	}
+	meta := metav1.ObjectMeta{Name: name, Annotations: getAnnotations(ms, claimAnnotations)}
+	if len(ms.Spec.Labels) > 0 || len(claimLabels) > 0 {
+		meta.Labels = maps.Clone(ms.Spec.Labels)
+		if meta.Labels == nil {
+			meta.Labels = map[string]string{}
+		}
+		maps.Copy(meta.Labels, claimLabels)
+	}
+	return meta
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	defaultMeilisearchImage = "getmeili/meilisearch:v1.8"
+	defaultOpenSearchImage  = "opensearchproject/opensearch:2.13.0"
+
+	// Các key trong Secret thông tin kết nối search engine
+	SearchEngineKey = "engine"
+	SearchURLKey    = "url"
+	SearchAPIKeyKey = "apiKey"
+)
+
+// SearchName trả về tên chung của StatefulSet và Service search engine
+func SearchName(ms *musicv1.MusicService) string {
+	return ms.Name + "-search"
+}
+
+// SearchConnectionSecretName trả về tên Secret chứa endpoint và API key của search engine cho ứng dụng
+func SearchConnectionSecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-search-conn"
+}
+
+// searchPort trả về port HTTP của search engine theo engine
+func searchPort(engine musicv1.SearchEngine) int32 {
+	if engine == musicv1.SearchEngineOpenSearch {
+		return 9200
+	}
+	return 7700
+}
+
+// SearchURL trả về endpoint HTTP mà ứng dụng dùng để truy vấn search engine
+func SearchURL(ms *musicv1.MusicService) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d", SearchName(ms), WorkloadNamespace(ms), searchPort(ms.Spec.Search.Engine))
+}
+
+// SearchUsesAPIKey cho biết search engine có cần API key hay không; OpenSearch tắt security plugin nên không cần
+func SearchUsesAPIKey(ms *musicv1.MusicService) bool {
+	return ms.Spec.Search.Engine == musicv1.SearchEngineMeilisearch
+}
+
+// BuildSearchConnectionSecret xây dựng Secret thông tin kết nối search engine
+// API key (master key của Meilisearch) do reconciler sinh một lần và truyền vào
+func (b *ResourceBuilder) BuildSearchConnectionSecret(ms *musicv1.MusicService, apiKey string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            SearchConnectionSecretName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, "search-conn"),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			SearchEngineKey: []byte(ms.Spec.Search.Engine),
+			SearchURLKey:    []byte(SearchURL(ms)),
+			SearchAPIKeyKey: []byte(apiKey),
+		},
+	}
+}
+
+// BuildSearchService xây dựng Service headless cho search engine
+func (b *ResourceBuilder) BuildSearchService(ms *musicv1.MusicService) *corev1.Service {
+	return b.buildSingleNodeService(ms, SearchName(ms), "search", "http", searchPort(ms.Spec.Search.Engine))
+}
+
+// BuildSearchStatefulSet xây dựng StatefulSet một node của search engine.
+// OpenSearch chạy discovery.type=single-node nên bỏ qua bootstrap check (không cần chỉnh vm.max_map_count trên node)
+func (b *ResourceBuilder) BuildSearchStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	search := ms.Spec.Search
+	port := searchPort(search.Engine)
+
+	var resources corev1.ResourceRequirements
+	if search.Resources != nil {
+		resources = *search.Resources.DeepCopy()
+	}
+
+	container := corev1.Container{
+		Name:      "search",
+		Image:     search.Image,
+		Resources: resources,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "http",
+				ContainerPort: port,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+	}
+	podSpec := corev1.PodSpec{ImagePullSecrets: buildImagePullSecrets(ms)}
+
+	switch search.Engine {
+	case musicv1.SearchEngineOpenSearch:
+		if container.Image == "" {
+			container.Image = defaultOpenSearchImage
+		}
+		container.Env = []corev1.EnvVar{
+			{Name: "discovery.type", Value: "single-node"},
+			{Name: "DISABLE_SECURITY_PLUGIN", Value: "true"},
+			{Name: "DISABLE_INSTALL_DEMO_CONFIG", Value: "true"},
+			{Name: "OPENSEARCH_JAVA_OPTS", Value: "-Xms512m -Xmx512m"},
+		}
+		container.VolumeMounts = []corev1.VolumeMount{{Name: "search-data", MountPath: "/usr/share/opensearch/data"}}
+		container.ReadinessProbe = searchReadinessProbe("/_cluster/health?local=true", port)
+		// Image OpenSearch chạy bằng uid 1000, cần quyền ghi vào volume index
+		fsGroup := int64(1000)
+		podSpec.SecurityContext = &corev1.PodSecurityContext{FSGroup: &fsGroup}
+	default:
+		if container.Image == "" {
+			container.Image = defaultMeilisearchImage
+		}
+		container.Env = []corev1.EnvVar{
+			{Name: "MEILI_ENV", Value: "production"},
+			{Name: "MEILI_DB_PATH", Value: "/meili_data/data.ms"},
+			{
+				Name: "MEILI_MASTER_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: SearchConnectionSecretName(ms)},
+						Key:                  SearchAPIKeyKey,
+					},
+				},
+			},
+		}
+		container.VolumeMounts = []corev1.VolumeMount{{Name: "search-data", MountPath: "/meili_data"}}
+		container.ReadinessProbe = searchReadinessProbe("/health", port)
+	}
+	podSpec.Containers = []corev1.Container{container}
+
+	return b.buildSingleNodeStatefulSet(ms, SearchName(ms), "search", podSpec, search.Storage)
+}
+
+// searchReadinessProbe dựng readiness probe HTTP; các field mặc định được đặt rõ để so sánh khớp với pod template hiện tại
+func searchReadinessProbe(path string, port int32) *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   path,
+				Port:   intstr.FromInt32(port),
+				Scheme: corev1.URISchemeHTTP,
+			},
+		},
+		InitialDelaySeconds: 10,
+		PeriodSeconds:       10,
+		TimeoutSeconds:      5,
+		SuccessThreshold:    1,
+		FailureThreshold:    3,
+	}
+}
+
+// buildServiceConnectionEnv trả về biến môi trường kết nối tới broker, search engine, Redis lưu phiên
+// và MinIO cho container ứng dụng
+func buildServiceConnectionEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	env := append(buildQueueConnectionEnv(ms), buildSearchConnectionEnv(ms)...)
+	env = append(env, buildSessionStoreConnectionEnv(ms)...)
+	return append(env, buildObjectStorageConnectionEnv(ms)...)
+}
+
+// buildSearchConnectionEnv trả về biến môi trường SEARCH_* cho container ứng dụng khi có spec.search;
+// SEARCH_API_KEY là optional vì OpenSearch không có API key
+func buildSearchConnectionEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	if ms.Spec.Search == nil {
+		return nil
+	}
+
+	secretName := SearchConnectionSecretName(ms)
+	optional := true
+	return []corev1.EnvVar{
+		{
+			Name: "SEARCH_ENGINE",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  SearchEngineKey,
+				},
+			},
+		},
+		{
+			Name: "SEARCH_URL",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  SearchURLKey,
+				},
+			},
+		},
+		{
+			Name: "SEARCH_API_KEY",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  SearchAPIKeyKey,
+					Optional:             &optional,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"path"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// SeedHashAnnotation ghi mã băm spec.seed mà Job nạp nội dung đã áp dụng
+	SeedHashAnnotation = "music.mixcorp.org/seed-hash"
+
+	seedHTTPImage   = "curlimages/curl:8.10.1"
+	seedS3Image     = "amazon/aws-cli:2.17.0"
+	seedOCIImage    = "ghcr.io/oras-project/oras:v1.2.0"
+	seedMarkerImage = "busybox:1.36"
+
+	// seedMarkerFile được ghi sau khi mọi nguồn đã nạp xong, chứa mã băm spec.seed
+	seedMarkerFile = "/data/.seed-hash"
+
+	defaultSeedInitMarkerFile = ".seeded"
+)
+
+// SeedHash trả về mã băm ngắn của spec.seed, dùng để phát hiện thay đổi nguồn
+func SeedHash(ms *musicv1.MusicService) string {
+	if ms.Spec.Seed == nil {
+		return ""
+	}
+	data, _ := json.Marshal(ms.Spec.Seed)
+	h := fnv.New32a()
+	_, _ = h.Write(data)
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// SeedJobName trả về tên Job nạp nội dung cho pod ứng dụng theo ordinal
+func SeedJobName(ms *musicv1.MusicService, ordinal int32) string {
+	return fmt.Sprintf("%s-seed-%d", ms.Name, ordinal)
+}
+
+// BuildSeedJob xây dựng Job nạp nội dung từ spec.seed vào PVC music-data của pod ứng dụng theo ordinal
+// PVC là ReadWriteOnce nên Job được xếp cùng node với pod tương ứng
+func (b *ResourceBuilder) BuildSeedJob(ms *musicv1.MusicService, ordinal int32) *batchv1.Job {
+	labels := b.getLabels(ms, "seed")
+	seed := ms.Spec.Seed
+	hash := SeedHash(ms)
+	backoffLimit := int32(3)
+
+	// Chỉ nguồn S3 bật objectStorage mới đọc từ MinIO trong cluster, bằng endpoint và Secret của MinIO;
+	// các nguồn khác giữ endpoint mặc định và CredentialsSecret để không làm hỏng AWS/IRSA
+	initContainers := make([]corev1.Container, 0, len(seed.Sources))
+	for i, source := range seed.Sources {
+		credentialsSecret := seed.CredentialsSecret
+		if source.ObjectStorage && source.Type == musicv1.SeedSourceS3 && ms.Spec.ObjectStorage != nil {
+			source.Endpoint = ObjectStorageEndpoint(ms)
+			credentialsSecret = ObjectStorageSecretName(ms)
+		}
+		initContainers = append(initContainers, buildSeedContainer(fmt.Sprintf("seed-%d", i), source, credentialsSecret))
+	}
+
+	podName := fmt.Sprintf("%s-%d", ms.Name, ordinal)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SeedJobName(ms, ordinal),
+			Namespace: WorkloadNamespace(ms),
+			Labels:    labels,
+			Annotations: map[string]string{
+				SeedHashAnnotation: hash,
+			},
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:  corev1.RestartPolicyOnFailure,
+					InitContainers: initContainers,
+					Containers: []corev1.Container{
+						{
+							Name:    "mark-seeded",
+							Image:   seedMarkerImage,
+							Command: []string{"/bin/sh", "-c", fmt.Sprintf("echo \"$SEED_HASH\" > %s", seedMarkerFile)},
+							Env: []corev1.EnvVar{
+								{Name: "SEED_HASH", Value: hash},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "music-data", MountPath: "/data"},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "music-data",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "music-data-" + podName,
+								},
+							},
+						},
+					},
+					Affinity: &corev1.Affinity{
+						PodAffinity: &corev1.PodAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											"app":                                ms.Name,
+											"component":                          "music-service",
+											"statefulset.kubernetes.io/pod-name": podName,
+										},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	b.applyImageOverrides(&job.Spec.Template.Spec)
+	return job
+}
+
+// buildSeedContainer dựng container tải một nguồn vào /data; URL được truyền qua biến môi trường
+// thay vì nội suy vào script để tránh shell injection
+func buildSeedContainer(name string, source musicv1.SeedSource, credentialsSecret string) corev1.Container {
+	dest := "/data"
+	if source.Path != "" {
+		dest = path.Join("/data", source.Path)
+	}
+
+	var image, script string
+	switch source.Type {
+	case musicv1.SeedSourceS3:
+		image = seedS3Image
+		script = `set -e
+mkdir -p "$SEED_DEST"
+aws s3 sync "$SEED_URL" "$SEED_DEST" ${SEED_ENDPOINT:+--endpoint-url "$SEED_ENDPOINT"}`
+	case musicv1.SeedSourceOCI:
+		image = seedOCIImage
+		script = `set -e
+mkdir -p "$SEED_DEST"
+oras pull "$SEED_URL" -o "$SEED_DEST" ${ORAS_USERNAME:+-u "$ORAS_USERNAME" -p "$ORAS_PASSWORD"}`
+	default:
+		image = seedHTTPImage
+		script = `set -e
+mkdir -p "$SEED_DEST"
+case "$SEED_URL" in
+  *.tar.gz|*.tgz) curl -fsSL "$SEED_URL" | tar -xz -C "$SEED_DEST" ;;
+  *) curl -fsSL -o "$SEED_DEST/$(basename "${SEED_URL%%\?*}")" "$SEED_URL" ;;
+esac`
+	}
+
+	container := corev1.Container{
+		Name:    name,
+		Image:   image,
+		Command: []string{"/bin/sh", "-c", script},
+		Env: []corev1.EnvVar{
+			{Name: "SEED_URL", Value: source.URL},
+			{Name: "SEED_DEST", Value: dest},
+			{Name: "SEED_ENDPOINT", Value: source.Endpoint},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "music-data", MountPath: "/data"},
+		},
+	}
+
+	if credentialsSecret != "" {
+		container.EnvFrom = []corev1.EnvFromSource{
+			{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: credentialsSecret},
+				},
+			},
+		}
+	}
+
+	return container
+}
+
+// buildSeedInitContainers dựng init container nạp catalog từ spec.seedInitContainer.
+// Lệnh của người dùng chỉ chạy khi chưa có file đánh dấu, nên pod khởi động lại không tải lại dữ liệu
+func buildSeedInitContainers(ms *musicv1.MusicService) []corev1.Container {
+	seedInit := ms.Spec.SeedInitContainer
+	if seedInit == nil {
+		return nil
+	}
+
+	marker := defaultSeedInitMarkerFile
+	if seedInit.MarkerFile != "" {
+		marker = seedInit.MarkerFile
+	}
+
+	// Lệnh gốc được truyền qua "$@" để giữ nguyên từng đối số mà không cần escape
+	script := `set -e
+if [ -f "/data/$SEED_MARKER" ]; then
+  echo "catalog already seeded, skipping"
+  exit 0
+fi
+"$@"
+touch "/data/$SEED_MARKER"`
+
+	container := corev1.Container{
+		Name:            "seed-catalog",
+		Image:           seedInit.Image,
+		ImagePullPolicy: ms.Spec.ImagePullPolicy,
+		Command:         append([]string{"/bin/sh", "-c", script, "seed-catalog"}, seedInit.Command...),
+		Env:             append([]corev1.EnvVar{{Name: "SEED_MARKER", Value: marker}}, seedInit.Env...),
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "music-data", MountPath: "/data"},
+		},
+	}
+	if seedInit.Resources != nil {
+		container.Resources = *seedInit.Resources
+	}
+
+	return []corev1.Container{container}
+}
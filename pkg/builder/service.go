@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// TopologyModeAnnotation là annotation Topology Aware Routing dùng cho cluster chưa hỗ trợ trafficDistribution
+const TopologyModeAnnotation = "service.kubernetes.io/topology-mode"
+
+// applyServiceRouting áp dụng spec.service lên các Service phục vụ lưu lượng đọc/streaming
+func applyServiceRouting(ms *musicv1.MusicService, svc *corev1.Service) {
+	if ms.Spec.Service == nil {
+		return
+	}
+
+	switch ms.Spec.Service.TopologyRouting {
+	case musicv1.TopologyRoutingPreferClose:
+		distribution := corev1.ServiceTrafficDistributionPreferClose
+		svc.Spec.TrafficDistribution = &distribution
+	case musicv1.TopologyRoutingAuto:
+		if svc.Annotations == nil {
+			svc.Annotations = map[string]string{}
+		}
+		svc.Annotations[TopologyModeAnnotation] = "Auto"
+	}
+}
+
+// applyServiceTrafficPolicy áp dụng kiểu Service và traffic policy của spec.service lên Service ứng dụng.
+// Giá trị mặc định được ghi tường minh để so sánh khớp với giá trị API server tự điền
+func applyServiceTrafficPolicy(ms *musicv1.MusicService, svc *corev1.Service) {
+	internalPolicy := corev1.ServiceInternalTrafficPolicyCluster
+	externalPolicy := corev1.ServiceExternalTrafficPolicyCluster
+
+	if opts := ms.Spec.Service; opts != nil {
+		if opts.Type != "" {
+			svc.Spec.Type = opts.Type
+		}
+		if opts.InternalTrafficPolicy != "" {
+			internalPolicy = opts.InternalTrafficPolicy
+		}
+		if opts.ExternalTrafficPolicy != "" {
+			externalPolicy = opts.ExternalTrafficPolicy
+		}
+	}
+
+	svc.Spec.InternalTrafficPolicy = &internalPolicy
+	// externalTrafficPolicy chỉ hợp lệ với Service NodePort hoặc LoadBalancer
+	if svc.Spec.Type != corev1.ServiceTypeClusterIP {
+		svc.Spec.ExternalTrafficPolicy = externalPolicy
+	}
+}
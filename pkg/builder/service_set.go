@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// ServiceSetLabel là nhãn trên MusicService con chứa tên MusicServiceSet sinh ra nó
+	ServiceSetLabel = "music.mixcorp.org/service-set"
+	// ServiceSetTenantLabel là nhãn trên MusicService con chứa tên tenant
+	ServiceSetTenantLabel = "music.mixcorp.org/tenant"
+	// ServiceSetHashAnnotation là hash của spec mong muốn; MusicService con chỉ được ghi lại khi hash đổi,
+	// tránh so sánh với các field mà API server tự điền giá trị mặc định
+	ServiceSetHashAnnotation = "music.mixcorp.org/service-set-hash"
+	// ServiceSetKeysAnnotation liệt kê các key label và annotation do set đặt lên MusicService con, để lần cập nhật
+	// sau chỉ xóa key bị bỏ khỏi template và giữ key do người dùng thêm (ví dụ annotation xoay vòng credentials)
+	ServiceSetKeysAnnotation = "music.mixcorp.org/service-set-keys"
+)
+
+// serviceSetKeys là nội dung JSON của ServiceSetKeysAnnotation
+type serviceSetKeys struct {
+	Labels      []string `json:"labels,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// ServiceSetMemberName trả về tên MusicService con của một tenant
+func ServiceSetMemberName(set *musicv1.MusicServiceSet, tenant string) string {
+	return set.Name + "-" + tenant
+}
+
+// BuildServiceSetMember dựng MusicService con của tenant từ template của set, áp dụng overrides theo
+// ngữ nghĩa JSON merge patch (RFC 7386); overrides không hợp lệ hoặc không khớp schema trả về lỗi
+func (b *ResourceBuilder) BuildServiceSetMember(set *musicv1.MusicServiceSet, tenant *musicv1.MusicServiceSetTenant) (*musicv1.MusicService, error) {
+	spec := *set.Spec.Template.Spec.DeepCopy()
+	if tenant.Overrides != nil && len(tenant.Overrides.Raw) > 0 {
+		original, err := json.Marshal(spec)
+		if err != nil {
+			return nil, err
+		}
+		patched, err := jsonpatch.MergePatch(original, tenant.Overrides.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("tenant %s: invalid overrides: %w", tenant.Name, err)
+		}
+		spec = musicv1.MusicServiceSpec{}
+		decoder := json.NewDecoder(bytes.NewReader(patched))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&spec); err != nil {
+			return nil, fmt.Errorf("tenant %s: overrides do not match the MusicService spec: %w", tenant.Name, err)
+		}
+	}
+
+	labels := maps.Clone(set.Spec.Template.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, tenant.Labels)
+	labels[ServiceSetLabel] = set.Name
+	labels[ServiceSetTenantLabel] = tenant.Name
+
+	annotations := maps.Clone(set.Spec.Template.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	ms := &musicv1.MusicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ServiceSetMemberName(set, tenant.Name),
+			Namespace:   set.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: spec,
+	}
+
+	hash, err := serviceSetMemberHash(ms)
+	if err != nil {
+		return nil, err
+	}
+	annotations[ServiceSetHashAnnotation] = hash
+	keys, err := json.Marshal(serviceSetKeys{Labels: sortedKeys(labels), Annotations: sortedKeys(annotations)})
+	if err != nil {
+		return nil, err
+	}
+	annotations[ServiceSetKeysAnnotation] = string(keys)
+	return ms, nil
+}
+
+// ServiceSetOwnedKeys trả về các key label và annotation mà set đã đặt lên MusicService con ở lần ghi trước;
+// member tạo trước khi có ServiceSetKeysAnnotation không có key nào được coi là của set
+func ServiceSetOwnedKeys(ms *musicv1.MusicService) (labels, annotations []string) {
+	var keys serviceSetKeys
+	if err := json.Unmarshal([]byte(ms.Annotations[ServiceSetKeysAnnotation]), &keys); err != nil {
+		return nil, nil
+	}
+	return keys.Labels, keys.Annotations
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// serviceSetMemberHash băm spec, labels và annotations mong muốn của MusicService con
+func serviceSetMemberHash(ms *musicv1.MusicService) (string, error) {
+	data, err := json.Marshal(struct {
+		Labels      map[string]string        `json:"labels"`
+		Annotations map[string]string        `json:"annotations"`
+		Spec        musicv1.MusicServiceSpec `json:"spec"`
+	}{ms.Labels, ms.Annotations, ms.Spec})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
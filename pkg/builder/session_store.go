@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"net/url"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	defaultRedisImage = "redis:7.2-alpine"
+	sessionStorePort  = int32(6379)
+)
+
+// SessionStoreName trả về tên chung của StatefulSet và Service Redis lưu phiên
+func SessionStoreName(ms *musicv1.MusicService) string {
+	return ms.Name + "-session"
+}
+
+// SessionStoreConnectionSecretName trả về tên Secret chứa thông tin kết nối Redis lưu phiên cho ứng dụng
+func SessionStoreConnectionSecretName(ms *musicv1.MusicService) string {
+	return ms.Name + "-session-conn"
+}
+
+// BuildSessionStoreConnectionSecret xây dựng Secret thông tin kết nối Redis lưu phiên
+// Mật khẩu do reconciler sinh một lần và truyền vào để không bị thay đổi giữa các lần reconcile
+func (b *ResourceBuilder) BuildSessionStoreConnectionSecret(ms *musicv1.MusicService, password string) *corev1.Secret {
+	host := fmt.Sprintf("%s.%s.svc", SessionStoreName(ms), WorkloadNamespace(ms))
+	redisURL := url.URL{
+		Scheme: "redis",
+		User:   url.UserPassword("", password),
+		Host:   fmt.Sprintf("%s:%d", host, sessionStorePort),
+		Path:   "/0",
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            SessionStoreConnectionSecretName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          b.getLabels(ms, "session-conn"),
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			ConnectionHostKey:     []byte(host),
+			ConnectionPortKey:     []byte(fmt.Sprintf("%d", sessionStorePort)),
+			ConnectionPasswordKey: []byte(password),
+			ConnectionURLKey:      []byte(redisURL.String()),
+		},
+	}
+}
+
+// BuildSessionStoreService xây dựng Service headless cho Redis lưu phiên
+func (b *ResourceBuilder) BuildSessionStoreService(ms *musicv1.MusicService) *corev1.Service {
+	return b.buildSingleNodeService(ms, SessionStoreName(ms), "session", "redis", sessionStorePort)
+}
+
+// BuildSessionStoreStatefulSet xây dựng StatefulSet một node của Redis lưu phiên.
+// Redis bật appendonly (fsync mỗi giây) để phiên đăng nhập còn sau khi khởi động lại, và yêu cầu mật khẩu
+// lấy từ Secret kết nối
+func (b *ResourceBuilder) BuildSessionStoreStatefulSet(ms *musicv1.MusicService) *appsv1.StatefulSet {
+	session := ms.Spec.SessionStore
+
+	image := session.Image
+	if image == "" {
+		image = defaultRedisImage
+	}
+	var resources corev1.ResourceRequirements
+	if session.Resources != nil {
+		resources = *session.Resources.DeepCopy()
+	}
+
+	podSpec := corev1.PodSpec{
+		ImagePullSecrets: buildImagePullSecrets(ms),
+		Containers: []corev1.Container{
+			{
+				Name:      "redis",
+				Image:     image,
+				Resources: resources,
+				Command:   []string{"redis-server"},
+				Args: []string{
+					"--appendonly", "yes",
+					"--appendfsync", "everysec",
+					"--dir", "/data",
+					"--requirepass", "$(REDIS_PASSWORD)",
+				},
+				Env: []corev1.EnvVar{
+					{
+						Name: "REDIS_PASSWORD",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: SessionStoreConnectionSecretName(ms)},
+								Key:                  ConnectionPasswordKey,
+							},
+						},
+					},
+				},
+				Ports: []corev1.ContainerPort{
+					{
+						Name:          "redis",
+						ContainerPort: sessionStorePort,
+						Protocol:      corev1.ProtocolTCP,
+					},
+				},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						Exec: &corev1.ExecAction{
+							Command: []string{"/bin/sh", "-c", `redis-cli -a "$REDIS_PASSWORD" --no-auth-warning ping | grep -q PONG`},
+						},
+					},
+					InitialDelaySeconds: 5,
+					PeriodSeconds:       10,
+					TimeoutSeconds:      5,
+					SuccessThreshold:    1,
+					FailureThreshold:    3,
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "session-data", MountPath: "/data"}},
+			},
+		},
+	}
+
+	return b.buildSingleNodeStatefulSet(ms, SessionStoreName(ms), "session", podSpec, session.Storage)
+}
+
+// buildSessionStoreConnectionEnv trả về biến môi trường SESSION_STORE_* cho container ứng dụng khi có spec.sessionStore
+func buildSessionStoreConnectionEnv(ms *musicv1.MusicService) []corev1.EnvVar {
+	if ms.Spec.SessionStore == nil {
+		return nil
+	}
+
+	secretName := SessionStoreConnectionSecretName(ms)
+	keys := []struct{ env, key string }{
+		{"SESSION_STORE_HOST", ConnectionHostKey},
+		{"SESSION_STORE_PORT", ConnectionPortKey},
+		{"SESSION_STORE_PASSWORD", ConnectionPasswordKey},
+		{"SESSION_STORE_URL", ConnectionURLKey},
+	}
+
+	env := make([]corev1.EnvVar, 0, len(keys))
+	for _, k := range keys {
+		env = append(env, corev1.EnvVar{
+			Name: k.env,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  k.key,
+				},
+			},
+		})
+	}
+	return env
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// ShardLabel là nhãn tên shard trên StatefulSet, Service, HPA và pod của shard
+const ShardLabel = "music.mixcorp.org/shard"
+
+// ShardsEnabled cho biết ứng dụng chạy theo spec.shards thay vì một StatefulSet duy nhất
+func ShardsEnabled(ms *musicv1.MusicService) bool {
+	return len(ms.Spec.Shards) > 0
+}
+
+// ShardName trả về tên StatefulSet và Service của shard
+func ShardName(ms *musicv1.MusicService, shard string) string {
+	return ms.Name + "-" + shard
+}
+
+// ShardAutoscalerName trả về tên HPA của shard
+func ShardAutoscalerName(ms *musicv1.MusicService, shard string) string {
+	return ShardName(ms, shard) + "-autoscaler"
+}
+
+// shardLabels thêm nhãn shard vào bộ nhãn của tài nguyên
+func shardLabels(labels map[string]string, shard string) map[string]string {
+	labels[ShardLabel] = shard
+	return labels
+}
+
+// BuildAppShardStatefulSet xây dựng StatefulSet của shard thứ index từ pod template của StatefulSet ứng dụng;
+// selector có thêm nhãn shard và container music-service nhận SHARD_NAME, SHARD_INDEX, SHARD_COUNT
+// cùng các biến PARTITION_* khi có spec.partitioning
+func (b *ResourceBuilder) BuildAppShardStatefulSet(ms *musicv1.MusicService, index int) *appsv1.StatefulSet {
+	shard := ms.Spec.Shards[index]
+	name := ShardName(ms, shard.Name)
+	replicas := shard.Replicas
+
+	sts := b.BuildAppStatefulSet(ms)
+	sts.Name = name
+	sts.Labels = shardLabels(sts.Labels, shard.Name)
+	sts.Spec.Replicas = &replicas
+	sts.Spec.ServiceName = name
+	podLabels := map[string]string{
+		"app":       ms.Name,
+		"component": "music-service",
+		ShardLabel:  shard.Name,
+	}
+	sts.Spec.Selector = &metav1.LabelSelector{MatchLabels: podLabels}
+	sts.Spec.Template.Labels = podLabels
+
+	for i := range sts.Spec.Template.Spec.Containers {
+		container := &sts.Spec.Template.Spec.Containers[i]
+		if container.Name != "music-service" {
+			continue
+		}
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: "SHARD_NAME", Value: shard.Name},
+			corev1.EnvVar{Name: "SHARD_INDEX", Value: strconv.Itoa(index)},
+			corev1.EnvVar{Name: "SHARD_COUNT", Value: strconv.Itoa(len(ms.Spec.Shards))},
+		)
+		container.Env = append(container.Env, shardPartitionEnv(BuildPartitioningStatus(ms), index)...)
+	}
+	return sts
+}
+
+// BuildAppShardService xây dựng Service chỉ trỏ tới pod của shard thứ index, cùng cổng với Service ứng dụng
+func (b *ResourceBuilder) BuildAppShardService(ms *musicv1.MusicService, index int) *corev1.Service {
+	shard := ms.Spec.Shards[index]
+
+	svc := b.BuildAppService(ms)
+	svc.Name = ShardName(ms, shard.Name)
+	svc.Labels = shardLabels(svc.Labels, shard.Name)
+	svc.Spec.Selector = shardLabels(svc.Spec.Selector, shard.Name)
+	return svc
+}
+
+// BuildAppShardAutoscaler xây dựng HorizontalPodAutoscaler cho StatefulSet của shard thứ index
+func (b *ResourceBuilder) BuildAppShardAutoscaler(ms *musicv1.MusicService, index int) *autoscalingv2.HorizontalPodAutoscaler {
+	shard := ms.Spec.Shards[index]
+	labels := shardLabels(b.getLabels(ms, "autoscaler"), shard.Name)
+	autoscaling := shard.Autoscaling
+	metrics := []autoscalingv2.MetricSpec{
+		buildResourceMetric(corev1.ResourceCPU, autoscaling.TargetCPUUtilizationPercentage),
+	}
+
+	if autoscaling.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, buildResourceMetric(corev1.ResourceMemory, *autoscaling.TargetMemoryUtilizationPercentage))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ShardAutoscalerName(ms, shard.Name),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       ShardName(ms, shard.Name),
+			},
+			MinReplicas: &autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// AppSharedStorage cho biết mọi replica ứng dụng dùng chung một PVC music-data ReadWriteMany
+func AppSharedStorage(ms *musicv1.MusicService) bool {
+	return ms.Spec.Storage != nil && ms.Spec.Storage.AccessMode == musicv1.StorageAccessModeReadWriteMany
+}
+
+// SharedDataPVCName trả về tên PVC music-data dùng chung; cùng tiền tố với PVC theo ordinal
+// nên cảnh báo và trạng thái resize của music-data vẫn bao gồm PVC này
+func SharedDataPVCName(ms *musicv1.MusicService) string {
+	return "music-data-" + ms.Name + "-shared"
+}
+
+// BuildAppSharedPVC xây dựng PVC music-data ReadWriteMany dùng chung cho mọi replica
+// PVC không có owner reference, giống PVC của volumeClaimTemplates, để dữ liệu còn lại khi xóa MusicService
+func (b *ResourceBuilder) BuildAppSharedPVC(ms *musicv1.MusicService) *corev1.PersistentVolumeClaim {
+	storage := ms.Spec.Storage
+	meta := volumeClaimMeta(ms, SharedDataPVCName(ms), storage)
+	meta.Namespace = WorkloadNamespace(ms)
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	meta.Labels["app"] = ms.Name
+	meta.Labels["component"] = "music-service"
+
+	spec := volumeClaimSpec(storage, parseQuantity(storage.Size))
+	spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+	spec.StorageClassName = storage.StorageClassName
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: meta,
+		Spec:       spec,
+	}
+}
+
+// sharedDataVolume trả về volume music-data trỏ vào PVC dùng chung
+func sharedDataVolume(ms *musicv1.MusicService) corev1.Volume {
+	return corev1.Volume{
+		Name: "music-data",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: SharedDataPVCName(ms)},
+		},
+	}
+}
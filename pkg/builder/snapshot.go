@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// VolumeSnapshotGVK là kind VolumeSnapshot của external-snapshotter; dùng unstructured để operator
+// không phụ thuộc vào client của CRD này
+var VolumeSnapshotGVK = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshot"}
+
+// SnapshotsBeforeRecreate cho biết cần chụp snapshot PVC trước khi updatePolicy Recreate xóa chúng
+func SnapshotsBeforeRecreate(ms *musicv1.MusicService) bool {
+	return ms.Spec.Snapshots != nil && ms.Spec.Snapshots.BeforeRecreate
+}
+
+// VolumeSnapshotClassName trả về VolumeSnapshotClass cho PVC của storage: ưu tiên override của thành phần,
+// sau đó spec.snapshots.volumeSnapshotClassName, nil để dùng class mặc định của cluster
+func VolumeSnapshotClassName(ms *musicv1.MusicService, storage *musicv1.StorageSpec) *string {
+	if storage != nil && storage.VolumeSnapshotClassName != nil {
+		return storage.VolumeSnapshotClassName
+	}
+	if ms.Spec.Snapshots != nil {
+		return ms.Spec.Snapshots.VolumeSnapshotClassName
+	}
+	return nil
+}
+
+// PVCSnapshotName trả về tên VolumeSnapshot của một PVC; gắn UID của PVC để PVC tạo lại nhận snapshot mới
+func PVCSnapshotName(pvc *corev1.PersistentVolumeClaim) string {
+	uid := string(pvc.UID)
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	return pvc.Name + "-" + uid
+}
+
+// BuildPVCSnapshot xây dựng VolumeSnapshot cho một PVC của MusicService
+func (b *ResourceBuilder) BuildPVCSnapshot(ms *musicv1.MusicService, pvc *corev1.PersistentVolumeClaim, className *string) *unstructured.Unstructured {
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetGroupVersionKind(VolumeSnapshotGVK)
+	snapshot.SetName(PVCSnapshotName(pvc))
+	snapshot.SetNamespace(pvc.Namespace)
+	snapshot.SetLabels(b.getLabels(ms, "snapshot"))
+
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvc.Name,
+		},
+	}
+	if className != nil {
+		spec["volumeSnapshotClassName"] = *className
+	}
+	snapshot.Object["spec"] = spec
+	return snapshot
+}
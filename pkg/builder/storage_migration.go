@@ -0,0 +1,235 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// Các bước Job của quá trình co nhỏ music-data
+const (
+	StorageMigrationBackup  = "backup"
+	StorageMigrationRestore = "restore"
+	StorageMigrationVerify  = "verify"
+	StorageMigrationCopy    = "copy"
+
+	storageMigrationImage = "busybox:1.36"
+)
+
+// StorageMigrationName trả về tên PVC trung gian giữ bản sao music-data trong lúc co nhỏ
+func StorageMigrationName(ms *musicv1.MusicService) string {
+	return ms.Name + "-storage-migration"
+}
+
+// StorageMigrationJobName trả về tên Job của một bước co nhỏ
+func StorageMigrationJobName(ms *musicv1.MusicService, step string) string {
+	return StorageMigrationName(ms) + "-" + step
+}
+
+// AppDataPVCName trả về tên PVC music-data mà StatefulSet ứng dụng dùng cho pod theo ordinal
+func AppDataPVCName(ms *musicv1.MusicService, ordinal int32) string {
+	return fmt.Sprintf("music-data-%s-%d", ms.Name, ordinal)
+}
+
+// BuildStorageMigrationPVC xây dựng PVC trung gian đủ chứa dữ liệu của mọi pod ở kích thước mới
+func (b *ResourceBuilder) BuildStorageMigrationPVC(ms *musicv1.MusicService, targetSize resource.Quantity, replicas int32) *corev1.PersistentVolumeClaim {
+	labels := b.getLabels(ms, "storage-migration")
+	size := resource.NewQuantity(targetSize.Value()*int64(replicas), resource.BinarySI)
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            StorageMigrationName(ms),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: *size},
+			},
+		},
+	}
+}
+
+// BuildAppDataPVC xây dựng PVC music-data theo ordinal với kích thước mới
+// PVC được tạo trước StatefulSet nên StatefulSet sẽ dùng lại theo tên thay vì tạo PVC rỗng
+func (b *ResourceBuilder) BuildAppDataPVC(ms *musicv1.MusicService, ordinal int32, size resource.Quantity) *corev1.PersistentVolumeClaim {
+	meta := volumeClaimMeta(ms, AppDataPVCName(ms, ordinal), ms.Spec.Storage)
+	meta.Namespace = WorkloadNamespace(ms)
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	meta.Labels["app"] = ms.Name
+	meta.Labels["component"] = "music-service"
+
+	// Dữ liệu được chép vào từ bản sao lưu hoặc PVC dùng chung nên không khôi phục từ dataSource
+	spec := volumeClaimSpec(ms.Spec.Storage, size)
+	spec.DataSource = nil
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: meta,
+		Spec:       spec,
+	}
+}
+
+// BuildStorageMigrationJob xây dựng Job cho một bước co nhỏ; Job mount mọi PVC music-data cùng PVC trung gian
+// - backup: chép /data-N vào /staging/N và ghi danh sách md5 của từng file
+// - restore: chép /staging/N về PVC mới
+// - verify: kiểm tra md5 trên PVC mới khớp với danh sách đã ghi lúc sao lưu
+// - copy: khi đổi accessMode, gộp /data-N vào PVC dùng chung /shared (file đã có không bị ghi đè),
+// hoặc làm rỗng /data-N rồi chép /shared vào từng PVC riêng
+func (b *ResourceBuilder) BuildStorageMigrationJob(ms *musicv1.MusicService, step string, replicas int32) *batchv1.Job {
+	labels := b.getLabels(ms, "storage-migration")
+	backoffLimit := int32(2)
+
+	var script string
+	switch step {
+	case StorageMigrationBackup:
+		script = `set -e
+i=0
+while [ "$i" -lt "$REPLICAS" ]; do
+  rm -rf "/staging/$i" && mkdir -p "/staging/$i"
+  cp -a "/data-$i/." "/staging/$i/"
+  (cd "/data-$i" && find . -type f -exec md5sum {} + | sort -k 2) > "/staging/$i.md5"
+  i=$((i + 1))
+done`
+	case StorageMigrationRestore:
+		script = `set -e
+i=0
+while [ "$i" -lt "$REPLICAS" ]; do
+  cp -a "/staging/$i/." "/data-$i/"
+  i=$((i + 1))
+done`
+	case StorageMigrationCopy:
+		if AppSharedStorage(ms) {
+			script = `set -e
+i=0
+while [ "$i" -lt "$REPLICAS" ]; do
+  cp -a -n "/data-$i/." /shared/
+  i=$((i + 1))
+done`
+		} else {
+			script = `set -e
+i=0
+while [ "$i" -lt "$REPLICAS" ]; do
+  find "/data-$i" -mindepth 1 -delete
+  cp -a /shared/. "/data-$i/"
+  i=$((i + 1))
+done`
+		}
+	default:
+		script = `set -e
+i=0
+while [ "$i" -lt "$REPLICAS" ]; do
+  (cd "/data-$i" && md5sum -c "/staging/$i.md5" > /dev/null)
+  echo "volume $i verified"
+  i=$((i + 1))
+done`
+	}
+
+	// Nguồn chỉ đọc: PVC theo ordinal khi sao lưu hoặc gộp vào PVC chung, PVC chung khi tách ra
+	toShared := step == StorageMigrationCopy && AppSharedStorage(ms)
+	dataReadOnly := step == StorageMigrationBackup || toShared
+	dataMountReadOnly := (step != StorageMigrationRestore && step != StorageMigrationCopy) || toShared
+
+	volumes := []corev1.Volume{
+		{
+			Name: "staging",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: StorageMigrationName(ms)},
+			},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: "staging", MountPath: "/staging"},
+	}
+	if step == StorageMigrationCopy {
+		volumes = []corev1.Volume{
+			{
+				Name: "shared",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: SharedDataPVCName(ms),
+						ReadOnly:  !toShared,
+					},
+				},
+			},
+		}
+		mounts = []corev1.VolumeMount{
+			{Name: "shared", MountPath: "/shared", ReadOnly: !toShared},
+		}
+	}
+	for i := int32(0); i < replicas; i++ {
+		name := fmt.Sprintf("data-%d", i)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: AppDataPVCName(ms, i),
+					ReadOnly:  dataReadOnly,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: "/" + name,
+			ReadOnly:  dataMountReadOnly,
+		})
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            StorageMigrationJobName(ms, step),
+			Namespace:       WorkloadNamespace(ms),
+			Labels:          labels,
+			OwnerReferences: b.OwnerReferences(ms),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "storage-" + step,
+							Image:   storageMigrationImage,
+							Command: []string{"/bin/sh", "-c", script},
+							Env: []corev1.EnvVar{
+								{Name: "REPLICAS", Value: fmt.Sprintf("%d", replicas)},
+							},
+							VolumeMounts: mounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+	b.applyImageOverrides(&job.Spec.Template.Spec)
+	return job
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// TenantOwnerNamespaceLabel và TenantOwnerNameLabel trỏ ngược về MusicService quản lý tài nguyên
+	// trong namespace tenant, vì OwnerReference không được phép khác namespace
+	TenantOwnerNamespaceLabel = "music.mixcorp.org/owner-namespace"
+	TenantOwnerNameLabel      = "music.mixcorp.org/owner-name"
+)
+
+// DedicatedTenancy cho biết MusicService có dùng namespace tenant riêng hay không
+func DedicatedTenancy(ms *musicv1.MusicService) bool {
+	return ms.Spec.Tenancy != nil && ms.Spec.Tenancy.Mode == musicv1.TenancyModeDedicated
+}
+
+// TenantNamespaceName trả về tên namespace tenant của MusicService
+func TenantNamespaceName(ms *musicv1.MusicService) string {
+	if ms.Spec.Tenancy != nil && ms.Spec.Tenancy.Namespace != "" {
+		return ms.Spec.Tenancy.Namespace
+	}
+	return "tenant-" + ms.Name
+}
+
+// WorkloadNamespace trả về namespace chứa tài nguyên con của MusicService
+func WorkloadNamespace(ms *musicv1.MusicService) string {
+	if DedicatedTenancy(ms) {
+		return TenantNamespaceName(ms)
+	}
+	return ms.Namespace
+}
+
+// BuildTenantNamespace xây dựng namespace tenant cho chế độ Dedicated
+func (b *ResourceBuilder) BuildTenantNamespace(ms *musicv1.MusicService) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   TenantNamespaceName(ms),
+			Labels: b.getLabels(ms, "tenant"),
+		},
+	}
+}
+
+// BuildTenantResourceQuota xây dựng ResourceQuota của namespace tenant từ spec.tenancy.quota
+func (b *ResourceBuilder) BuildTenantResourceQuota(ms *musicv1.MusicService) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ms.Name + "-quota",
+			Namespace: TenantNamespaceName(ms),
+			Labels:    b.getLabels(ms, "tenant"),
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: ms.Spec.Tenancy.Quota.DeepCopy(),
+		},
+	}
+}
+
+// BuildTenantNetworkPolicies xây dựng NetworkPolicy cho namespace tenant:
+// chặn mọi ingress mặc định, cho phép lưu lượng nội bộ namespace và lưu lượng tới cổng của ứng dụng
+func (b *ResourceBuilder) BuildTenantNetworkPolicies(ms *musicv1.MusicService) []*networkingv1.NetworkPolicy {
+	namespace := TenantNamespaceName(ms)
+	labels := b.getLabels(ms, "tenant")
+	appPort := intstr.FromString("http")
+
+	return []*networkingv1.NetworkPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default-deny-ingress",
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "allow-same-namespace",
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{
+					{
+						From: []networkingv1.NetworkPolicyPeer{
+							{PodSelector: &metav1.LabelSelector{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "allow-music-service",
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app": ms.Name,
+					},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "component",
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"music-service", "read-pool"},
+						},
+					},
+				},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{
+					{
+						Ports: []networkingv1.NetworkPolicyPort{
+							{Port: &appPort},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// OwnerReferences trả về OwnerReference tới MusicService; trong chế độ Dedicated tài nguyên con
+// nằm ở namespace khác nên không gắn owner mà được dọn cùng namespace tenant
+func (b *ResourceBuilder) OwnerReferences(ms *musicv1.MusicService) []metav1.OwnerReference {
+	if DedicatedTenancy(ms) {
+		return nil
+	}
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(ms, musicv1.GroupVersion.WithKind("MusicService")),
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+const (
+	// defaultTLSPassthroughPort là cổng container phục vụ TLS khi tlsPassthrough.port chưa được đặt
+	defaultTLSPassthroughPort = int32(8443)
+	// defaultTLSPassthroughServicePort là cổng https của Service khi tlsPassthrough.servicePort chưa được đặt
+	defaultTLSPassthroughServicePort = int32(443)
+	// defaultTLSPassthroughMountPath là thư mục mount Secret chứng chỉ trong container
+	defaultTLSPassthroughMountPath = "/etc/music/tls"
+
+	ingressSSLPassthroughAnnotation  = "nginx.ingress.kubernetes.io/ssl-passthrough"
+	ingressBackendProtocolAnnotation = "nginx.ingress.kubernetes.io/backend-protocol"
+)
+
+// TLSPassthroughEnabled cho biết ứng dụng tự kết thúc TLS bằng chứng chỉ trong spec.tlsPassthrough
+func TLSPassthroughEnabled(ms *musicv1.MusicService) bool {
+	return ms.Spec.TLSPassthrough != nil
+}
+
+// tlsPassthroughPort trả về cổng container phục vụ TLS
+func tlsPassthroughPort(ms *musicv1.MusicService) int32 {
+	if ms.Spec.TLSPassthrough.Port > 0 {
+		return ms.Spec.TLSPassthrough.Port
+	}
+	return defaultTLSPassthroughPort
+}
+
+// applyTLSPassthrough mount Secret chứng chỉ vào container music-service, mở port "https"
+// và truyền đường dẫn chứng chỉ qua TLS_CERT_FILE/TLS_KEY_FILE để ứng dụng tự kết thúc TLS
+func applyTLSPassthrough(ms *musicv1.MusicService, spec *corev1.PodSpec) {
+	if !TLSPassthroughEnabled(ms) {
+		return
+	}
+	tls := ms.Spec.TLSPassthrough
+
+	mountPath := defaultTLSPassthroughMountPath
+	if tls.MountPath != "" {
+		mountPath = tls.MountPath
+	}
+
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "app-tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: tls.SecretName},
+		},
+	})
+	for i := range spec.Containers {
+		container := &spec.Containers[i]
+		if container.Name != "music-service" {
+			continue
+		}
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			Name:          "https",
+			ContainerPort: tlsPassthroughPort(ms),
+			Protocol:      corev1.ProtocolTCP,
+		})
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: "TLS_CERT_FILE", Value: path.Join(mountPath, corev1.TLSCertKey)},
+			corev1.EnvVar{Name: "TLS_KEY_FILE", Value: path.Join(mountPath, corev1.TLSPrivateKeyKey)},
+		)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      "app-tls",
+			MountPath: mountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// buildTLSPassthroughServicePort trả về port "https" của Service ứng dụng trỏ tới port TLS của container
+func buildTLSPassthroughServicePort(ms *musicv1.MusicService) corev1.ServicePort {
+	port := defaultTLSPassthroughServicePort
+	if ms.Spec.TLSPassthrough.ServicePort > 0 {
+		port = ms.Spec.TLSPassthrough.ServicePort
+	}
+	return corev1.ServicePort{
+		Name:       "https",
+		Port:       port,
+		TargetPort: intstr.FromString("https"),
+		Protocol:   corev1.ProtocolTCP,
+	}
+}
+
+// buildTLSPassthroughAnnotations dựng annotation ingress-nginx chuyển thẳng luồng TLS tới pod
+// mà không giải mã ở edge; controller ingress-nginx phải chạy với --enable-ssl-passthrough
+func buildTLSPassthroughAnnotations() map[string]string {
+	return map[string]string{
+		ingressSSLPassthroughAnnotation:  "true",
+		ingressBackendProtocolAnnotation: "HTTPS",
+	}
+}
@@ -0,0 +1,563 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package database định nghĩa Provider của từng engine cơ sở dữ liệu (MariaDB, MySQL, PostgreSQL) cùng các kiểu
+// mà builder dùng để sinh StatefulSet; package công khai để chương trình dùng builder.WithDatabaseProvider
+// có thể tự triển khai provider
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Hướng dẫn đọc nhanh:
+// - Nếu chưa rõ nơi dùng provider, xem pkg/builder/resource_builder.go (databaseConfig).
+// - Nếu chưa rõ cấu hình DB trong spec, xem api/v1/musicservice_types.go.
+
+// Role là vai trò của pod cơ sở dữ liệu khi sinh cấu hình server
+type Role string
+
+const (
+	// RoleMaster là endpoint ghi duy nhất
+	RoleMaster Role = "master"
+	// RoleReplica là replica chỉ đọc nhân bản từ master
+	RoleReplica Role = "replica"
+)
+
+// ServerOptions là các tùy chọn server chung mà builder lấy từ spec.database
+type ServerOptions struct {
+	// MemoryBytes là memory limit (hoặc request) của container, 0 nghĩa là không khai báo
+	MemoryBytes  int64
+	CharacterSet string
+	Collation    string
+	TimeZone     string
+	// Extra là các dòng cấu hình riêng của engine (mỗi dòng kết thúc bằng \n) được thêm vào cuối file cấu hình
+	Extra string
+}
+
+// Features cho biết những tính năng của operator mà provider hỗ trợ;
+// webhook từ chối spec bật tính năng không được hỗ trợ và reconciler bỏ qua các bước tương ứng
+type Features struct {
+	Galera              bool
+	AuditLog            bool
+	Backup              bool
+	ParallelApply       bool
+	ReplicationFilters  bool
+	CredentialRotation  bool
+	ReplicaDecommission bool
+	Locale              bool
+}
+
+// ReplicaSetup là script thiết lập replication của replica: InitScript chạy trong init container trước
+// khi server khởi động (ví dụ sao chép base backup), SidecarScript chạy trong sidecar cạnh server đang chạy
+type ReplicaSetup struct {
+	InitScript    string
+	SidecarScript string
+}
+
+// Provider trừu tượng hóa cấu hình theo từng loại cơ sở dữ liệu
+type Provider interface {
+	Name() string
+	DefaultImage() string
+	DefaultPort() int32
+	DefaultRootPassword() string
+	DefaultStorageSize() string
+
+	// ContainerName là tên container server trong pod master/replica
+	ContainerName() string
+	// PortName là tên cổng của container và Service
+	PortName() string
+	// DataDir là nơi mount PVC db-data
+	DataDir() string
+	// ConfigDir là nơi mount file cấu hình do init container sinh ra
+	ConfigDir() string
+	// InitScriptsDir là thư mục image chạy script khởi tạo khi data directory còn trống
+	InitScriptsDir() string
+	// RootPasswordEnv, DatabaseEnv và UserEnv là tên biến môi trường image đọc mật khẩu superuser,
+	// database và user ứng dụng
+	RootPasswordEnv() string
+	DatabaseEnv() string
+	UserEnv() (user, password string)
+	// ServerEnv là biến môi trường cố định thêm vào container server và init container dùng data directory
+	ServerEnv() []corev1.EnvVar
+	// ServerArgs là args của container server, nil để giữ CMD của image
+	ServerArgs() []string
+	// ConfigScript sinh script shell ghi cấu hình server vào /db-config
+	ConfigScript(role Role, options ServerOptions) string
+	// WaitCommand kiểm tra server tại $DB_HOST nhận kết nối mà không cần mật khẩu
+	WaitCommand() string
+	// HealthCommand kiểm tra server cục bộ; query bật để chạy thử một truy vấn thay vì chỉ ping
+	HealthCommand(query bool) string
+	// ReplicaReadyCommand thành công khi replication chạy và độ trễ không vượt maxLagSeconds
+	ReplicaReadyCommand(maxLagSeconds int32) string
+	// ReplicaSetup trả về script thiết lập replication từ masterHost, đọc REPLICATION_USER/REPLICATION_PASSWORD
+	ReplicaSetup(masterHost string) ReplicaSetup
+	// BootstrapScript chạy trong sidecar của master để tạo user ứng dụng khi image không tự tạo, "" nếu không cần
+	BootstrapScript() string
+	Features() Features
+}
+
+// innodbBufferPoolMemoryPercent là phần trăm bộ nhớ của container MariaDB/MySQL dành cho InnoDB buffer pool,
+// phần còn lại cho kết nối, bộ đệm sắp xếp và tiến trình khác trong container
+const innodbBufferPoolMemoryPercent = 70
+
+// MySQLServerOptions sinh innodb_buffer_pool_size, character-set-server, collation-server và default_time_zone
+// theo options rồi nối Extra; dùng chung cho MariaDB, MySQL và cấu hình Galera
+func MySQLServerOptions(options ServerOptions) string {
+	var lines string
+	if options.MemoryBytes > 0 {
+		// Làm tròn xuống MiB, tối thiểu 5MiB là giá trị nhỏ nhất MariaDB chấp nhận
+		const mib = int64(1 << 20)
+		size := options.MemoryBytes * innodbBufferPoolMemoryPercent / 100 / mib * mib
+		if size < 5*mib {
+			size = 5 * mib
+		}
+		lines += fmt.Sprintf("innodb_buffer_pool_size=%d\n", size)
+	}
+	if options.CharacterSet != "" {
+		lines += fmt.Sprintf("character-set-server=%s\n", options.CharacterSet)
+	}
+	if options.Collation != "" {
+		lines += fmt.Sprintf("collation-server=%s\n", options.Collation)
+	}
+	if options.TimeZone != "" {
+		lines += fmt.Sprintf("default_time_zone='%s'\n", options.TimeZone)
+	}
+	return lines + options.Extra
+}
+
+// mysqlFamily gom phần chung của MariaDB và MySQL: image chính thức đọc cùng biến MYSQL_*,
+// cùng data directory và thư mục conf.d
+type mysqlFamily struct{}
+
+func (mysqlFamily) PortName() string       { return "mysql" }
+func (mysqlFamily) DataDir() string        { return "/var/lib/mysql" }
+func (mysqlFamily) ConfigDir() string      { return "/etc/mysql/conf.d" }
+func (mysqlFamily) InitScriptsDir() string { return "/docker-entrypoint-initdb.d" }
+func (mysqlFamily) RootPasswordEnv() string {
+	return "MYSQL_ROOT_PASSWORD"
+}
+func (mysqlFamily) DatabaseEnv() string { return "MYSQL_DATABASE" }
+func (mysqlFamily) UserEnv() (string, string) {
+	return "MYSQL_USER", "MYSQL_PASSWORD"
+}
+func (mysqlFamily) ServerEnv() []corev1.EnvVar { return nil }
+func (mysqlFamily) ServerArgs() []string       { return nil }
+func (mysqlFamily) BootstrapScript() string    { return "" }
+
+// mysqladmin ping trả về thành công ngay cả khi bị từ chối xác thực nên không cần mật khẩu
+func (mysqlFamily) WaitCommand() string {
+	return `mysqladmin ping -h "$DB_HOST" -P 3306 --silent --connect-timeout=2`
+}
+
+func (mysqlFamily) HealthCommand(query bool) string {
+	if query {
+		return "mysql -uroot -p$MYSQL_ROOT_PASSWORD -e 'SELECT 1' > /dev/null"
+	}
+	return "mysqladmin ping -uroot -p$MYSQL_ROOT_PASSWORD"
+}
+
+// mysqlConfigScript ghi server-id.cnf: master có server-id 1, replica 200 + ordinal của pod
+func mysqlConfigScript(role Role, masterOptions, replicaOptions string, options ServerOptions) string {
+	if role == RoleReplica {
+		return `
+set -e
+ordinal=${POD_NAME##*-}
+server_id=$((200 + ordinal))
+cat <<EOF > /db-config/server-id.cnf
+[mysqld]
+server-id=${server_id}
+` + replicaOptions + MySQLServerOptions(options) + `EOF
+`
+	}
+	return `
+set -e
+cat <<'EOF' > /db-config/server-id.cnf
+[mysqld]
+server-id=1
+` + masterOptions + MySQLServerOptions(options) + `EOF
+`
+}
+
+// MariaDBProvider triển khai Provider cho MariaDB
+type MariaDBProvider struct{ mysqlFamily }
+
+func (p *MariaDBProvider) Name() string {
+	return "mariadb"
+}
+
+func (p *MariaDBProvider) DefaultImage() string {
+	return "mariadb:10.11"
+}
+
+func (p *MariaDBProvider) DefaultPort() int32 {
+	return 3306
+}
+
+func (p *MariaDBProvider) DefaultRootPassword() string {
+	return "rootpass"
+}
+
+func (p *MariaDBProvider) DefaultStorageSize() string {
+	return "10Gi"
+}
+
+func (p *MariaDBProvider) ContainerName() string {
+	return "mariadb"
+}
+
+func (p *MariaDBProvider) ConfigScript(role Role, options ServerOptions) string {
+	const common = `log_bin=mysql-bin
+binlog_format=ROW
+gtid_strict_mode=ON
+log_slave_updates=ON
+`
+	return mysqlConfigScript(role, common, common+`read_only=ON
+skip_slave_start=1
+`, options)
+}
+
+func (p *MariaDBProvider) ReplicaReadyCommand(maxLagSeconds int32) string {
+	return fmt.Sprintf(`mysqladmin ping -uroot -p$MYSQL_ROOT_PASSWORD > /dev/null || exit 1
+lag=$(mysql -uroot -p$MYSQL_ROOT_PASSWORD -e "SHOW SLAVE STATUS\G" | awk '/Seconds_Behind_Master:/ {print $2}')
+case "$lag" in
+  ''|NULL) exit 1 ;;
+esac
+[ "$lag" -le %d ]`, maxLagSeconds)
+}
+
+func (p *MariaDBProvider) ReplicaSetup(masterHost string) ReplicaSetup {
+	return ReplicaSetup{SidecarScript: fmt.Sprintf(`
+#!/bin/bash
+set -e
+echo "Waiting for local MariaDB to be ready..."
+until mysql -h 127.0.0.1 -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SELECT 1" > /dev/null 2>&1; do
+	sleep 2
+done
+echo "Waiting for master to be ready..."
+until mysql -h %[1]s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SELECT 1" > /dev/null 2>&1; do
+	sleep 2
+done
+echo "Master is ready, ensuring replication user..."
+mysql -h %[1]s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "CREATE USER IF NOT EXISTS '${REPLICATION_USER}'@'%%' IDENTIFIED BY '${REPLICATION_PASSWORD}'; GRANT REPLICATION SLAVE ON *.* TO '${REPLICATION_USER}'@'%%'; FLUSH PRIVILEGES;"
+echo "Configuring replica..."
+mysql -h 127.0.0.1 -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "STOP SLAVE; RESET SLAVE ALL; CHANGE MASTER TO MASTER_HOST='%[1]s', MASTER_USER='${REPLICATION_USER}', MASTER_PASSWORD='${REPLICATION_PASSWORD}', MASTER_PORT=3306, MASTER_USE_GTID=slave_pos; START SLAVE;"
+mysql -h 127.0.0.1 -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SHOW SLAVE STATUS\\G" | grep -E "Slave_IO_Running: Yes|Slave_SQL_Running: Yes" || true
+echo "Replication setup complete. Sleeping..."
+sleep infinity
+`, masterHost)}
+}
+
+func (p *MariaDBProvider) Features() Features {
+	return Features{
+		Galera:              true,
+		AuditLog:            true,
+		Backup:              true,
+		ParallelApply:       true,
+		ReplicationFilters:  true,
+		CredentialRotation:  true,
+		ReplicaDecommission: true,
+		Locale:              true,
+	}
+}
+
+// PostgreSQLProvider triển khai Provider cho PostgreSQL
+type PostgreSQLProvider struct{}
+
+func (p *PostgreSQLProvider) Name() string {
+	return "postgresql"
+}
+
+func (p *PostgreSQLProvider) DefaultImage() string {
+	return "postgres:15"
+}
+
+func (p *PostgreSQLProvider) DefaultPort() int32 {
+	return 5432
+}
+
+func (p *PostgreSQLProvider) DefaultRootPassword() string {
+	return "postgres"
+}
+
+func (p *PostgreSQLProvider) DefaultStorageSize() string {
+	return "10Gi"
+}
+
+func (p *PostgreSQLProvider) ContainerName() string  { return "postgres" }
+func (p *PostgreSQLProvider) PortName() string       { return "postgresql" }
+func (p *PostgreSQLProvider) ConfigDir() string      { return "/etc/postgresql/conf.d" }
+func (p *PostgreSQLProvider) InitScriptsDir() string { return "/docker-entrypoint-initdb.d" }
+func (p *PostgreSQLProvider) RootPasswordEnv() string {
+	return "POSTGRES_PASSWORD"
+}
+func (p *PostgreSQLProvider) DatabaseEnv() string { return "POSTGRES_DB" }
+
+// UserEnv không dùng POSTGRES_USER vì biến đó đổi tên superuser; user ứng dụng do BootstrapScript tạo
+func (p *PostgreSQLProvider) UserEnv() (string, string) {
+	return "APP_DB_USER", "APP_DB_PASSWORD"
+}
+
+func (p *PostgreSQLProvider) DataDir() string {
+	return "/var/lib/postgresql/data"
+}
+
+// ServerEnv đặt PGDATA vào thư mục con của PVC vì initdb từ chối thư mục gốc có lost+found
+func (p *PostgreSQLProvider) ServerEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "PGDATA", Value: "/var/lib/postgresql/data/pgdata"}}
+}
+
+func (p *PostgreSQLProvider) ServerArgs() []string {
+	return []string{"postgres", "-c", "config_file=/etc/postgresql/conf.d/postgresql.conf"}
+}
+
+// ConfigScript ghi postgresql.conf và pg_hba.conf; kết nối cục bộ được tin cậy để probe không cần mật khẩu,
+// mọi kết nối mạng (kể cả replication) dùng scram-sha-256. max_slot_wal_keep_size giới hạn WAL mà slot của
+// replica đã bị xóa giữ lại trên master; slot vượt giới hạn bị vô hiệu và replica tương ứng được sao chép lại
+// khi khởi động
+func (p *PostgreSQLProvider) ConfigScript(role Role, options ServerOptions) string {
+	var lines strings.Builder
+	lines.WriteString(`listen_addresses = '*'
+port = 5432
+hba_file = '/etc/postgresql/conf.d/pg_hba.conf'
+wal_level = replica
+max_wal_senders = 10
+max_replication_slots = 10
+max_slot_wal_keep_size = 4GB
+hot_standby = on
+`)
+	if options.MemoryBytes > 0 {
+		// shared_buffers theo khuyến nghị 25% bộ nhớ, tối thiểu 16MB
+		const mib = int64(1 << 20)
+		size := options.MemoryBytes / 4 / mib
+		if size < 16 {
+			size = 16
+		}
+		fmt.Fprintf(&lines, "shared_buffers = %dMB\n", size)
+	}
+	if options.TimeZone != "" && options.TimeZone != "SYSTEM" {
+		fmt.Fprintf(&lines, "timezone = '%s'\n", options.TimeZone)
+	}
+	lines.WriteString(options.Extra)
+
+	return `
+set -e
+cat <<'EOF' > /db-config/postgresql.conf
+` + lines.String() + `EOF
+cat <<'EOF' > /db-config/pg_hba.conf
+local all all trust
+host all all 127.0.0.1/32 trust
+host all all ::1/128 trust
+host replication all all scram-sha-256
+host all all all scram-sha-256
+EOF
+`
+}
+
+func (p *PostgreSQLProvider) WaitCommand() string {
+	return `pg_isready -h "$DB_HOST" -p 5432 -t 2`
+}
+
+func (p *PostgreSQLProvider) HealthCommand(query bool) string {
+	if query {
+		return "psql -U postgres -h 127.0.0.1 -p 5432 -tAc 'SELECT 1' > /dev/null"
+	}
+	return "pg_isready -U postgres -h 127.0.0.1 -p 5432"
+}
+
+// ReplicaReadyCommand coi độ trễ là 0 khi replica đã phát lại hết WAL nhận được,
+// nếu không thì là thời gian kể từ giao dịch cuối cùng được phát lại
+func (p *PostgreSQLProvider) ReplicaReadyCommand(maxLagSeconds int32) string {
+	return fmt.Sprintf(`pg_isready -U postgres -h 127.0.0.1 -p 5432 > /dev/null || exit 1
+lag=$(psql -U postgres -h 127.0.0.1 -p 5432 -tAc "SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0 ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)::int END WHERE pg_is_in_recovery() AND pg_last_wal_receive_lsn() IS NOT NULL")
+case "$lag" in
+  '') exit 1 ;;
+esac
+[ "$lag" -le %d ]`, maxLagSeconds)
+}
+
+// ReplicaSetup tạo role replication trên master rồi sao chép data directory bằng pg_basebackup -R kèm
+// replication slot vật lý riêng của pod (-C -S), để master giữ WAL cho replica và server khởi động ở chế độ standby.
+// Khi data directory đã có, replica tiếp tục streaming nếu slot còn giữ WAL; slot mất hoặc bị vô hiệu
+// (vượt max_slot_wal_keep_size) nghĩa là WAL cần thiết đã bị xóa nên data directory được sao chép lại từ đầu.
+// Master không truy cập được thì giữ nguyên data directory để replica vẫn phục vụ đọc
+func (p *PostgreSQLProvider) ReplicaSetup(masterHost string) ReplicaSetup {
+	return ReplicaSetup{InitScript: fmt.Sprintf(`
+set -e
+slot=$(echo "$POD_NAME" | tr '.-' '__')
+export PGPASSWORD="$POSTGRES_PASSWORD"
+if [ -s "$PGDATA/PG_VERSION" ]; then
+  if ! pg_isready -h %[1]s -p 5432 -t 5 > /dev/null 2>&1; then
+    echo "Master is unreachable, starting from the existing data directory"
+    exit 0
+  fi
+  status=$(psql -h %[1]s -p 5432 -U postgres -tA -v ON_ERROR_STOP=1 -v slot="$slot" <<'EOF'
+SELECT wal_status FROM pg_replication_slots WHERE slot_name = :'slot'
+EOF
+)
+  case "$status" in
+    ''|lost)
+      echo "Replication slot $slot is missing or lost, re-seeding the data directory"
+      find "$PGDATA" -mindepth 1 -delete
+      ;;
+    *)
+      echo "Replication slot $slot retains WAL, resuming streaming"
+      exit 0
+      ;;
+  esac
+fi
+echo "Waiting for master to be ready..."
+until pg_isready -h %[1]s -p 5432 > /dev/null 2>&1; do
+  sleep 2
+done
+echo "Master is ready, ensuring replication role and slot..."
+psql -h %[1]s -p 5432 -U postgres -v ON_ERROR_STOP=1 -v user="$REPLICATION_USER" -v password="$REPLICATION_PASSWORD" -v slot="$slot" <<'EOF'
+SELECT format('CREATE ROLE %%I WITH REPLICATION LOGIN', :'user') WHERE NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = :'user') \gexec
+SELECT format('ALTER ROLE %%I WITH REPLICATION LOGIN PASSWORD %%L', :'user', :'password') \gexec
+SELECT pg_drop_replication_slot(slot_name) FROM pg_replication_slots WHERE slot_name = :'slot' AND NOT active;
+EOF
+echo "Copying base backup from master..."
+export PGPASSWORD="$REPLICATION_PASSWORD"
+pg_basebackup -h %[1]s -p 5432 -U "$REPLICATION_USER" -D "$PGDATA" -X stream -R -C -S "$slot"
+echo "Replica data directory ready"
+`, masterHost)}
+}
+
+// BootstrapScript tạo hoặc cập nhật user ứng dụng và cấp quyền trên database mỗi lần master khởi động,
+// nên đổi mật khẩu trong Secret kết nối cũng được áp dụng
+func (p *PostgreSQLProvider) BootstrapScript() string {
+	return `
+set -e
+until pg_isready -U postgres -h 127.0.0.1 -p 5432 > /dev/null 2>&1; do
+  sleep 2
+done
+psql -U postgres -h 127.0.0.1 -p 5432 -v ON_ERROR_STOP=1 -v user="$APP_DB_USER" -v password="$APP_DB_PASSWORD" -v db="$POSTGRES_DB" <<'EOF'
+SELECT format('CREATE ROLE %I WITH LOGIN', :'user') WHERE NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = :'user') \gexec
+SELECT format('ALTER ROLE %I WITH LOGIN PASSWORD %L', :'user', :'password') \gexec
+SELECT format('ALTER DATABASE %I OWNER TO %I', :'db', :'user') \gexec
+EOF
+echo "Application user ready. Sleeping..."
+sleep infinity
+`
+}
+
+func (p *PostgreSQLProvider) Features() Features {
+	return Features{}
+}
+
+// MySQLProvider triển khai Provider cho MySQL
+type MySQLProvider struct{ mysqlFamily }
+
+func (p *MySQLProvider) Name() string {
+	return "mysql"
+}
+
+func (p *MySQLProvider) DefaultImage() string {
+	return "mysql:8.0"
+}
+
+func (p *MySQLProvider) DefaultPort() int32 {
+	return 3306
+}
+
+func (p *MySQLProvider) DefaultRootPassword() string {
+	return "rootpass"
+}
+
+func (p *MySQLProvider) DefaultStorageSize() string {
+	return "10Gi"
+}
+
+func (p *MySQLProvider) ContainerName() string {
+	return "mysql"
+}
+
+func (p *MySQLProvider) ConfigScript(role Role, options ServerOptions) string {
+	const common = `log_bin=mysql-bin
+binlog_format=ROW
+gtid_mode=ON
+enforce_gtid_consistency=ON
+log_replica_updates=ON
+`
+	return mysqlConfigScript(role, common, common+`read_only=ON
+skip_replica_start=ON
+`, options)
+}
+
+func (p *MySQLProvider) ReplicaReadyCommand(maxLagSeconds int32) string {
+	return fmt.Sprintf(`mysqladmin ping -uroot -p$MYSQL_ROOT_PASSWORD > /dev/null || exit 1
+lag=$(mysql -uroot -p$MYSQL_ROOT_PASSWORD -e "SHOW REPLICA STATUS\G" | awk '/Seconds_Behind_Source:/ {print $2}')
+case "$lag" in
+  ''|NULL) exit 1 ;;
+esac
+[ "$lag" -le %d ]`, maxLagSeconds)
+}
+
+// ReplicaSetup dùng GTID auto-position; GET_SOURCE_PUBLIC_KEY cho phép xác thực caching_sha2_password không cần TLS
+func (p *MySQLProvider) ReplicaSetup(masterHost string) ReplicaSetup {
+	return ReplicaSetup{SidecarScript: fmt.Sprintf(`
+#!/bin/bash
+set -e
+echo "Waiting for local MySQL to be ready..."
+until mysql -h 127.0.0.1 -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SELECT 1" > /dev/null 2>&1; do
+	sleep 2
+done
+echo "Waiting for master to be ready..."
+until mysql -h %[1]s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SELECT 1" > /dev/null 2>&1; do
+	sleep 2
+done
+echo "Master is ready, ensuring replication user..."
+mysql -h %[1]s -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "CREATE USER IF NOT EXISTS '${REPLICATION_USER}'@'%%' IDENTIFIED BY '${REPLICATION_PASSWORD}'; GRANT REPLICATION SLAVE ON *.* TO '${REPLICATION_USER}'@'%%'; FLUSH PRIVILEGES;"
+echo "Configuring replica..."
+mysql -h 127.0.0.1 -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "STOP REPLICA; RESET REPLICA ALL; CHANGE REPLICATION SOURCE TO SOURCE_HOST='%[1]s', SOURCE_USER='${REPLICATION_USER}', SOURCE_PASSWORD='${REPLICATION_PASSWORD}', SOURCE_PORT=3306, SOURCE_AUTO_POSITION=1, GET_SOURCE_PUBLIC_KEY=1; START REPLICA;"
+mysql -h 127.0.0.1 -P 3306 -uroot -p${MYSQL_ROOT_PASSWORD} -e "SHOW REPLICA STATUS\\G" | grep -E "Replica_IO_Running: Yes|Replica_SQL_Running: Yes" || true
+echo "Replication setup complete. Sleeping..."
+sleep infinity
+`, masterHost)}
+}
+
+func (p *MySQLProvider) Features() Features {
+	return Features{
+		ReplicationFilters:  true,
+		CredentialRotation:  true,
+		ReplicaDecommission: true,
+		Locale:              true,
+	}
+}
+
+// Registry cho các provider cơ sở dữ liệu
+var providers = map[string]Provider{
+	"mariadb":    &MariaDBProvider{},
+	"postgresql": &PostgreSQLProvider{},
+	"mysql":      &MySQLProvider{},
+}
+
+// GetProvider trả về provider cho loại cơ sở dữ liệu đã cho
+func GetProvider(dbType string) Provider {
+	if p, ok := providers[dbType]; ok {
+		return p
+	}
+	// Mặc định dùng MariaDB nếu không tìm thấy
+	return providers["mariadb"]
+}
+
+// RegisterProvider đăng ký một provider tùy chỉnh
+func RegisterProvider(name string, provider Provider) {
+	providers[name] = provider
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// AccessLogShippingSpecApplyConfiguration represents an declarative configuration of the AccessLogShippingSpec type for use
+// with apply.
+type AccessLogShippingSpecApplyConfiguration struct {
+	Enabled   *bool                                `json:"enabled,omitempty"`
+	Path      *string                              `json:"path,omitempty"`
+	Sink      *AccessLogSinkSpecApplyConfiguration `json:"sink,omitempty"`
+	Image     *string                              `json:"image,omitempty"`
+	Resources *corev1.ResourceRequirements         `json:"resources,omitempty"`
+}
+
+// AccessLogShippingSpecApplyConfiguration constructs an declarative configuration of the AccessLogShippingSpec type for use with
+// apply.
+func AccessLogShippingSpec() *AccessLogShippingSpecApplyConfiguration {
+	return &AccessLogShippingSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *AccessLogShippingSpecApplyConfiguration) WithEnabled(value bool) *AccessLogShippingSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *AccessLogShippingSpecApplyConfiguration) WithPath(value string) *AccessLogShippingSpecApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithSink sets the Sink field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sink field is set to the value of the last call.
+func (b *AccessLogShippingSpecApplyConfiguration) WithSink(value *AccessLogSinkSpecApplyConfiguration) *AccessLogShippingSpecApplyConfiguration {
+	b.Sink = value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *AccessLogShippingSpecApplyConfiguration) WithImage(value string) *AccessLogShippingSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *AccessLogShippingSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *AccessLogShippingSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// AccessLogSinkSpecApplyConfiguration represents an declarative configuration of the AccessLogSinkSpec type for use
+// with apply.
+type AccessLogSinkSpecApplyConfiguration struct {
+	Type              *v1.AccessLogSinkType `json:"type,omitempty"`
+	Host              *string               `json:"host,omitempty"`
+	Port              *int32                `json:"port,omitempty"`
+	TLS               *bool                 `json:"tls,omitempty"`
+	Index             *string               `json:"index,omitempty"`
+	Labels            map[string]string     `json:"labels,omitempty"`
+	CredentialsSecret *string               `json:"credentialsSecret,omitempty"`
+}
+
+// AccessLogSinkSpecApplyConfiguration constructs an declarative configuration of the AccessLogSinkSpec type for use with
+// apply.
+func AccessLogSinkSpec() *AccessLogSinkSpecApplyConfiguration {
+	return &AccessLogSinkSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *AccessLogSinkSpecApplyConfiguration) WithType(value v1.AccessLogSinkType) *AccessLogSinkSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithHost sets the Host field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Host field is set to the value of the last call.
+func (b *AccessLogSinkSpecApplyConfiguration) WithHost(value string) *AccessLogSinkSpecApplyConfiguration {
+	b.Host = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *AccessLogSinkSpecApplyConfiguration) WithPort(value int32) *AccessLogSinkSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *AccessLogSinkSpecApplyConfiguration) WithTLS(value bool) *AccessLogSinkSpecApplyConfiguration {
+	b.TLS = &value
+	return b
+}
+
+// WithIndex sets the Index field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Index field is set to the value of the last call.
+func (b *AccessLogSinkSpecApplyConfiguration) WithIndex(value string) *AccessLogSinkSpecApplyConfiguration {
+	b.Index = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *AccessLogSinkSpecApplyConfiguration) WithLabels(entries map[string]string) *AccessLogSinkSpecApplyConfiguration {
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithCredentialsSecret sets the CredentialsSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialsSecret field is set to the value of the last call.
+func (b *AccessLogSinkSpecApplyConfiguration) WithCredentialsSecret(value string) *AccessLogSinkSpecApplyConfiguration {
+	b.CredentialsSecret = &value
+	return b
+}
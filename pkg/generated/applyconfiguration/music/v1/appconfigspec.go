@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// AppConfigSpecApplyConfiguration represents an declarative configuration of the AppConfigSpec type for use
+// with apply.
+type AppConfigSpecApplyConfiguration struct {
+	ConfigMapName  *string                             `json:"configMapName,omitempty"`
+	MountPath      *string                             `json:"mountPath,omitempty"`
+	ReloadableKeys []string                            `json:"reloadableKeys,omitempty"`
+	Reload         *ConfigReloadSpecApplyConfiguration `json:"reload,omitempty"`
+}
+
+// AppConfigSpecApplyConfiguration constructs an declarative configuration of the AppConfigSpec type for use with
+// apply.
+func AppConfigSpec() *AppConfigSpecApplyConfiguration {
+	return &AppConfigSpecApplyConfiguration{}
+}
+
+// WithConfigMapName sets the ConfigMapName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapName field is set to the value of the last call.
+func (b *AppConfigSpecApplyConfiguration) WithConfigMapName(value string) *AppConfigSpecApplyConfiguration {
+	b.ConfigMapName = &value
+	return b
+}
+
+// WithMountPath sets the MountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountPath field is set to the value of the last call.
+func (b *AppConfigSpecApplyConfiguration) WithMountPath(value string) *AppConfigSpecApplyConfiguration {
+	b.MountPath = &value
+	return b
+}
+
+// WithReloadableKeys adds the given value to the ReloadableKeys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReloadableKeys field.
+func (b *AppConfigSpecApplyConfiguration) WithReloadableKeys(values ...string) *AppConfigSpecApplyConfiguration {
+	for i := range values {
+		b.ReloadableKeys = append(b.ReloadableKeys, values[i])
+	}
+	return b
+}
+
+// WithReload sets the Reload field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reload field is set to the value of the last call.
+func (b *AppConfigSpecApplyConfiguration) WithReload(value *ConfigReloadSpecApplyConfiguration) *AppConfigSpecApplyConfiguration {
+	b.Reload = value
+	return b
+}
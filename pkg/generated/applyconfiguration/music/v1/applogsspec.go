@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// AppLogsSpecApplyConfiguration represents an declarative configuration of the AppLogsSpec type for use
+// with apply.
+type AppLogsSpecApplyConfiguration struct {
+	Path           *string                  `json:"path,omitempty"`
+	VolumeSize     *string                  `json:"volumeSize,omitempty"`
+	MaxFileSize    *string                  `json:"maxFileSize,omitempty"`
+	MaxAgeHours    *int32                   `json:"maxAgeHours,omitempty"`
+	MaxFiles       *int32                   `json:"maxFiles,omitempty"`
+	RetentionHours *int32                   `json:"retentionHours,omitempty"`
+	Resources      *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// AppLogsSpecApplyConfiguration constructs an declarative configuration of the AppLogsSpec type for use with
+// apply.
+func AppLogsSpec() *AppLogsSpecApplyConfiguration {
+	return &AppLogsSpecApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithPath(value string) *AppLogsSpecApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithVolumeSize sets the VolumeSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSize field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithVolumeSize(value string) *AppLogsSpecApplyConfiguration {
+	b.VolumeSize = &value
+	return b
+}
+
+// WithMaxFileSize sets the MaxFileSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFileSize field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithMaxFileSize(value string) *AppLogsSpecApplyConfiguration {
+	b.MaxFileSize = &value
+	return b
+}
+
+// WithMaxAgeHours sets the MaxAgeHours field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAgeHours field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithMaxAgeHours(value int32) *AppLogsSpecApplyConfiguration {
+	b.MaxAgeHours = &value
+	return b
+}
+
+// WithMaxFiles sets the MaxFiles field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFiles field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithMaxFiles(value int32) *AppLogsSpecApplyConfiguration {
+	b.MaxFiles = &value
+	return b
+}
+
+// WithRetentionHours sets the RetentionHours field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetentionHours field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithRetentionHours(value int32) *AppLogsSpecApplyConfiguration {
+	b.RetentionHours = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *AppLogsSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *AppLogsSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
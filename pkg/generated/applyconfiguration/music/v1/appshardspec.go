@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// AppShardSpecApplyConfiguration represents an declarative configuration of the AppShardSpec type for use
+// with apply.
+type AppShardSpecApplyConfiguration struct {
+	Name        *string                            `json:"name,omitempty"`
+	Replicas    *int32                             `json:"replicas,omitempty"`
+	Autoscaling *AutoscalingSpecApplyConfiguration `json:"autoscaling,omitempty"`
+	Genres      []string                           `json:"genres,omitempty"`
+}
+
+// AppShardSpecApplyConfiguration constructs an declarative configuration of the AppShardSpec type for use with
+// apply.
+func AppShardSpec() *AppShardSpecApplyConfiguration {
+	return &AppShardSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AppShardSpecApplyConfiguration) WithName(value string) *AppShardSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *AppShardSpecApplyConfiguration) WithReplicas(value int32) *AppShardSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *AppShardSpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *AppShardSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithGenres adds the given value to the Genres field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Genres field.
+func (b *AppShardSpecApplyConfiguration) WithGenres(values ...string) *AppShardSpecApplyConfiguration {
+	for i := range values {
+		b.Genres = append(b.Genres, values[i])
+	}
+	return b
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// AutoscalingSpecApplyConfiguration represents an declarative configuration of the AutoscalingSpec type for use
+// with apply.
+type AutoscalingSpecApplyConfiguration struct {
+	Enabled                           *bool  `json:"enabled,omitempty"`
+	MinReplicas                       *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas                       *int32 `json:"maxReplicas,omitempty"`
+	TargetCPUUtilizationPercentage    *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// AutoscalingSpecApplyConfiguration constructs an declarative configuration of the AutoscalingSpec type for use with
+// apply.
+func AutoscalingSpec() *AutoscalingSpecApplyConfiguration {
+	return &AutoscalingSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithEnabled(value bool) *AutoscalingSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithMinReplicas(value int32) *AutoscalingSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithMaxReplicas(value int32) *AutoscalingSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithTargetCPUUtilizationPercentage sets the TargetCPUUtilizationPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetCPUUtilizationPercentage field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithTargetCPUUtilizationPercentage(value int32) *AutoscalingSpecApplyConfiguration {
+	b.TargetCPUUtilizationPercentage = &value
+	return b
+}
+
+// WithTargetMemoryUtilizationPercentage sets the TargetMemoryUtilizationPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetMemoryUtilizationPercentage field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithTargetMemoryUtilizationPercentage(value int32) *AutoscalingSpecApplyConfiguration {
+	b.TargetMemoryUtilizationPercentage = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// BackupObjectStorageDestinationApplyConfiguration represents an declarative configuration of the BackupObjectStorageDestination type for use
+// with apply.
+type BackupObjectStorageDestinationApplyConfiguration struct {
+	Bucket *string `json:"bucket,omitempty"`
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// BackupObjectStorageDestinationApplyConfiguration constructs an declarative configuration of the BackupObjectStorageDestination type for use with
+// apply.
+func BackupObjectStorageDestination() *BackupObjectStorageDestinationApplyConfiguration {
+	return &BackupObjectStorageDestinationApplyConfiguration{}
+}
+
+// WithBucket sets the Bucket field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bucket field is set to the value of the last call.
+func (b *BackupObjectStorageDestinationApplyConfiguration) WithBucket(value string) *BackupObjectStorageDestinationApplyConfiguration {
+	b.Bucket = &value
+	return b
+}
+
+// WithPrefix sets the Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prefix field is set to the value of the last call.
+func (b *BackupObjectStorageDestinationApplyConfiguration) WithPrefix(value string) *BackupObjectStorageDestinationApplyConfiguration {
+	b.Prefix = &value
+	return b
+}
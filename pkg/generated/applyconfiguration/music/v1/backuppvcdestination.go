@@ -0,0 +1,47 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// BackupPVCDestinationApplyConfiguration represents an declarative configuration of the BackupPVCDestination type for use
+// with apply.
+type BackupPVCDestinationApplyConfiguration struct {
+	Size             *string `json:"size,omitempty"`
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// BackupPVCDestinationApplyConfiguration constructs an declarative configuration of the BackupPVCDestination type for use with
+// apply.
+func BackupPVCDestination() *BackupPVCDestinationApplyConfiguration {
+	return &BackupPVCDestinationApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *BackupPVCDestinationApplyConfiguration) WithSize(value string) *BackupPVCDestinationApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *BackupPVCDestinationApplyConfiguration) WithStorageClassName(value string) *BackupPVCDestinationApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupStatusApplyConfiguration represents an declarative configuration of the BackupStatus type for use
+// with apply.
+type BackupStatusApplyConfiguration struct {
+	LastBackupTime       *v1.Time `json:"lastBackupTime,omitempty"`
+	LastBackupSizeBytes  *int64   `json:"lastBackupSizeBytes,omitempty"`
+	LastBackupLocation   *string  `json:"lastBackupLocation,omitempty"`
+	NextScheduledBackup  *v1.Time `json:"nextScheduledBackup,omitempty"`
+	LastFailedBackupTime *v1.Time `json:"lastFailedBackupTime,omitempty"`
+}
+
+// BackupStatusApplyConfiguration constructs an declarative configuration of the BackupStatus type for use with
+// apply.
+func BackupStatus() *BackupStatusApplyConfiguration {
+	return &BackupStatusApplyConfiguration{}
+}
+
+// WithLastBackupTime sets the LastBackupTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastBackupTime field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithLastBackupTime(value v1.Time) *BackupStatusApplyConfiguration {
+	b.LastBackupTime = &value
+	return b
+}
+
+// WithLastBackupSizeBytes sets the LastBackupSizeBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastBackupSizeBytes field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithLastBackupSizeBytes(value int64) *BackupStatusApplyConfiguration {
+	b.LastBackupSizeBytes = &value
+	return b
+}
+
+// WithLastBackupLocation sets the LastBackupLocation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastBackupLocation field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithLastBackupLocation(value string) *BackupStatusApplyConfiguration {
+	b.LastBackupLocation = &value
+	return b
+}
+
+// WithNextScheduledBackup sets the NextScheduledBackup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextScheduledBackup field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithNextScheduledBackup(value v1.Time) *BackupStatusApplyConfiguration {
+	b.NextScheduledBackup = &value
+	return b
+}
+
+// WithLastFailedBackupTime sets the LastFailedBackupTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailedBackupTime field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithLastFailedBackupTime(value v1.Time) *BackupStatusApplyConfiguration {
+	b.LastFailedBackupTime = &value
+	return b
+}
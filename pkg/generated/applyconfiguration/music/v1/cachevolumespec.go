@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// CacheVolumeSpecApplyConfiguration represents an declarative configuration of the CacheVolumeSpec type for use
+// with apply.
+type CacheVolumeSpecApplyConfiguration struct {
+	Size      *string         `json:"size,omitempty"`
+	Medium    *v1.CacheMedium `json:"medium,omitempty"`
+	MountPath *string         `json:"mountPath,omitempty"`
+}
+
+// CacheVolumeSpecApplyConfiguration constructs an declarative configuration of the CacheVolumeSpec type for use with
+// apply.
+func CacheVolumeSpec() *CacheVolumeSpecApplyConfiguration {
+	return &CacheVolumeSpecApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *CacheVolumeSpecApplyConfiguration) WithSize(value string) *CacheVolumeSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithMedium sets the Medium field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Medium field is set to the value of the last call.
+func (b *CacheVolumeSpecApplyConfiguration) WithMedium(value v1.CacheMedium) *CacheVolumeSpecApplyConfiguration {
+	b.Medium = &value
+	return b
+}
+
+// WithMountPath sets the MountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountPath field is set to the value of the last call.
+func (b *CacheVolumeSpecApplyConfiguration) WithMountPath(value string) *CacheVolumeSpecApplyConfiguration {
+	b.MountPath = &value
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ComponentStatusApplyConfiguration represents an declarative configuration of the ComponentStatus type for use
+// with apply.
+type ComponentStatusApplyConfiguration struct {
+	Kind    *string `json:"kind,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Ready   *bool   `json:"ready,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// ComponentStatusApplyConfiguration constructs an declarative configuration of the ComponentStatus type for use with
+// apply.
+func ComponentStatus() *ComponentStatusApplyConfiguration {
+	return &ComponentStatusApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *ComponentStatusApplyConfiguration) WithKind(value string) *ComponentStatusApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ComponentStatusApplyConfiguration) WithName(value string) *ComponentStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *ComponentStatusApplyConfiguration) WithReady(value bool) *ComponentStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ComponentStatusApplyConfiguration) WithMessage(value string) *ComponentStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ConfigReloadSpecApplyConfiguration represents an declarative configuration of the ConfigReloadSpec type for use
+// with apply.
+type ConfigReloadSpecApplyConfiguration struct {
+	Signal      *v1.ConfigReloadSignal       `json:"signal,omitempty"`
+	ProcessName *string                      `json:"processName,omitempty"`
+	Image       *string                      `json:"image,omitempty"`
+	Resources   *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ConfigReloadSpecApplyConfiguration constructs an declarative configuration of the ConfigReloadSpec type for use with
+// apply.
+func ConfigReloadSpec() *ConfigReloadSpecApplyConfiguration {
+	return &ConfigReloadSpecApplyConfiguration{}
+}
+
+// WithSignal sets the Signal field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signal field is set to the value of the last call.
+func (b *ConfigReloadSpecApplyConfiguration) WithSignal(value v1.ConfigReloadSignal) *ConfigReloadSpecApplyConfiguration {
+	b.Signal = &value
+	return b
+}
+
+// WithProcessName sets the ProcessName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProcessName field is set to the value of the last call.
+func (b *ConfigReloadSpecApplyConfiguration) WithProcessName(value string) *ConfigReloadSpecApplyConfiguration {
+	b.ProcessName = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ConfigReloadSpecApplyConfiguration) WithImage(value string) *ConfigReloadSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ConfigReloadSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *ConfigReloadSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
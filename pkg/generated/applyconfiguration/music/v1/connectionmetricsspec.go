@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ConnectionMetricsSpecApplyConfiguration represents an declarative configuration of the ConnectionMetricsSpec type for use
+// with apply.
+type ConnectionMetricsSpecApplyConfiguration struct {
+	Path       *string `json:"path,omitempty"`
+	Port       *int32  `json:"port,omitempty"`
+	MetricName *string `json:"metricName,omitempty"`
+}
+
+// ConnectionMetricsSpecApplyConfiguration constructs an declarative configuration of the ConnectionMetricsSpec type for use with
+// apply.
+func ConnectionMetricsSpec() *ConnectionMetricsSpecApplyConfiguration {
+	return &ConnectionMetricsSpecApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *ConnectionMetricsSpecApplyConfiguration) WithPath(value string) *ConnectionMetricsSpecApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *ConnectionMetricsSpecApplyConfiguration) WithPort(value int32) *ConnectionMetricsSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithMetricName sets the MetricName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricName field is set to the value of the last call.
+func (b *ConnectionMetricsSpecApplyConfiguration) WithMetricName(value string) *ConnectionMetricsSpecApplyConfiguration {
+	b.MetricName = &value
+	return b
+}
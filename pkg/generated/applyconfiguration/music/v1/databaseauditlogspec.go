@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DatabaseAuditLogSpecApplyConfiguration represents an declarative configuration of the DatabaseAuditLogSpec type for use
+// with apply.
+type DatabaseAuditLogSpecApplyConfiguration struct {
+	Enabled          *bool                        `json:"enabled,omitempty"`
+	Events           []v1.AuditLogEvent           `json:"events,omitempty"`
+	IncludeUsers     []string                     `json:"includeUsers,omitempty"`
+	ExcludeUsers     []string                     `json:"excludeUsers,omitempty"`
+	FileRotateSize   *string                      `json:"fileRotateSize,omitempty"`
+	FileRotations    *int32                       `json:"fileRotations,omitempty"`
+	ShipperImage     *string                      `json:"shipperImage,omitempty"`
+	ShipperResources *corev1.ResourceRequirements `json:"shipperResources,omitempty"`
+}
+
+// DatabaseAuditLogSpecApplyConfiguration constructs an declarative configuration of the DatabaseAuditLogSpec type for use with
+// apply.
+func DatabaseAuditLogSpec() *DatabaseAuditLogSpecApplyConfiguration {
+	return &DatabaseAuditLogSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithEnabled(value bool) *DatabaseAuditLogSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithEvents adds the given value to the Events field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Events field.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithEvents(values ...v1.AuditLogEvent) *DatabaseAuditLogSpecApplyConfiguration {
+	for i := range values {
+		b.Events = append(b.Events, values[i])
+	}
+	return b
+}
+
+// WithIncludeUsers adds the given value to the IncludeUsers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IncludeUsers field.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithIncludeUsers(values ...string) *DatabaseAuditLogSpecApplyConfiguration {
+	for i := range values {
+		b.IncludeUsers = append(b.IncludeUsers, values[i])
+	}
+	return b
+}
+
+// WithExcludeUsers adds the given value to the ExcludeUsers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludeUsers field.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithExcludeUsers(values ...string) *DatabaseAuditLogSpecApplyConfiguration {
+	for i := range values {
+		b.ExcludeUsers = append(b.ExcludeUsers, values[i])
+	}
+	return b
+}
+
+// WithFileRotateSize sets the FileRotateSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FileRotateSize field is set to the value of the last call.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithFileRotateSize(value string) *DatabaseAuditLogSpecApplyConfiguration {
+	b.FileRotateSize = &value
+	return b
+}
+
+// WithFileRotations sets the FileRotations field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FileRotations field is set to the value of the last call.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithFileRotations(value int32) *DatabaseAuditLogSpecApplyConfiguration {
+	b.FileRotations = &value
+	return b
+}
+
+// WithShipperImage sets the ShipperImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShipperImage field is set to the value of the last call.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithShipperImage(value string) *DatabaseAuditLogSpecApplyConfiguration {
+	b.ShipperImage = &value
+	return b
+}
+
+// WithShipperResources sets the ShipperResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShipperResources field is set to the value of the last call.
+func (b *DatabaseAuditLogSpecApplyConfiguration) WithShipperResources(value corev1.ResourceRequirements) *DatabaseAuditLogSpecApplyConfiguration {
+	b.ShipperResources = &value
+	return b
+}
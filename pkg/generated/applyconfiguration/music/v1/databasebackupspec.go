@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// DatabaseBackupSpecApplyConfiguration represents an declarative configuration of the DatabaseBackupSpec type for use
+// with apply.
+type DatabaseBackupSpecApplyConfiguration struct {
+	Enabled     *bool                                    `json:"enabled,omitempty"`
+	Schedule    *string                                  `json:"schedule,omitempty"`
+	TimeZone    *string                                  `json:"timeZone,omitempty"`
+	Method      *v1.BackupMethod                         `json:"method,omitempty"`
+	Retention   *int32                                   `json:"retention,omitempty"`
+	Destination *BackupDestinationSpecApplyConfiguration `json:"destination,omitempty"`
+}
+
+// DatabaseBackupSpecApplyConfiguration constructs an declarative configuration of the DatabaseBackupSpec type for use with
+// apply.
+func DatabaseBackupSpec() *DatabaseBackupSpecApplyConfiguration {
+	return &DatabaseBackupSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *DatabaseBackupSpecApplyConfiguration) WithEnabled(value bool) *DatabaseBackupSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *DatabaseBackupSpecApplyConfiguration) WithSchedule(value string) *DatabaseBackupSpecApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *DatabaseBackupSpecApplyConfiguration) WithTimeZone(value string) *DatabaseBackupSpecApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
+
+// WithMethod sets the Method field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Method field is set to the value of the last call.
+func (b *DatabaseBackupSpecApplyConfiguration) WithMethod(value v1.BackupMethod) *DatabaseBackupSpecApplyConfiguration {
+	b.Method = &value
+	return b
+}
+
+// WithRetention sets the Retention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retention field is set to the value of the last call.
+func (b *DatabaseBackupSpecApplyConfiguration) WithRetention(value int32) *DatabaseBackupSpecApplyConfiguration {
+	b.Retention = &value
+	return b
+}
+
+// WithDestination sets the Destination field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Destination field is set to the value of the last call.
+func (b *DatabaseBackupSpecApplyConfiguration) WithDestination(value *BackupDestinationSpecApplyConfiguration) *DatabaseBackupSpecApplyConfiguration {
+	b.Destination = value
+	return b
+}
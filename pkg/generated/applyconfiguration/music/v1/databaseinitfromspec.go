@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// DatabaseInitFromSpecApplyConfiguration represents an declarative configuration of the DatabaseInitFromSpec type for use
+// with apply.
+type DatabaseInitFromSpecApplyConfiguration struct {
+	MusicService *string `json:"musicService,omitempty"`
+	Namespace    *string `json:"namespace,omitempty"`
+	Archive      *string `json:"archive,omitempty"`
+}
+
+// DatabaseInitFromSpecApplyConfiguration constructs an declarative configuration of the DatabaseInitFromSpec type for use with
+// apply.
+func DatabaseInitFromSpec() *DatabaseInitFromSpecApplyConfiguration {
+	return &DatabaseInitFromSpecApplyConfiguration{}
+}
+
+// WithMusicService sets the MusicService field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MusicService field is set to the value of the last call.
+func (b *DatabaseInitFromSpecApplyConfiguration) WithMusicService(value string) *DatabaseInitFromSpecApplyConfiguration {
+	b.MusicService = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *DatabaseInitFromSpecApplyConfiguration) WithNamespace(value string) *DatabaseInitFromSpecApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithArchive sets the Archive field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Archive field is set to the value of the last call.
+func (b *DatabaseInitFromSpecApplyConfiguration) WithArchive(value string) *DatabaseInitFromSpecApplyConfiguration {
+	b.Archive = &value
+	return b
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// DatabaseNodeStatusApplyConfiguration represents an declarative configuration of the DatabaseNodeStatus type for use
+// with apply.
+type DatabaseNodeStatusApplyConfiguration struct {
+	Name     *string `json:"name,omitempty"`
+	Role     *string `json:"role,omitempty"`
+	NodeName *string `json:"nodeName,omitempty"`
+	Ready    *bool   `json:"ready,omitempty"`
+	State    *string `json:"state,omitempty"`
+	Restarts *int32  `json:"restarts,omitempty"`
+}
+
+// DatabaseNodeStatusApplyConfiguration constructs an declarative configuration of the DatabaseNodeStatus type for use with
+// apply.
+func DatabaseNodeStatus() *DatabaseNodeStatusApplyConfiguration {
+	return &DatabaseNodeStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *DatabaseNodeStatusApplyConfiguration) WithName(value string) *DatabaseNodeStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithRole sets the Role field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Role field is set to the value of the last call.
+func (b *DatabaseNodeStatusApplyConfiguration) WithRole(value string) *DatabaseNodeStatusApplyConfiguration {
+	b.Role = &value
+	return b
+}
+
+// WithNodeName sets the NodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeName field is set to the value of the last call.
+func (b *DatabaseNodeStatusApplyConfiguration) WithNodeName(value string) *DatabaseNodeStatusApplyConfiguration {
+	b.NodeName = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *DatabaseNodeStatusApplyConfiguration) WithReady(value bool) *DatabaseNodeStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *DatabaseNodeStatusApplyConfiguration) WithState(value string) *DatabaseNodeStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithRestarts sets the Restarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Restarts field is set to the value of the last call.
+func (b *DatabaseNodeStatusApplyConfiguration) WithRestarts(value int32) *DatabaseNodeStatusApplyConfiguration {
+	b.Restarts = &value
+	return b
+}
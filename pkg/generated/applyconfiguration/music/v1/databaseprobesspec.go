@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// DatabaseProbesSpecApplyConfiguration represents an declarative configuration of the DatabaseProbesSpec type for use
+// with apply.
+type DatabaseProbesSpecApplyConfiguration struct {
+	Handler   *v1.DatabaseProbeHandler           `json:"handler,omitempty"`
+	Readiness *ProbeTimingSpecApplyConfiguration `json:"readiness,omitempty"`
+	Liveness  *ProbeTimingSpecApplyConfiguration `json:"liveness,omitempty"`
+}
+
+// DatabaseProbesSpecApplyConfiguration constructs an declarative configuration of the DatabaseProbesSpec type for use with
+// apply.
+func DatabaseProbesSpec() *DatabaseProbesSpecApplyConfiguration {
+	return &DatabaseProbesSpecApplyConfiguration{}
+}
+
+// WithHandler sets the Handler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Handler field is set to the value of the last call.
+func (b *DatabaseProbesSpecApplyConfiguration) WithHandler(value v1.DatabaseProbeHandler) *DatabaseProbesSpecApplyConfiguration {
+	b.Handler = &value
+	return b
+}
+
+// WithReadiness sets the Readiness field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Readiness field is set to the value of the last call.
+func (b *DatabaseProbesSpecApplyConfiguration) WithReadiness(value *ProbeTimingSpecApplyConfiguration) *DatabaseProbesSpecApplyConfiguration {
+	b.Readiness = value
+	return b
+}
+
+// WithLiveness sets the Liveness field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Liveness field is set to the value of the last call.
+func (b *DatabaseProbesSpecApplyConfiguration) WithLiveness(value *ProbeTimingSpecApplyConfiguration) *DatabaseProbesSpecApplyConfiguration {
+	b.Liveness = value
+	return b
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseReplicationSpecApplyConfiguration represents an declarative configuration of the DatabaseReplicationSpec type for use
+// with apply.
+type DatabaseReplicationSpecApplyConfiguration struct {
+	Enabled                    *bool                                     `json:"enabled,omitempty"`
+	GTID                       *bool                                     `json:"gtid,omitempty"`
+	MinReplicas                *int32                                    `json:"minReplicas,omitempty"`
+	MaxReplicas                *int32                                    `json:"maxReplicas,omitempty"`
+	MaxLagSeconds              *int32                                    `json:"maxLagSeconds,omitempty"`
+	DeletePVCOnScaleDown       *bool                                     `json:"deletePVCOnScaleDown,omitempty"`
+	CredentialRotationInterval *v1.Duration                              `json:"credentialRotationInterval,omitempty"`
+	Filters                    *ReplicationFiltersSpecApplyConfiguration `json:"filters,omitempty"`
+	ParallelThreads            *int32                                    `json:"parallelThreads,omitempty"`
+	ParallelMode               *string                                   `json:"parallelMode,omitempty"`
+}
+
+// DatabaseReplicationSpecApplyConfiguration constructs an declarative configuration of the DatabaseReplicationSpec type for use with
+// apply.
+func DatabaseReplicationSpec() *DatabaseReplicationSpecApplyConfiguration {
+	return &DatabaseReplicationSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithEnabled(value bool) *DatabaseReplicationSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithGTID sets the GTID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GTID field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithGTID(value bool) *DatabaseReplicationSpecApplyConfiguration {
+	b.GTID = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithMinReplicas(value int32) *DatabaseReplicationSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithMaxReplicas(value int32) *DatabaseReplicationSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithMaxLagSeconds sets the MaxLagSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxLagSeconds field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithMaxLagSeconds(value int32) *DatabaseReplicationSpecApplyConfiguration {
+	b.MaxLagSeconds = &value
+	return b
+}
+
+// WithDeletePVCOnScaleDown sets the DeletePVCOnScaleDown field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletePVCOnScaleDown field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithDeletePVCOnScaleDown(value bool) *DatabaseReplicationSpecApplyConfiguration {
+	b.DeletePVCOnScaleDown = &value
+	return b
+}
+
+// WithCredentialRotationInterval sets the CredentialRotationInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialRotationInterval field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithCredentialRotationInterval(value v1.Duration) *DatabaseReplicationSpecApplyConfiguration {
+	b.CredentialRotationInterval = &value
+	return b
+}
+
+// WithFilters sets the Filters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Filters field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithFilters(value *ReplicationFiltersSpecApplyConfiguration) *DatabaseReplicationSpecApplyConfiguration {
+	b.Filters = value
+	return b
+}
+
+// WithParallelThreads sets the ParallelThreads field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParallelThreads field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithParallelThreads(value int32) *DatabaseReplicationSpecApplyConfiguration {
+	b.ParallelThreads = &value
+	return b
+}
+
+// WithParallelMode sets the ParallelMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParallelMode field is set to the value of the last call.
+func (b *DatabaseReplicationSpecApplyConfiguration) WithParallelMode(value string) *DatabaseReplicationSpecApplyConfiguration {
+	b.ParallelMode = &value
+	return b
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DatabaseSpecApplyConfiguration represents an declarative configuration of the DatabaseSpec type for use
+// with apply.
+type DatabaseSpecApplyConfiguration struct {
+	Enabled               *bool                                           `json:"enabled,omitempty"`
+	Replicas              *int32                                          `json:"replicas,omitempty"`
+	Image                 *string                                         `json:"image,omitempty"`
+	Engine                *v1.DatabaseEngine                              `json:"engine,omitempty"`
+	StartupTimeoutSeconds *int32                                          `json:"startupTimeoutSeconds,omitempty"`
+	SafeToEvict           *bool                                           `json:"safeToEvict,omitempty"`
+	Probes                *DatabaseProbesSpecApplyConfiguration           `json:"probes,omitempty"`
+	AuditLog              *DatabaseAuditLogSpecApplyConfiguration         `json:"auditLog,omitempty"`
+	Resources             *corev1.ResourceRequirements                    `json:"resources,omitempty"`
+	MinReadySeconds       *int32                                          `json:"minReadySeconds,omitempty"`
+	ImagePullPolicy       *corev1.PullPolicy                              `json:"imagePullPolicy,omitempty"`
+	CharacterSet          *string                                         `json:"characterSet,omitempty"`
+	Collation             *string                                         `json:"collation,omitempty"`
+	TimeZone              *string                                         `json:"timeZone,omitempty"`
+	Storage               *StorageSpecApplyConfiguration                  `json:"storage,omitempty"`
+	RootPassword          *string                                         `json:"rootPassword,omitempty"`
+	RootPasswordSecretRef *corev1.SecretKeySelector                       `json:"rootPasswordSecretRef,omitempty"`
+	Replication           *DatabaseReplicationSpecApplyConfiguration      `json:"replication,omitempty"`
+	InjectEnv             *bool                                           `json:"injectEnv,omitempty"`
+	ReadFallbackToMaster  *bool                                           `json:"readFallbackToMaster,omitempty"`
+	Autoscaling           *AutoscalingSpecApplyConfiguration              `json:"autoscaling,omitempty"`
+	HighAvailability      *DatabaseHighAvailabilitySpecApplyConfiguration `json:"highAvailability,omitempty"`
+	Backup                *DatabaseBackupSpecApplyConfiguration           `json:"backup,omitempty"`
+	InitFrom              *DatabaseInitFromSpecApplyConfiguration         `json:"initFrom,omitempty"`
+	InitScriptsConfigMap  *string                                         `json:"initScriptsConfigMap,omitempty"`
+}
+
+// DatabaseSpecApplyConfiguration constructs an declarative configuration of the DatabaseSpec type for use with
+// apply.
+func DatabaseSpec() *DatabaseSpecApplyConfiguration {
+	return &DatabaseSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithEnabled(value bool) *DatabaseSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithReplicas(value int32) *DatabaseSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithImage(value string) *DatabaseSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithEngine sets the Engine field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Engine field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithEngine(value v1.DatabaseEngine) *DatabaseSpecApplyConfiguration {
+	b.Engine = &value
+	return b
+}
+
+// WithStartupTimeoutSeconds sets the StartupTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartupTimeoutSeconds field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithStartupTimeoutSeconds(value int32) *DatabaseSpecApplyConfiguration {
+	b.StartupTimeoutSeconds = &value
+	return b
+}
+
+// WithSafeToEvict sets the SafeToEvict field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SafeToEvict field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithSafeToEvict(value bool) *DatabaseSpecApplyConfiguration {
+	b.SafeToEvict = &value
+	return b
+}
+
+// WithProbes sets the Probes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Probes field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithProbes(value *DatabaseProbesSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.Probes = value
+	return b
+}
+
+// WithAuditLog sets the AuditLog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuditLog field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithAuditLog(value *DatabaseAuditLogSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.AuditLog = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *DatabaseSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReadySeconds field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithMinReadySeconds(value int32) *DatabaseSpecApplyConfiguration {
+	b.MinReadySeconds = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithImagePullPolicy(value corev1.PullPolicy) *DatabaseSpecApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithCharacterSet sets the CharacterSet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CharacterSet field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithCharacterSet(value string) *DatabaseSpecApplyConfiguration {
+	b.CharacterSet = &value
+	return b
+}
+
+// WithCollation sets the Collation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Collation field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithCollation(value string) *DatabaseSpecApplyConfiguration {
+	b.Collation = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithTimeZone(value string) *DatabaseSpecApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithStorage(value *StorageSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithRootPassword sets the RootPassword field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RootPassword field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithRootPassword(value string) *DatabaseSpecApplyConfiguration {
+	b.RootPassword = &value
+	return b
+}
+
+// WithRootPasswordSecretRef sets the RootPasswordSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RootPasswordSecretRef field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithRootPasswordSecretRef(value corev1.SecretKeySelector) *DatabaseSpecApplyConfiguration {
+	b.RootPasswordSecretRef = &value
+	return b
+}
+
+// WithReplication sets the Replication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replication field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithReplication(value *DatabaseReplicationSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.Replication = value
+	return b
+}
+
+// WithInjectEnv sets the InjectEnv field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InjectEnv field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithInjectEnv(value bool) *DatabaseSpecApplyConfiguration {
+	b.InjectEnv = &value
+	return b
+}
+
+// WithReadFallbackToMaster sets the ReadFallbackToMaster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadFallbackToMaster field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithReadFallbackToMaster(value bool) *DatabaseSpecApplyConfiguration {
+	b.ReadFallbackToMaster = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithHighAvailability sets the HighAvailability field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HighAvailability field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithHighAvailability(value *DatabaseHighAvailabilitySpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.HighAvailability = value
+	return b
+}
+
+// WithBackup sets the Backup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backup field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithBackup(value *DatabaseBackupSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.Backup = value
+	return b
+}
+
+// WithInitFrom sets the InitFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitFrom field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithInitFrom(value *DatabaseInitFromSpecApplyConfiguration) *DatabaseSpecApplyConfiguration {
+	b.InitFrom = value
+	return b
+}
+
+// WithInitScriptsConfigMap sets the InitScriptsConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitScriptsConfigMap field is set to the value of the last call.
+func (b *DatabaseSpecApplyConfiguration) WithInitScriptsConfigMap(value string) *DatabaseSpecApplyConfiguration {
+	b.InitScriptsConfigMap = &value
+	return b
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseStatusApplyConfiguration represents an declarative configuration of the DatabaseStatus type for use
+// with apply.
+type DatabaseStatusApplyConfiguration struct {
+	Phase                   *string                                         `json:"phase,omitempty"`
+	MasterReady             *bool                                           `json:"masterReady,omitempty"`
+	ReplicasReady           *int32                                          `json:"replicasReady,omitempty"`
+	ReplicaEverCreated      *bool                                           `json:"replicaEverCreated,omitempty"`
+	ReplicaLastSeen         *v1.Time                                        `json:"replicaLastSeen,omitempty"`
+	ReplicaDeletionDetected *bool                                           `json:"replicaDeletionDetected,omitempty"`
+	ReplicationReady        *bool                                           `json:"replicationReady,omitempty"`
+	GaleraClusterSize       *int32                                          `json:"galeraClusterSize,omitempty"`
+	GaleraReadyNodes        *int32                                          `json:"galeraReadyNodes,omitempty"`
+	GaleraClusterStatus     *string                                         `json:"galeraClusterStatus,omitempty"`
+	BootstrapNode           *string                                         `json:"bootstrapNode,omitempty"`
+	FailoverHistory         []FailoverEventApplyConfiguration               `json:"failoverHistory,omitempty"`
+	Nodes                   []DatabaseNodeStatusApplyConfiguration          `json:"nodes,omitempty"`
+	InitializedFrom         *string                                         `json:"initializedFrom,omitempty"`
+	Backup                  *BackupStatusApplyConfiguration                 `json:"backup,omitempty"`
+	ReplicationCredentials  *ReplicationCredentialsStatusApplyConfiguration `json:"replicationCredentials,omitempty"`
+}
+
+// DatabaseStatusApplyConfiguration constructs an declarative configuration of the DatabaseStatus type for use with
+// apply.
+func DatabaseStatus() *DatabaseStatusApplyConfiguration {
+	return &DatabaseStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithPhase(value string) *DatabaseStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithMasterReady sets the MasterReady field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MasterReady field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithMasterReady(value bool) *DatabaseStatusApplyConfiguration {
+	b.MasterReady = &value
+	return b
+}
+
+// WithReplicasReady sets the ReplicasReady field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicasReady field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithReplicasReady(value int32) *DatabaseStatusApplyConfiguration {
+	b.ReplicasReady = &value
+	return b
+}
+
+// WithReplicaEverCreated sets the ReplicaEverCreated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaEverCreated field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithReplicaEverCreated(value bool) *DatabaseStatusApplyConfiguration {
+	b.ReplicaEverCreated = &value
+	return b
+}
+
+// WithReplicaLastSeen sets the ReplicaLastSeen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaLastSeen field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithReplicaLastSeen(value v1.Time) *DatabaseStatusApplyConfiguration {
+	b.ReplicaLastSeen = &value
+	return b
+}
+
+// WithReplicaDeletionDetected sets the ReplicaDeletionDetected field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaDeletionDetected field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithReplicaDeletionDetected(value bool) *DatabaseStatusApplyConfiguration {
+	b.ReplicaDeletionDetected = &value
+	return b
+}
+
+// WithReplicationReady sets the ReplicationReady field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicationReady field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithReplicationReady(value bool) *DatabaseStatusApplyConfiguration {
+	b.ReplicationReady = &value
+	return b
+}
+
+// WithGaleraClusterSize sets the GaleraClusterSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GaleraClusterSize field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithGaleraClusterSize(value int32) *DatabaseStatusApplyConfiguration {
+	b.GaleraClusterSize = &value
+	return b
+}
+
+// WithGaleraReadyNodes sets the GaleraReadyNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GaleraReadyNodes field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithGaleraReadyNodes(value int32) *DatabaseStatusApplyConfiguration {
+	b.GaleraReadyNodes = &value
+	return b
+}
+
+// WithGaleraClusterStatus sets the GaleraClusterStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GaleraClusterStatus field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithGaleraClusterStatus(value string) *DatabaseStatusApplyConfiguration {
+	b.GaleraClusterStatus = &value
+	return b
+}
+
+// WithBootstrapNode sets the BootstrapNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapNode field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithBootstrapNode(value string) *DatabaseStatusApplyConfiguration {
+	b.BootstrapNode = &value
+	return b
+}
+
+// WithFailoverHistory adds the given value to the FailoverHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FailoverHistory field.
+func (b *DatabaseStatusApplyConfiguration) WithFailoverHistory(values ...*FailoverEventApplyConfiguration) *DatabaseStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFailoverHistory")
+		}
+		b.FailoverHistory = append(b.FailoverHistory, *values[i])
+	}
+	return b
+}
+
+// WithNodes adds the given value to the Nodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Nodes field.
+func (b *DatabaseStatusApplyConfiguration) WithNodes(values ...*DatabaseNodeStatusApplyConfiguration) *DatabaseStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodes")
+		}
+		b.Nodes = append(b.Nodes, *values[i])
+	}
+	return b
+}
+
+// WithInitializedFrom sets the InitializedFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitializedFrom field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithInitializedFrom(value string) *DatabaseStatusApplyConfiguration {
+	b.InitializedFrom = &value
+	return b
+}
+
+// WithBackup sets the Backup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backup field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithBackup(value *BackupStatusApplyConfiguration) *DatabaseStatusApplyConfiguration {
+	b.Backup = value
+	return b
+}
+
+// WithReplicationCredentials sets the ReplicationCredentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicationCredentials field is set to the value of the last call.
+func (b *DatabaseStatusApplyConfiguration) WithReplicationCredentials(value *ReplicationCredentialsStatusApplyConfiguration) *DatabaseStatusApplyConfiguration {
+	b.ReplicationCredentials = value
+	return b
+}
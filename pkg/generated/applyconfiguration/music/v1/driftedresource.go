@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DriftedResourceApplyConfiguration represents an declarative configuration of the DriftedResource type for use
+// with apply.
+type DriftedResourceApplyConfiguration struct {
+	Kind             *string  `json:"kind,omitempty"`
+	Name             *string  `json:"name,omitempty"`
+	Fields           []string `json:"fields,omitempty"`
+	LastDetectedTime *v1.Time `json:"lastDetectedTime,omitempty"`
+	Reverted         *bool    `json:"reverted,omitempty"`
+	Generation       *int64   `json:"generation,omitempty"`
+}
+
+// DriftedResourceApplyConfiguration constructs an declarative configuration of the DriftedResource type for use with
+// apply.
+func DriftedResource() *DriftedResourceApplyConfiguration {
+	return &DriftedResourceApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *DriftedResourceApplyConfiguration) WithKind(value string) *DriftedResourceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *DriftedResourceApplyConfiguration) WithName(value string) *DriftedResourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithFields adds the given value to the Fields field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Fields field.
+func (b *DriftedResourceApplyConfiguration) WithFields(values ...string) *DriftedResourceApplyConfiguration {
+	for i := range values {
+		b.Fields = append(b.Fields, values[i])
+	}
+	return b
+}
+
+// WithLastDetectedTime sets the LastDetectedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastDetectedTime field is set to the value of the last call.
+func (b *DriftedResourceApplyConfiguration) WithLastDetectedTime(value v1.Time) *DriftedResourceApplyConfiguration {
+	b.LastDetectedTime = &value
+	return b
+}
+
+// WithReverted sets the Reverted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reverted field is set to the value of the last call.
+func (b *DriftedResourceApplyConfiguration) WithReverted(value bool) *DriftedResourceApplyConfiguration {
+	b.Reverted = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *DriftedResourceApplyConfiguration) WithGeneration(value int64) *DriftedResourceApplyConfiguration {
+	b.Generation = &value
+	return b
+}
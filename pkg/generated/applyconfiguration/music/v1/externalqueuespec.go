@@ -0,0 +1,47 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ExternalQueueSpecApplyConfiguration represents an declarative configuration of the ExternalQueueSpec type for use
+// with apply.
+type ExternalQueueSpecApplyConfiguration struct {
+	Brokers           *string `json:"brokers,omitempty"`
+	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
+}
+
+// ExternalQueueSpecApplyConfiguration constructs an declarative configuration of the ExternalQueueSpec type for use with
+// apply.
+func ExternalQueueSpec() *ExternalQueueSpecApplyConfiguration {
+	return &ExternalQueueSpecApplyConfiguration{}
+}
+
+// WithBrokers sets the Brokers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Brokers field is set to the value of the last call.
+func (b *ExternalQueueSpecApplyConfiguration) WithBrokers(value string) *ExternalQueueSpecApplyConfiguration {
+	b.Brokers = &value
+	return b
+}
+
+// WithCredentialsSecret sets the CredentialsSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialsSecret field is set to the value of the last call.
+func (b *ExternalQueueSpecApplyConfiguration) WithCredentialsSecret(value string) *ExternalQueueSpecApplyConfiguration {
+	b.CredentialsSecret = &value
+	return b
+}
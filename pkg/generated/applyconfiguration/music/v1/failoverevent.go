@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FailoverEventApplyConfiguration represents an declarative configuration of the FailoverEvent type for use
+// with apply.
+type FailoverEventApplyConfiguration struct {
+	Time   *v1.Time `json:"time,omitempty"`
+	From   *string  `json:"from,omitempty"`
+	To     *string  `json:"to,omitempty"`
+	Reason *string  `json:"reason,omitempty"`
+}
+
+// FailoverEventApplyConfiguration constructs an declarative configuration of the FailoverEvent type for use with
+// apply.
+func FailoverEvent() *FailoverEventApplyConfiguration {
+	return &FailoverEventApplyConfiguration{}
+}
+
+// WithTime sets the Time field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Time field is set to the value of the last call.
+func (b *FailoverEventApplyConfiguration) WithTime(value v1.Time) *FailoverEventApplyConfiguration {
+	b.Time = &value
+	return b
+}
+
+// WithFrom sets the From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the From field is set to the value of the last call.
+func (b *FailoverEventApplyConfiguration) WithFrom(value string) *FailoverEventApplyConfiguration {
+	b.From = &value
+	return b
+}
+
+// WithTo sets the To field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the To field is set to the value of the last call.
+func (b *FailoverEventApplyConfiguration) WithTo(value string) *FailoverEventApplyConfiguration {
+	b.To = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *FailoverEventApplyConfiguration) WithReason(value string) *FailoverEventApplyConfiguration {
+	b.Reason = &value
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// HealthCheckSpecApplyConfiguration represents an declarative configuration of the HealthCheckSpec type for use
+// with apply.
+type HealthCheckSpecApplyConfiguration struct {
+	Path                  *string             `json:"path,omitempty"`
+	Port                  *intstr.IntOrString `json:"port,omitempty"`
+	Scheme                *v1.URIScheme       `json:"scheme,omitempty"`
+	InitialDelaySeconds   *int32              `json:"initialDelaySeconds,omitempty"`
+	StartupTimeoutSeconds *int32              `json:"startupTimeoutSeconds,omitempty"`
+}
+
+// HealthCheckSpecApplyConfiguration constructs an declarative configuration of the HealthCheckSpec type for use with
+// apply.
+func HealthCheckSpec() *HealthCheckSpecApplyConfiguration {
+	return &HealthCheckSpecApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *HealthCheckSpecApplyConfiguration) WithPath(value string) *HealthCheckSpecApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *HealthCheckSpecApplyConfiguration) WithPort(value intstr.IntOrString) *HealthCheckSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithScheme sets the Scheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheme field is set to the value of the last call.
+func (b *HealthCheckSpecApplyConfiguration) WithScheme(value v1.URIScheme) *HealthCheckSpecApplyConfiguration {
+	b.Scheme = &value
+	return b
+}
+
+// WithInitialDelaySeconds sets the InitialDelaySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitialDelaySeconds field is set to the value of the last call.
+func (b *HealthCheckSpecApplyConfiguration) WithInitialDelaySeconds(value int32) *HealthCheckSpecApplyConfiguration {
+	b.InitialDelaySeconds = &value
+	return b
+}
+
+// WithStartupTimeoutSeconds sets the StartupTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartupTimeoutSeconds field is set to the value of the last call.
+func (b *HealthCheckSpecApplyConfiguration) WithStartupTimeoutSeconds(value int32) *HealthCheckSpecApplyConfiguration {
+	b.StartupTimeoutSeconds = &value
+	return b
+}
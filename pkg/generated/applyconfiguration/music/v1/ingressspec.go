@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// IngressSpecApplyConfiguration represents an declarative configuration of the IngressSpec type for use
+// with apply.
+type IngressSpecApplyConfiguration struct {
+	Host           *string                              `json:"host,omitempty"`
+	ClassName      *string                              `json:"className,omitempty"`
+	TLSSecretName  *string                              `json:"tlsSecretName,omitempty"`
+	StickySessions *StickySessionSpecApplyConfiguration `json:"stickySessions,omitempty"`
+}
+
+// IngressSpecApplyConfiguration constructs an declarative configuration of the IngressSpec type for use with
+// apply.
+func IngressSpec() *IngressSpecApplyConfiguration {
+	return &IngressSpecApplyConfiguration{}
+}
+
+// WithHost sets the Host field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Host field is set to the value of the last call.
+func (b *IngressSpecApplyConfiguration) WithHost(value string) *IngressSpecApplyConfiguration {
+	b.Host = &value
+	return b
+}
+
+// WithClassName sets the ClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClassName field is set to the value of the last call.
+func (b *IngressSpecApplyConfiguration) WithClassName(value string) *IngressSpecApplyConfiguration {
+	b.ClassName = &value
+	return b
+}
+
+// WithTLSSecretName sets the TLSSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSSecretName field is set to the value of the last call.
+func (b *IngressSpecApplyConfiguration) WithTLSSecretName(value string) *IngressSpecApplyConfiguration {
+	b.TLSSecretName = &value
+	return b
+}
+
+// WithStickySessions sets the StickySessions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StickySessions field is set to the value of the last call.
+func (b *IngressSpecApplyConfiguration) WithStickySessions(value *StickySessionSpecApplyConfiguration) *IngressSpecApplyConfiguration {
+	b.StickySessions = value
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// LibraryBucketSourceApplyConfiguration represents an declarative configuration of the LibraryBucketSource type for use
+// with apply.
+type LibraryBucketSourceApplyConfiguration struct {
+	Driver           *string           `json:"driver,omitempty"`
+	Name             *string           `json:"name,omitempty"`
+	VolumeAttributes map[string]string `json:"volumeAttributes,omitempty"`
+	Capacity         *string           `json:"capacity,omitempty"`
+}
+
+// LibraryBucketSourceApplyConfiguration constructs an declarative configuration of the LibraryBucketSource type for use with
+// apply.
+func LibraryBucketSource() *LibraryBucketSourceApplyConfiguration {
+	return &LibraryBucketSourceApplyConfiguration{}
+}
+
+// WithDriver sets the Driver field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Driver field is set to the value of the last call.
+func (b *LibraryBucketSourceApplyConfiguration) WithDriver(value string) *LibraryBucketSourceApplyConfiguration {
+	b.Driver = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LibraryBucketSourceApplyConfiguration) WithName(value string) *LibraryBucketSourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVolumeAttributes puts the entries into the VolumeAttributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the VolumeAttributes field,
+// overwriting an existing map entries in VolumeAttributes field with the same key.
+func (b *LibraryBucketSourceApplyConfiguration) WithVolumeAttributes(entries map[string]string) *LibraryBucketSourceApplyConfiguration {
+	if b.VolumeAttributes == nil && len(entries) > 0 {
+		b.VolumeAttributes = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.VolumeAttributes[k] = v
+	}
+	return b
+}
+
+// WithCapacity sets the Capacity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capacity field is set to the value of the last call.
+func (b *LibraryBucketSourceApplyConfiguration) WithCapacity(value string) *LibraryBucketSourceApplyConfiguration {
+	b.Capacity = &value
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MonitoringSpecApplyConfiguration represents an declarative configuration of the MonitoringSpec type for use
+// with apply.
+type MonitoringSpecApplyConfiguration struct {
+	Enabled  *bool             `json:"enabled,omitempty"`
+	Path     *string           `json:"path,omitempty"`
+	Port     *int32            `json:"port,omitempty"`
+	Interval *string           `json:"interval,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+}
+
+// MonitoringSpecApplyConfiguration constructs an declarative configuration of the MonitoringSpec type for use with
+// apply.
+func MonitoringSpec() *MonitoringSpecApplyConfiguration {
+	return &MonitoringSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *MonitoringSpecApplyConfiguration) WithEnabled(value bool) *MonitoringSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *MonitoringSpecApplyConfiguration) WithPath(value string) *MonitoringSpecApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *MonitoringSpecApplyConfiguration) WithPort(value int32) *MonitoringSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithInterval sets the Interval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interval field is set to the value of the last call.
+func (b *MonitoringSpecApplyConfiguration) WithInterval(value string) *MonitoringSpecApplyConfiguration {
+	b.Interval = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *MonitoringSpecApplyConfiguration) WithLabels(entries map[string]string) *MonitoringSpecApplyConfiguration {
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
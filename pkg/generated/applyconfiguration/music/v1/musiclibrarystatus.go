@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MusicLibraryStatusApplyConfiguration represents an declarative configuration of the MusicLibraryStatus type for use
+// with apply.
+type MusicLibraryStatusApplyConfiguration struct {
+	Phase      *string                          `json:"phase,omitempty"`
+	ClaimName  *string                          `json:"claimName,omitempty"`
+	Consumers  []string                         `json:"consumers,omitempty"`
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// MusicLibraryStatusApplyConfiguration constructs an declarative configuration of the MusicLibraryStatus type for use with
+// apply.
+func MusicLibraryStatus() *MusicLibraryStatusApplyConfiguration {
+	return &MusicLibraryStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *MusicLibraryStatusApplyConfiguration) WithPhase(value string) *MusicLibraryStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithClaimName sets the ClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimName field is set to the value of the last call.
+func (b *MusicLibraryStatusApplyConfiguration) WithClaimName(value string) *MusicLibraryStatusApplyConfiguration {
+	b.ClaimName = &value
+	return b
+}
+
+// WithConsumers adds the given value to the Consumers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Consumers field.
+func (b *MusicLibraryStatusApplyConfiguration) WithConsumers(values ...string) *MusicLibraryStatusApplyConfiguration {
+	for i := range values {
+		b.Consumers = append(b.Consumers, values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MusicLibraryStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *MusicLibraryStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
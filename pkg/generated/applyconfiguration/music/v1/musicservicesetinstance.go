@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MusicServiceSetInstanceApplyConfiguration represents an declarative configuration of the MusicServiceSetInstance type for use
+// with apply.
+type MusicServiceSetInstanceApplyConfiguration struct {
+	Tenant  *string `json:"tenant,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Phase   *string `json:"phase,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// MusicServiceSetInstanceApplyConfiguration constructs an declarative configuration of the MusicServiceSetInstance type for use with
+// apply.
+func MusicServiceSetInstance() *MusicServiceSetInstanceApplyConfiguration {
+	return &MusicServiceSetInstanceApplyConfiguration{}
+}
+
+// WithTenant sets the Tenant field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tenant field is set to the value of the last call.
+func (b *MusicServiceSetInstanceApplyConfiguration) WithTenant(value string) *MusicServiceSetInstanceApplyConfiguration {
+	b.Tenant = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MusicServiceSetInstanceApplyConfiguration) WithName(value string) *MusicServiceSetInstanceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *MusicServiceSetInstanceApplyConfiguration) WithPhase(value string) *MusicServiceSetInstanceApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *MusicServiceSetInstanceApplyConfiguration) WithMessage(value string) *MusicServiceSetInstanceApplyConfiguration {
+	b.Message = &value
+	return b
+}
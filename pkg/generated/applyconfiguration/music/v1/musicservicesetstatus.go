@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MusicServiceSetStatusApplyConfiguration represents an declarative configuration of the MusicServiceSetStatus type for use
+// with apply.
+type MusicServiceSetStatusApplyConfiguration struct {
+	ObservedGeneration *int64                                      `json:"observedGeneration,omitempty"`
+	Total              *int32                                      `json:"total,omitempty"`
+	Ready              *int32                                      `json:"ready,omitempty"`
+	Instances          []MusicServiceSetInstanceApplyConfiguration `json:"instances,omitempty"`
+	Conditions         []metav1.ConditionApplyConfiguration        `json:"conditions,omitempty"`
+}
+
+// MusicServiceSetStatusApplyConfiguration constructs an declarative configuration of the MusicServiceSetStatus type for use with
+// apply.
+func MusicServiceSetStatus() *MusicServiceSetStatusApplyConfiguration {
+	return &MusicServiceSetStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MusicServiceSetStatusApplyConfiguration) WithObservedGeneration(value int64) *MusicServiceSetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithTotal sets the Total field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Total field is set to the value of the last call.
+func (b *MusicServiceSetStatusApplyConfiguration) WithTotal(value int32) *MusicServiceSetStatusApplyConfiguration {
+	b.Total = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *MusicServiceSetStatusApplyConfiguration) WithReady(value int32) *MusicServiceSetStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithInstances adds the given value to the Instances field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Instances field.
+func (b *MusicServiceSetStatusApplyConfiguration) WithInstances(values ...*MusicServiceSetInstanceApplyConfiguration) *MusicServiceSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithInstances")
+		}
+		b.Instances = append(b.Instances, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MusicServiceSetStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *MusicServiceSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,475 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MusicServiceSpecApplyConfiguration represents an declarative configuration of the MusicServiceSpec type for use
+// with apply.
+type MusicServiceSpecApplyConfiguration struct {
+	Replicas                *int32                                     `json:"replicas,omitempty"`
+	WorkloadType            *v1.WorkloadType                           `json:"workloadType,omitempty"`
+	PodManagementPolicy     *appsv1.PodManagementPolicyType            `json:"podManagementPolicy,omitempty"`
+	DriftPolicy             *v1.DriftPolicy                            `json:"driftPolicy,omitempty"`
+	MinReadySeconds         *int32                                     `json:"minReadySeconds,omitempty"`
+	Image                   *string                                    `json:"image,omitempty"`
+	ImagePullPolicy         *corev1.PullPolicy                         `json:"imagePullPolicy,omitempty"`
+	RegistryCredentials     *RegistryCredentialsSpecApplyConfiguration `json:"registryCredentials,omitempty"`
+	Command                 []string                                   `json:"command,omitempty"`
+	Args                    []string                                   `json:"args,omitempty"`
+	RuntimeClassName        *string                                    `json:"runtimeClassName,omitempty"`
+	Port                    *int32                                     `json:"port,omitempty"`
+	ContainerPort           *int32                                     `json:"containerPort,omitempty"`
+	Storage                 *StorageSpecApplyConfiguration             `json:"storage,omitempty"`
+	Snapshots               *SnapshotSpecApplyConfiguration            `json:"snapshots,omitempty"`
+	Streaming               *StreamingSpecApplyConfiguration           `json:"streaming,omitempty"`
+	Resources               *corev1.ResourceRequirements               `json:"resources,omitempty"`
+	GPU                     *GPUSpecApplyConfiguration                 `json:"gpu,omitempty"`
+	QoS                     *v1.QoSMode                                `json:"qos,omitempty"`
+	Autoscaling             *AutoscalingSpecApplyConfiguration         `json:"autoscaling,omitempty"`
+	Database                *DatabaseSpecApplyConfiguration            `json:"database,omitempty"`
+	Queue                   *QueueSpecApplyConfiguration               `json:"queue,omitempty"`
+	Search                  *SearchSpecApplyConfiguration              `json:"search,omitempty"`
+	SessionStore            *SessionStoreSpecApplyConfiguration        `json:"sessionStore,omitempty"`
+	ObjectStorage           *ObjectStorageSpecApplyConfiguration       `json:"objectStorage,omitempty"`
+	Tenancy                 *TenancySpecApplyConfiguration             `json:"tenancy,omitempty"`
+	Libraries               []LibraryMountApplyConfiguration           `json:"libraries,omitempty"`
+	Seed                    *SeedSpecApplyConfiguration                `json:"seed,omitempty"`
+	SeedInitContainer       *SeedInitContainerSpecApplyConfiguration   `json:"seedInitContainer,omitempty"`
+	ReadPool                *ReadPoolSpecApplyConfiguration            `json:"readPool,omitempty"`
+	Shards                  []AppShardSpecApplyConfiguration           `json:"shards,omitempty"`
+	Partitioning            *PartitioningSpecApplyConfiguration        `json:"partitioning,omitempty"`
+	HealthCheck             *HealthCheckSpecApplyConfiguration         `json:"healthCheck,omitempty"`
+	ConnectionMetrics       *ConnectionMetricsSpecApplyConfiguration   `json:"connectionMetrics,omitempty"`
+	TTLSecondsAfterCreation *int64                                     `json:"ttlSecondsAfterCreation,omitempty"`
+	TTLSecondsAfterLastUse  *int64                                     `json:"ttlSecondsAfterLastUse,omitempty"`
+	Service                 *ServiceOptionsSpecApplyConfiguration      `json:"service,omitempty"`
+	Ingress                 *IngressSpecApplyConfiguration             `json:"ingress,omitempty"`
+	TLSPassthrough          *TLSPassthroughSpecApplyConfiguration      `json:"tlsPassthrough,omitempty"`
+	Monitoring              *MonitoringSpecApplyConfiguration          `json:"monitoring,omitempty"`
+	AccessLogs              *AccessLogShippingSpecApplyConfiguration   `json:"accessLogs,omitempty"`
+	Logs                    *AppLogsSpecApplyConfiguration             `json:"logs,omitempty"`
+	Config                  *AppConfigSpecApplyConfiguration           `json:"config,omitempty"`
+	SafeToEvict             *bool                                      `json:"safeToEvict,omitempty"`
+	Labels                  map[string]string                          `json:"labels,omitempty"`
+	Annotations             map[string]string                          `json:"annotations,omitempty"`
+}
+
+// MusicServiceSpecApplyConfiguration constructs an declarative configuration of the MusicServiceSpec type for use with
+// apply.
+func MusicServiceSpec() *MusicServiceSpecApplyConfiguration {
+	return &MusicServiceSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithReplicas(value int32) *MusicServiceSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithWorkloadType sets the WorkloadType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkloadType field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithWorkloadType(value v1.WorkloadType) *MusicServiceSpecApplyConfiguration {
+	b.WorkloadType = &value
+	return b
+}
+
+// WithPodManagementPolicy sets the PodManagementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodManagementPolicy field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithPodManagementPolicy(value appsv1.PodManagementPolicyType) *MusicServiceSpecApplyConfiguration {
+	b.PodManagementPolicy = &value
+	return b
+}
+
+// WithDriftPolicy sets the DriftPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DriftPolicy field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithDriftPolicy(value v1.DriftPolicy) *MusicServiceSpecApplyConfiguration {
+	b.DriftPolicy = &value
+	return b
+}
+
+// WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReadySeconds field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithMinReadySeconds(value int32) *MusicServiceSpecApplyConfiguration {
+	b.MinReadySeconds = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithImage(value string) *MusicServiceSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithImagePullPolicy(value corev1.PullPolicy) *MusicServiceSpecApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithRegistryCredentials sets the RegistryCredentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RegistryCredentials field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithRegistryCredentials(value *RegistryCredentialsSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.RegistryCredentials = value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *MusicServiceSpecApplyConfiguration) WithCommand(values ...string) *MusicServiceSpecApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *MusicServiceSpecApplyConfiguration) WithArgs(values ...string) *MusicServiceSpecApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithRuntimeClassName sets the RuntimeClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuntimeClassName field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithRuntimeClassName(value string) *MusicServiceSpecApplyConfiguration {
+	b.RuntimeClassName = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithPort(value int32) *MusicServiceSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithContainerPort sets the ContainerPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerPort field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithContainerPort(value int32) *MusicServiceSpecApplyConfiguration {
+	b.ContainerPort = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithStorage(value *StorageSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithSnapshots sets the Snapshots field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Snapshots field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithSnapshots(value *SnapshotSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Snapshots = value
+	return b
+}
+
+// WithStreaming sets the Streaming field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Streaming field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithStreaming(value *StreamingSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Streaming = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *MusicServiceSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithGPU sets the GPU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GPU field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithGPU(value *GPUSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.GPU = value
+	return b
+}
+
+// WithQoS sets the QoS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QoS field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithQoS(value v1.QoSMode) *MusicServiceSpecApplyConfiguration {
+	b.QoS = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithDatabase sets the Database field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Database field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithDatabase(value *DatabaseSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Database = value
+	return b
+}
+
+// WithQueue sets the Queue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Queue field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithQueue(value *QueueSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Queue = value
+	return b
+}
+
+// WithSearch sets the Search field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Search field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithSearch(value *SearchSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Search = value
+	return b
+}
+
+// WithSessionStore sets the SessionStore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionStore field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithSessionStore(value *SessionStoreSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.SessionStore = value
+	return b
+}
+
+// WithObjectStorage sets the ObjectStorage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectStorage field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithObjectStorage(value *ObjectStorageSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.ObjectStorage = value
+	return b
+}
+
+// WithTenancy sets the Tenancy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tenancy field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithTenancy(value *TenancySpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Tenancy = value
+	return b
+}
+
+// WithLibraries adds the given value to the Libraries field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Libraries field.
+func (b *MusicServiceSpecApplyConfiguration) WithLibraries(values ...*LibraryMountApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLibraries")
+		}
+		b.Libraries = append(b.Libraries, *values[i])
+	}
+	return b
+}
+
+// WithSeed sets the Seed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Seed field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithSeed(value *SeedSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Seed = value
+	return b
+}
+
+// WithSeedInitContainer sets the SeedInitContainer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SeedInitContainer field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithSeedInitContainer(value *SeedInitContainerSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.SeedInitContainer = value
+	return b
+}
+
+// WithReadPool sets the ReadPool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadPool field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithReadPool(value *ReadPoolSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.ReadPool = value
+	return b
+}
+
+// WithShards adds the given value to the Shards field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Shards field.
+func (b *MusicServiceSpecApplyConfiguration) WithShards(values ...*AppShardSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithShards")
+		}
+		b.Shards = append(b.Shards, *values[i])
+	}
+	return b
+}
+
+// WithPartitioning sets the Partitioning field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Partitioning field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithPartitioning(value *PartitioningSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Partitioning = value
+	return b
+}
+
+// WithHealthCheck sets the HealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheck field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithHealthCheck(value *HealthCheckSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.HealthCheck = value
+	return b
+}
+
+// WithConnectionMetrics sets the ConnectionMetrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectionMetrics field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithConnectionMetrics(value *ConnectionMetricsSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.ConnectionMetrics = value
+	return b
+}
+
+// WithTTLSecondsAfterCreation sets the TTLSecondsAfterCreation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLSecondsAfterCreation field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithTTLSecondsAfterCreation(value int64) *MusicServiceSpecApplyConfiguration {
+	b.TTLSecondsAfterCreation = &value
+	return b
+}
+
+// WithTTLSecondsAfterLastUse sets the TTLSecondsAfterLastUse field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLSecondsAfterLastUse field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithTTLSecondsAfterLastUse(value int64) *MusicServiceSpecApplyConfiguration {
+	b.TTLSecondsAfterLastUse = &value
+	return b
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithService(value *ServiceOptionsSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Service = value
+	return b
+}
+
+// WithIngress sets the Ingress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ingress field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithIngress(value *IngressSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Ingress = value
+	return b
+}
+
+// WithTLSPassthrough sets the TLSPassthrough field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSPassthrough field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithTLSPassthrough(value *TLSPassthroughSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.TLSPassthrough = value
+	return b
+}
+
+// WithMonitoring sets the Monitoring field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Monitoring field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithMonitoring(value *MonitoringSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Monitoring = value
+	return b
+}
+
+// WithAccessLogs sets the AccessLogs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccessLogs field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithAccessLogs(value *AccessLogShippingSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.AccessLogs = value
+	return b
+}
+
+// WithLogs sets the Logs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logs field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithLogs(value *AppLogsSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Logs = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithConfig(value *AppConfigSpecApplyConfiguration) *MusicServiceSpecApplyConfiguration {
+	b.Config = value
+	return b
+}
+
+// WithSafeToEvict sets the SafeToEvict field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SafeToEvict field is set to the value of the last call.
+func (b *MusicServiceSpecApplyConfiguration) WithSafeToEvict(value bool) *MusicServiceSpecApplyConfiguration {
+	b.SafeToEvict = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *MusicServiceSpecApplyConfiguration) WithLabels(entries map[string]string) *MusicServiceSpecApplyConfiguration {
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *MusicServiceSpecApplyConfiguration) WithAnnotations(entries map[string]string) *MusicServiceSpecApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MusicServiceStatusApplyConfiguration represents an declarative configuration of the MusicServiceStatus type for use
+// with apply.
+type MusicServiceStatusApplyConfiguration struct {
+	ObservedGeneration     *int64                                       `json:"observedGeneration,omitempty"`
+	DesiredReplicas        *int32                                       `json:"desiredReplicas,omitempty"`
+	ReadyReplicas          *int32                                       `json:"readyReplicas,omitempty"`
+	Phase                  *string                                      `json:"phase,omitempty"`
+	LastReconcileTime      *v1.Time                                     `json:"lastReconcileTime,omitempty"`
+	LastError              *string                                      `json:"lastError,omitempty"`
+	LastAppliedSpecHash    *string                                      `json:"lastAppliedSpecHash,omitempty"`
+	Conditions             []metav1.ConditionApplyConfiguration         `json:"conditions,omitempty"`
+	Database               *DatabaseStatusApplyConfiguration            `json:"database,omitempty"`
+	Footprint              *ResourceFootprintApplyConfiguration         `json:"footprint,omitempty"`
+	TenantNamespace        *string                                      `json:"tenantNamespace,omitempty"`
+	Seed                   *SeedStatusApplyConfiguration                `json:"seed,omitempty"`
+	StorageMigration       *StorageMigrationStatusApplyConfiguration    `json:"storageMigration,omitempty"`
+	Volumes                []VolumeUsageStatusApplyConfiguration        `json:"volumes,omitempty"`
+	Components             map[string]ComponentStatusApplyConfiguration `json:"components,omitempty"`
+	TotalActiveConnections *int64                                       `json:"totalActiveConnections,omitempty"`
+	ConnectionsPerReplica  *int64                                       `json:"connectionsPerReplica,omitempty"`
+	LastUsedTime           *v1.Time                                     `json:"lastUsedTime,omitempty"`
+	ExpirationTime         *v1.Time                                     `json:"expirationTime,omitempty"`
+	Shards                 []ShardStatusApplyConfiguration              `json:"shards,omitempty"`
+	Partitioning           *PartitioningStatusApplyConfiguration        `json:"partitioning,omitempty"`
+	Drift                  []DriftedResourceApplyConfiguration          `json:"drift,omitempty"`
+}
+
+// MusicServiceStatusApplyConfiguration constructs an declarative configuration of the MusicServiceStatus type for use with
+// apply.
+func MusicServiceStatus() *MusicServiceStatusApplyConfiguration {
+	return &MusicServiceStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithObservedGeneration(value int64) *MusicServiceStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithDesiredReplicas sets the DesiredReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DesiredReplicas field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithDesiredReplicas(value int32) *MusicServiceStatusApplyConfiguration {
+	b.DesiredReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithReadyReplicas(value int32) *MusicServiceStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithPhase(value string) *MusicServiceStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithLastReconcileTime sets the LastReconcileTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastReconcileTime field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithLastReconcileTime(value v1.Time) *MusicServiceStatusApplyConfiguration {
+	b.LastReconcileTime = &value
+	return b
+}
+
+// WithLastError sets the LastError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastError field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithLastError(value string) *MusicServiceStatusApplyConfiguration {
+	b.LastError = &value
+	return b
+}
+
+// WithLastAppliedSpecHash sets the LastAppliedSpecHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastAppliedSpecHash field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithLastAppliedSpecHash(value string) *MusicServiceStatusApplyConfiguration {
+	b.LastAppliedSpecHash = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MusicServiceStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithDatabase sets the Database field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Database field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithDatabase(value *DatabaseStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	b.Database = value
+	return b
+}
+
+// WithFootprint sets the Footprint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Footprint field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithFootprint(value *ResourceFootprintApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	b.Footprint = value
+	return b
+}
+
+// WithTenantNamespace sets the TenantNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TenantNamespace field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithTenantNamespace(value string) *MusicServiceStatusApplyConfiguration {
+	b.TenantNamespace = &value
+	return b
+}
+
+// WithSeed sets the Seed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Seed field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithSeed(value *SeedStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	b.Seed = value
+	return b
+}
+
+// WithStorageMigration sets the StorageMigration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageMigration field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithStorageMigration(value *StorageMigrationStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	b.StorageMigration = value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *MusicServiceStatusApplyConfiguration) WithVolumes(values ...*VolumeUsageStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
+
+// WithComponents puts the entries into the Components field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Components field,
+// overwriting an existing map entries in Components field with the same key.
+func (b *MusicServiceStatusApplyConfiguration) WithComponents(entries map[string]ComponentStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	if b.Components == nil && len(entries) > 0 {
+		b.Components = make(map[string]ComponentStatusApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Components[k] = v
+	}
+	return b
+}
+
+// WithTotalActiveConnections sets the TotalActiveConnections field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalActiveConnections field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithTotalActiveConnections(value int64) *MusicServiceStatusApplyConfiguration {
+	b.TotalActiveConnections = &value
+	return b
+}
+
+// WithConnectionsPerReplica sets the ConnectionsPerReplica field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectionsPerReplica field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithConnectionsPerReplica(value int64) *MusicServiceStatusApplyConfiguration {
+	b.ConnectionsPerReplica = &value
+	return b
+}
+
+// WithLastUsedTime sets the LastUsedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUsedTime field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithLastUsedTime(value v1.Time) *MusicServiceStatusApplyConfiguration {
+	b.LastUsedTime = &value
+	return b
+}
+
+// WithExpirationTime sets the ExpirationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpirationTime field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithExpirationTime(value v1.Time) *MusicServiceStatusApplyConfiguration {
+	b.ExpirationTime = &value
+	return b
+}
+
+// WithShards adds the given value to the Shards field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Shards field.
+func (b *MusicServiceStatusApplyConfiguration) WithShards(values ...*ShardStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithShards")
+		}
+		b.Shards = append(b.Shards, *values[i])
+	}
+	return b
+}
+
+// WithPartitioning sets the Partitioning field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Partitioning field is set to the value of the last call.
+func (b *MusicServiceStatusApplyConfiguration) WithPartitioning(value *PartitioningStatusApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	b.Partitioning = value
+	return b
+}
+
+// WithDrift adds the given value to the Drift field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drift field.
+func (b *MusicServiceStatusApplyConfiguration) WithDrift(values ...*DriftedResourceApplyConfiguration) *MusicServiceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDrift")
+		}
+		b.Drift = append(b.Drift, *values[i])
+	}
+	return b
+}
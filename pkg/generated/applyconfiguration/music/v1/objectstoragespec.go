@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ObjectStorageSpecApplyConfiguration represents an declarative configuration of the ObjectStorageSpec type for use
+// with apply.
+type ObjectStorageSpecApplyConfiguration struct {
+	Image     *string                        `json:"image,omitempty"`
+	Storage   *StorageSpecApplyConfiguration `json:"storage,omitempty"`
+	Resources *corev1.ResourceRequirements   `json:"resources,omitempty"`
+	Buckets   []string                       `json:"buckets,omitempty"`
+}
+
+// ObjectStorageSpecApplyConfiguration constructs an declarative configuration of the ObjectStorageSpec type for use with
+// apply.
+func ObjectStorageSpec() *ObjectStorageSpecApplyConfiguration {
+	return &ObjectStorageSpecApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ObjectStorageSpecApplyConfiguration) WithImage(value string) *ObjectStorageSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *ObjectStorageSpecApplyConfiguration) WithStorage(value *StorageSpecApplyConfiguration) *ObjectStorageSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ObjectStorageSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *ObjectStorageSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithBuckets adds the given value to the Buckets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Buckets field.
+func (b *ObjectStorageSpecApplyConfiguration) WithBuckets(values ...string) *ObjectStorageSpecApplyConfiguration {
+	for i := range values {
+		b.Buckets = append(b.Buckets, values[i])
+	}
+	return b
+}
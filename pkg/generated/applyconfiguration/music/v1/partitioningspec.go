@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// PartitioningSpecApplyConfiguration represents an declarative configuration of the PartitioningSpec type for use
+// with apply.
+type PartitioningSpecApplyConfiguration struct {
+	Strategy     *v1.PartitionStrategy `json:"strategy,omitempty"`
+	HashBuckets  *int32                `json:"hashBuckets,omitempty"`
+	DefaultShard *string               `json:"defaultShard,omitempty"`
+}
+
+// PartitioningSpecApplyConfiguration constructs an declarative configuration of the PartitioningSpec type for use with
+// apply.
+func PartitioningSpec() *PartitioningSpecApplyConfiguration {
+	return &PartitioningSpecApplyConfiguration{}
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *PartitioningSpecApplyConfiguration) WithStrategy(value v1.PartitionStrategy) *PartitioningSpecApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
+
+// WithHashBuckets sets the HashBuckets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HashBuckets field is set to the value of the last call.
+func (b *PartitioningSpecApplyConfiguration) WithHashBuckets(value int32) *PartitioningSpecApplyConfiguration {
+	b.HashBuckets = &value
+	return b
+}
+
+// WithDefaultShard sets the DefaultShard field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultShard field is set to the value of the last call.
+func (b *PartitioningSpecApplyConfiguration) WithDefaultShard(value string) *PartitioningSpecApplyConfiguration {
+	b.DefaultShard = &value
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// PartitioningStatusApplyConfiguration represents an declarative configuration of the PartitioningStatus type for use
+// with apply.
+type PartitioningStatusApplyConfiguration struct {
+	Strategy    *v1.PartitionStrategy              `json:"strategy,omitempty"`
+	HashBuckets *int32                             `json:"hashBuckets,omitempty"`
+	Assignments []ShardPartitionApplyConfiguration `json:"assignments,omitempty"`
+}
+
+// PartitioningStatusApplyConfiguration constructs an declarative configuration of the PartitioningStatus type for use with
+// apply.
+func PartitioningStatus() *PartitioningStatusApplyConfiguration {
+	return &PartitioningStatusApplyConfiguration{}
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *PartitioningStatusApplyConfiguration) WithStrategy(value v1.PartitionStrategy) *PartitioningStatusApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
+
+// WithHashBuckets sets the HashBuckets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HashBuckets field is set to the value of the last call.
+func (b *PartitioningStatusApplyConfiguration) WithHashBuckets(value int32) *PartitioningStatusApplyConfiguration {
+	b.HashBuckets = &value
+	return b
+}
+
+// WithAssignments adds the given value to the Assignments field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Assignments field.
+func (b *PartitioningStatusApplyConfiguration) WithAssignments(values ...*ShardPartitionApplyConfiguration) *PartitioningStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAssignments")
+		}
+		b.Assignments = append(b.Assignments, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ProbeTimingSpecApplyConfiguration represents an declarative configuration of the ProbeTimingSpec type for use
+// with apply.
+type ProbeTimingSpecApplyConfiguration struct {
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       *int32 `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      *int32 `json:"timeoutSeconds,omitempty"`
+	FailureThreshold    *int32 `json:"failureThreshold,omitempty"`
+}
+
+// ProbeTimingSpecApplyConfiguration constructs an declarative configuration of the ProbeTimingSpec type for use with
+// apply.
+func ProbeTimingSpec() *ProbeTimingSpecApplyConfiguration {
+	return &ProbeTimingSpecApplyConfiguration{}
+}
+
+// WithInitialDelaySeconds sets the InitialDelaySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitialDelaySeconds field is set to the value of the last call.
+func (b *ProbeTimingSpecApplyConfiguration) WithInitialDelaySeconds(value int32) *ProbeTimingSpecApplyConfiguration {
+	b.InitialDelaySeconds = &value
+	return b
+}
+
+// WithPeriodSeconds sets the PeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PeriodSeconds field is set to the value of the last call.
+func (b *ProbeTimingSpecApplyConfiguration) WithPeriodSeconds(value int32) *ProbeTimingSpecApplyConfiguration {
+	b.PeriodSeconds = &value
+	return b
+}
+
+// WithTimeoutSeconds sets the TimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutSeconds field is set to the value of the last call.
+func (b *ProbeTimingSpecApplyConfiguration) WithTimeoutSeconds(value int32) *ProbeTimingSpecApplyConfiguration {
+	b.TimeoutSeconds = &value
+	return b
+}
+
+// WithFailureThreshold sets the FailureThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailureThreshold field is set to the value of the last call.
+func (b *ProbeTimingSpecApplyConfiguration) WithFailureThreshold(value int32) *ProbeTimingSpecApplyConfiguration {
+	b.FailureThreshold = &value
+	return b
+}
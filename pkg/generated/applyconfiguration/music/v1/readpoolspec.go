@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ReadPoolSpecApplyConfiguration represents an declarative configuration of the ReadPoolSpec type for use
+// with apply.
+type ReadPoolSpecApplyConfiguration struct {
+	Enabled     *bool                              `json:"enabled,omitempty"`
+	Replicas    *int32                             `json:"replicas,omitempty"`
+	Image       *string                            `json:"image,omitempty"`
+	Resources   *v1.ResourceRequirements           `json:"resources,omitempty"`
+	Autoscaling *AutoscalingSpecApplyConfiguration `json:"autoscaling,omitempty"`
+}
+
+// ReadPoolSpecApplyConfiguration constructs an declarative configuration of the ReadPoolSpec type for use with
+// apply.
+func ReadPoolSpec() *ReadPoolSpecApplyConfiguration {
+	return &ReadPoolSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *ReadPoolSpecApplyConfiguration) WithEnabled(value bool) *ReadPoolSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ReadPoolSpecApplyConfiguration) WithReplicas(value int32) *ReadPoolSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ReadPoolSpecApplyConfiguration) WithImage(value string) *ReadPoolSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ReadPoolSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *ReadPoolSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *ReadPoolSpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *ReadPoolSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
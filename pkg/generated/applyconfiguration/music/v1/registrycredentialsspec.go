@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// RegistryCredentialsSpecApplyConfiguration represents an declarative configuration of the RegistryCredentialsSpec type for use
+// with apply.
+type RegistryCredentialsSpecApplyConfiguration struct {
+	SecretName        *string               `json:"secretName,omitempty"`
+	Server            *string               `json:"server,omitempty"`
+	Username          *string               `json:"username,omitempty"`
+	PasswordSecretRef *v1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// RegistryCredentialsSpecApplyConfiguration constructs an declarative configuration of the RegistryCredentialsSpec type for use with
+// apply.
+func RegistryCredentialsSpec() *RegistryCredentialsSpecApplyConfiguration {
+	return &RegistryCredentialsSpecApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *RegistryCredentialsSpecApplyConfiguration) WithSecretName(value string) *RegistryCredentialsSpecApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithServer sets the Server field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Server field is set to the value of the last call.
+func (b *RegistryCredentialsSpecApplyConfiguration) WithServer(value string) *RegistryCredentialsSpecApplyConfiguration {
+	b.Server = &value
+	return b
+}
+
+// WithUsername sets the Username field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Username field is set to the value of the last call.
+func (b *RegistryCredentialsSpecApplyConfiguration) WithUsername(value string) *RegistryCredentialsSpecApplyConfiguration {
+	b.Username = &value
+	return b
+}
+
+// WithPasswordSecretRef sets the PasswordSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PasswordSecretRef field is set to the value of the last call.
+func (b *RegistryCredentialsSpecApplyConfiguration) WithPasswordSecretRef(value v1.SecretKeySelector) *RegistryCredentialsSpecApplyConfiguration {
+	b.PasswordSecretRef = &value
+	return b
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicationCredentialsStatusApplyConfiguration represents an declarative configuration of the ReplicationCredentialsStatus type for use
+// with apply.
+type ReplicationCredentialsStatusApplyConfiguration struct {
+	Phase            *string  `json:"phase,omitempty"`
+	User             *string  `json:"user,omitempty"`
+	PreviousUser     *string  `json:"previousUser,omitempty"`
+	StartedAt        *v1.Time `json:"startedAt,omitempty"`
+	LastRotationTime *v1.Time `json:"lastRotationTime,omitempty"`
+	LastTrigger      *string  `json:"lastTrigger,omitempty"`
+	Message          *string  `json:"message,omitempty"`
+}
+
+// ReplicationCredentialsStatusApplyConfiguration constructs an declarative configuration of the ReplicationCredentialsStatus type for use with
+// apply.
+func ReplicationCredentialsStatus() *ReplicationCredentialsStatusApplyConfiguration {
+	return &ReplicationCredentialsStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithPhase(value string) *ReplicationCredentialsStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithUser sets the User field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the User field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithUser(value string) *ReplicationCredentialsStatusApplyConfiguration {
+	b.User = &value
+	return b
+}
+
+// WithPreviousUser sets the PreviousUser field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousUser field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithPreviousUser(value string) *ReplicationCredentialsStatusApplyConfiguration {
+	b.PreviousUser = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithStartedAt(value v1.Time) *ReplicationCredentialsStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithLastRotationTime sets the LastRotationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRotationTime field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithLastRotationTime(value v1.Time) *ReplicationCredentialsStatusApplyConfiguration {
+	b.LastRotationTime = &value
+	return b
+}
+
+// WithLastTrigger sets the LastTrigger field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTrigger field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithLastTrigger(value string) *ReplicationCredentialsStatusApplyConfiguration {
+	b.LastTrigger = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ReplicationCredentialsStatusApplyConfiguration) WithMessage(value string) *ReplicationCredentialsStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
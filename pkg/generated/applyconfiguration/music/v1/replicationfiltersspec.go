@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ReplicationFiltersSpecApplyConfiguration represents an declarative configuration of the ReplicationFiltersSpec type for use
+// with apply.
+type ReplicationFiltersSpecApplyConfiguration struct {
+	DoDB            []string `json:"doDB,omitempty"`
+	IgnoreDB        []string `json:"ignoreDB,omitempty"`
+	DoTable         []string `json:"doTable,omitempty"`
+	IgnoreTable     []string `json:"ignoreTable,omitempty"`
+	WildDoTable     []string `json:"wildDoTable,omitempty"`
+	WildIgnoreTable []string `json:"wildIgnoreTable,omitempty"`
+}
+
+// ReplicationFiltersSpecApplyConfiguration constructs an declarative configuration of the ReplicationFiltersSpec type for use with
+// apply.
+func ReplicationFiltersSpec() *ReplicationFiltersSpecApplyConfiguration {
+	return &ReplicationFiltersSpecApplyConfiguration{}
+}
+
+// WithDoDB adds the given value to the DoDB field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DoDB field.
+func (b *ReplicationFiltersSpecApplyConfiguration) WithDoDB(values ...string) *ReplicationFiltersSpecApplyConfiguration {
+	for i := range values {
+		b.DoDB = append(b.DoDB, values[i])
+	}
+	return b
+}
+
+// WithIgnoreDB adds the given value to the IgnoreDB field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IgnoreDB field.
+func (b *ReplicationFiltersSpecApplyConfiguration) WithIgnoreDB(values ...string) *ReplicationFiltersSpecApplyConfiguration {
+	for i := range values {
+		b.IgnoreDB = append(b.IgnoreDB, values[i])
+	}
+	return b
+}
+
+// WithDoTable adds the given value to the DoTable field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DoTable field.
+func (b *ReplicationFiltersSpecApplyConfiguration) WithDoTable(values ...string) *ReplicationFiltersSpecApplyConfiguration {
+	for i := range values {
+		b.DoTable = append(b.DoTable, values[i])
+	}
+	return b
+}
+
+// WithIgnoreTable adds the given value to the IgnoreTable field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IgnoreTable field.
+func (b *ReplicationFiltersSpecApplyConfiguration) WithIgnoreTable(values ...string) *ReplicationFiltersSpecApplyConfiguration {
+	for i := range values {
+		b.IgnoreTable = append(b.IgnoreTable, values[i])
+	}
+	return b
+}
+
+// WithWildDoTable adds the given value to the WildDoTable field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WildDoTable field.
+func (b *ReplicationFiltersSpecApplyConfiguration) WithWildDoTable(values ...string) *ReplicationFiltersSpecApplyConfiguration {
+	for i := range values {
+		b.WildDoTable = append(b.WildDoTable, values[i])
+	}
+	return b
+}
+
+// WithWildIgnoreTable adds the given value to the WildIgnoreTable field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WildIgnoreTable field.
+func (b *ReplicationFiltersSpecApplyConfiguration) WithWildIgnoreTable(values ...string) *ReplicationFiltersSpecApplyConfiguration {
+	for i := range values {
+		b.WildIgnoreTable = append(b.WildIgnoreTable, values[i])
+	}
+	return b
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceFootprintApplyConfiguration represents an declarative configuration of the ResourceFootprint type for use
+// with apply.
+type ResourceFootprintApplyConfiguration struct {
+	CPU     *resource.Quantity `json:"cpu,omitempty"`
+	Memory  *resource.Quantity `json:"memory,omitempty"`
+	Storage *resource.Quantity `json:"storage,omitempty"`
+}
+
+// ResourceFootprintApplyConfiguration constructs an declarative configuration of the ResourceFootprint type for use with
+// apply.
+func ResourceFootprint() *ResourceFootprintApplyConfiguration {
+	return &ResourceFootprintApplyConfiguration{}
+}
+
+// WithCPU sets the CPU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CPU field is set to the value of the last call.
+func (b *ResourceFootprintApplyConfiguration) WithCPU(value resource.Quantity) *ResourceFootprintApplyConfiguration {
+	b.CPU = &value
+	return b
+}
+
+// WithMemory sets the Memory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Memory field is set to the value of the last call.
+func (b *ResourceFootprintApplyConfiguration) WithMemory(value resource.Quantity) *ResourceFootprintApplyConfiguration {
+	b.Memory = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *ResourceFootprintApplyConfiguration) WithStorage(value resource.Quantity) *ResourceFootprintApplyConfiguration {
+	b.Storage = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SearchSpecApplyConfiguration represents an declarative configuration of the SearchSpec type for use
+// with apply.
+type SearchSpecApplyConfiguration struct {
+	Engine    *v1.SearchEngine               `json:"engine,omitempty"`
+	Image     *string                        `json:"image,omitempty"`
+	Storage   *StorageSpecApplyConfiguration `json:"storage,omitempty"`
+	Resources *corev1.ResourceRequirements   `json:"resources,omitempty"`
+}
+
+// SearchSpecApplyConfiguration constructs an declarative configuration of the SearchSpec type for use with
+// apply.
+func SearchSpec() *SearchSpecApplyConfiguration {
+	return &SearchSpecApplyConfiguration{}
+}
+
+// WithEngine sets the Engine field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Engine field is set to the value of the last call.
+func (b *SearchSpecApplyConfiguration) WithEngine(value v1.SearchEngine) *SearchSpecApplyConfiguration {
+	b.Engine = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *SearchSpecApplyConfiguration) WithImage(value string) *SearchSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *SearchSpecApplyConfiguration) WithStorage(value *StorageSpecApplyConfiguration) *SearchSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *SearchSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *SearchSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SeedInitContainerSpecApplyConfiguration represents an declarative configuration of the SeedInitContainerSpec type for use
+// with apply.
+type SeedInitContainerSpecApplyConfiguration struct {
+	Image      *string                  `json:"image,omitempty"`
+	Command    []string                 `json:"command,omitempty"`
+	Env        []v1.EnvVar              `json:"env,omitempty"`
+	Resources  *v1.ResourceRequirements `json:"resources,omitempty"`
+	MarkerFile *string                  `json:"markerFile,omitempty"`
+}
+
+// SeedInitContainerSpecApplyConfiguration constructs an declarative configuration of the SeedInitContainerSpec type for use with
+// apply.
+func SeedInitContainerSpec() *SeedInitContainerSpecApplyConfiguration {
+	return &SeedInitContainerSpecApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *SeedInitContainerSpecApplyConfiguration) WithImage(value string) *SeedInitContainerSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *SeedInitContainerSpecApplyConfiguration) WithCommand(values ...string) *SeedInitContainerSpecApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *SeedInitContainerSpecApplyConfiguration) WithEnv(values ...v1.EnvVar) *SeedInitContainerSpecApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *SeedInitContainerSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *SeedInitContainerSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithMarkerFile sets the MarkerFile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MarkerFile field is set to the value of the last call.
+func (b *SeedInitContainerSpecApplyConfiguration) WithMarkerFile(value string) *SeedInitContainerSpecApplyConfiguration {
+	b.MarkerFile = &value
+	return b
+}
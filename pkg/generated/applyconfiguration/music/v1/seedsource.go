@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+)
+
+// SeedSourceApplyConfiguration represents an declarative configuration of the SeedSource type for use
+// with apply.
+type SeedSourceApplyConfiguration struct {
+	Type          *v1.SeedSourceType `json:"type,omitempty"`
+	URL           *string            `json:"url,omitempty"`
+	Path          *string            `json:"path,omitempty"`
+	Endpoint      *string            `json:"endpoint,omitempty"`
+	ObjectStorage *bool              `json:"objectStorage,omitempty"`
+}
+
+// SeedSourceApplyConfiguration constructs an declarative configuration of the SeedSource type for use with
+// apply.
+func SeedSource() *SeedSourceApplyConfiguration {
+	return &SeedSourceApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *SeedSourceApplyConfiguration) WithType(value v1.SeedSourceType) *SeedSourceApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *SeedSourceApplyConfiguration) WithURL(value string) *SeedSourceApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *SeedSourceApplyConfiguration) WithPath(value string) *SeedSourceApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithEndpoint sets the Endpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Endpoint field is set to the value of the last call.
+func (b *SeedSourceApplyConfiguration) WithEndpoint(value string) *SeedSourceApplyConfiguration {
+	b.Endpoint = &value
+	return b
+}
+
+// WithObjectStorage sets the ObjectStorage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectStorage field is set to the value of the last call.
+func (b *SeedSourceApplyConfiguration) WithObjectStorage(value bool) *SeedSourceApplyConfiguration {
+	b.ObjectStorage = &value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// SeedSpecApplyConfiguration represents an declarative configuration of the SeedSpec type for use
+// with apply.
+type SeedSpecApplyConfiguration struct {
+	Sources           []SeedSourceApplyConfiguration `json:"sources,omitempty"`
+	CredentialsSecret *string                        `json:"credentialsSecret,omitempty"`
+}
+
+// SeedSpecApplyConfiguration constructs an declarative configuration of the SeedSpec type for use with
+// apply.
+func SeedSpec() *SeedSpecApplyConfiguration {
+	return &SeedSpecApplyConfiguration{}
+}
+
+// WithSources adds the given value to the Sources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sources field.
+func (b *SeedSpecApplyConfiguration) WithSources(values ...*SeedSourceApplyConfiguration) *SeedSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSources")
+		}
+		b.Sources = append(b.Sources, *values[i])
+	}
+	return b
+}
+
+// WithCredentialsSecret sets the CredentialsSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialsSecret field is set to the value of the last call.
+func (b *SeedSpecApplyConfiguration) WithCredentialsSecret(value string) *SeedSpecApplyConfiguration {
+	b.CredentialsSecret = &value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// SeedStatusApplyConfiguration represents an declarative configuration of the SeedStatus type for use
+// with apply.
+type SeedStatusApplyConfiguration struct {
+	Phase             *string `json:"phase,omitempty"`
+	CompletedReplicas *int32  `json:"completedReplicas,omitempty"`
+	Hash              *string `json:"hash,omitempty"`
+}
+
+// SeedStatusApplyConfiguration constructs an declarative configuration of the SeedStatus type for use with
+// apply.
+func SeedStatus() *SeedStatusApplyConfiguration {
+	return &SeedStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *SeedStatusApplyConfiguration) WithPhase(value string) *SeedStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithCompletedReplicas sets the CompletedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedReplicas field is set to the value of the last call.
+func (b *SeedStatusApplyConfiguration) WithCompletedReplicas(value int32) *SeedStatusApplyConfiguration {
+	b.CompletedReplicas = &value
+	return b
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *SeedStatusApplyConfiguration) WithHash(value string) *SeedStatusApplyConfiguration {
+	b.Hash = &value
+	return b
+}
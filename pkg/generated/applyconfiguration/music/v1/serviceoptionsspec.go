@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ServiceOptionsSpecApplyConfiguration represents an declarative configuration of the ServiceOptionsSpec type for use
+// with apply.
+type ServiceOptionsSpecApplyConfiguration struct {
+	Type                  *v1.ServiceType                  `json:"type,omitempty"`
+	ExternalTrafficPolicy *v1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+	InternalTrafficPolicy *v1.ServiceInternalTrafficPolicy `json:"internalTrafficPolicy,omitempty"`
+	TopologyRouting       *musicv1.TopologyRoutingMode     `json:"topologyRouting,omitempty"`
+	TargetPort            *intstr.IntOrString              `json:"targetPort,omitempty"`
+}
+
+// ServiceOptionsSpecApplyConfiguration constructs an declarative configuration of the ServiceOptionsSpec type for use with
+// apply.
+func ServiceOptionsSpec() *ServiceOptionsSpecApplyConfiguration {
+	return &ServiceOptionsSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ServiceOptionsSpecApplyConfiguration) WithType(value v1.ServiceType) *ServiceOptionsSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithExternalTrafficPolicy sets the ExternalTrafficPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalTrafficPolicy field is set to the value of the last call.
+func (b *ServiceOptionsSpecApplyConfiguration) WithExternalTrafficPolicy(value v1.ServiceExternalTrafficPolicy) *ServiceOptionsSpecApplyConfiguration {
+	b.ExternalTrafficPolicy = &value
+	return b
+}
+
+// WithInternalTrafficPolicy sets the InternalTrafficPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InternalTrafficPolicy field is set to the value of the last call.
+func (b *ServiceOptionsSpecApplyConfiguration) WithInternalTrafficPolicy(value v1.ServiceInternalTrafficPolicy) *ServiceOptionsSpecApplyConfiguration {
+	b.InternalTrafficPolicy = &value
+	return b
+}
+
+// WithTopologyRouting sets the TopologyRouting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyRouting field is set to the value of the last call.
+func (b *ServiceOptionsSpecApplyConfiguration) WithTopologyRouting(value musicv1.TopologyRoutingMode) *ServiceOptionsSpecApplyConfiguration {
+	b.TopologyRouting = &value
+	return b
+}
+
+// WithTargetPort sets the TargetPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetPort field is set to the value of the last call.
+func (b *ServiceOptionsSpecApplyConfiguration) WithTargetPort(value intstr.IntOrString) *ServiceOptionsSpecApplyConfiguration {
+	b.TargetPort = &value
+	return b
+}
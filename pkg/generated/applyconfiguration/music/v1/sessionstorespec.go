@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SessionStoreSpecApplyConfiguration represents an declarative configuration of the SessionStoreSpec type for use
+// with apply.
+type SessionStoreSpecApplyConfiguration struct {
+	Image     *string                        `json:"image,omitempty"`
+	Storage   *StorageSpecApplyConfiguration `json:"storage,omitempty"`
+	Resources *corev1.ResourceRequirements   `json:"resources,omitempty"`
+}
+
+// SessionStoreSpecApplyConfiguration constructs an declarative configuration of the SessionStoreSpec type for use with
+// apply.
+func SessionStoreSpec() *SessionStoreSpecApplyConfiguration {
+	return &SessionStoreSpecApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *SessionStoreSpecApplyConfiguration) WithImage(value string) *SessionStoreSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *SessionStoreSpecApplyConfiguration) WithStorage(value *StorageSpecApplyConfiguration) *SessionStoreSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *SessionStoreSpecApplyConfiguration) WithResources(value corev1.ResourceRequirements) *SessionStoreSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ShardPartitionApplyConfiguration represents an declarative configuration of the ShardPartition type for use
+// with apply.
+type ShardPartitionApplyConfiguration struct {
+	Shard     *string                      `json:"shard,omitempty"`
+	Service   *string                      `json:"service,omitempty"`
+	Genres    []string                     `json:"genres,omitempty"`
+	Default   *bool                        `json:"default,omitempty"`
+	HashRange *HashRangeApplyConfiguration `json:"hashRange,omitempty"`
+}
+
+// ShardPartitionApplyConfiguration constructs an declarative configuration of the ShardPartition type for use with
+// apply.
+func ShardPartition() *ShardPartitionApplyConfiguration {
+	return &ShardPartitionApplyConfiguration{}
+}
+
+// WithShard sets the Shard field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shard field is set to the value of the last call.
+func (b *ShardPartitionApplyConfiguration) WithShard(value string) *ShardPartitionApplyConfiguration {
+	b.Shard = &value
+	return b
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *ShardPartitionApplyConfiguration) WithService(value string) *ShardPartitionApplyConfiguration {
+	b.Service = &value
+	return b
+}
+
+// WithGenres adds the given value to the Genres field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Genres field.
+func (b *ShardPartitionApplyConfiguration) WithGenres(values ...string) *ShardPartitionApplyConfiguration {
+	for i := range values {
+		b.Genres = append(b.Genres, values[i])
+	}
+	return b
+}
+
+// WithDefault sets the Default field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Default field is set to the value of the last call.
+func (b *ShardPartitionApplyConfiguration) WithDefault(value bool) *ShardPartitionApplyConfiguration {
+	b.Default = &value
+	return b
+}
+
+// WithHashRange sets the HashRange field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HashRange field is set to the value of the last call.
+func (b *ShardPartitionApplyConfiguration) WithHashRange(value *HashRangeApplyConfiguration) *ShardPartitionApplyConfiguration {
+	b.HashRange = value
+	return b
+}
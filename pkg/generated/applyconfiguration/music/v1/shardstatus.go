@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ShardStatusApplyConfiguration represents an declarative configuration of the ShardStatus type for use
+// with apply.
+type ShardStatusApplyConfiguration struct {
+	Name            *string `json:"name,omitempty"`
+	DesiredReplicas *int32  `json:"desiredReplicas,omitempty"`
+	ReadyReplicas   *int32  `json:"readyReplicas,omitempty"`
+}
+
+// ShardStatusApplyConfiguration constructs an declarative configuration of the ShardStatus type for use with
+// apply.
+func ShardStatus() *ShardStatusApplyConfiguration {
+	return &ShardStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithName(value string) *ShardStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDesiredReplicas sets the DesiredReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DesiredReplicas field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithDesiredReplicas(value int32) *ShardStatusApplyConfiguration {
+	b.DesiredReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithReadyReplicas(value int32) *ShardStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// SnapshotSpecApplyConfiguration represents an declarative configuration of the SnapshotSpec type for use
+// with apply.
+type SnapshotSpecApplyConfiguration struct {
+	BeforeRecreate          *bool   `json:"beforeRecreate,omitempty"`
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// SnapshotSpecApplyConfiguration constructs an declarative configuration of the SnapshotSpec type for use with
+// apply.
+func SnapshotSpec() *SnapshotSpecApplyConfiguration {
+	return &SnapshotSpecApplyConfiguration{}
+}
+
+// WithBeforeRecreate sets the BeforeRecreate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BeforeRecreate field is set to the value of the last call.
+func (b *SnapshotSpecApplyConfiguration) WithBeforeRecreate(value bool) *SnapshotSpecApplyConfiguration {
+	b.BeforeRecreate = &value
+	return b
+}
+
+// WithVolumeSnapshotClassName sets the VolumeSnapshotClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSnapshotClassName field is set to the value of the last call.
+func (b *SnapshotSpecApplyConfiguration) WithVolumeSnapshotClassName(value string) *SnapshotSpecApplyConfiguration {
+	b.VolumeSnapshotClassName = &value
+	return b
+}
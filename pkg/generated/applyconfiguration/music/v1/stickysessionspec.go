@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// StickySessionSpecApplyConfiguration represents an declarative configuration of the StickySessionSpec type for use
+// with apply.
+type StickySessionSpecApplyConfiguration struct {
+	Enabled       *bool   `json:"enabled,omitempty"`
+	CookieName    *string `json:"cookieName,omitempty"`
+	MaxAgeSeconds *int32  `json:"maxAgeSeconds,omitempty"`
+}
+
+// StickySessionSpecApplyConfiguration constructs an declarative configuration of the StickySessionSpec type for use with
+// apply.
+func StickySessionSpec() *StickySessionSpecApplyConfiguration {
+	return &StickySessionSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *StickySessionSpecApplyConfiguration) WithEnabled(value bool) *StickySessionSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithCookieName sets the CookieName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CookieName field is set to the value of the last call.
+func (b *StickySessionSpecApplyConfiguration) WithCookieName(value string) *StickySessionSpecApplyConfiguration {
+	b.CookieName = &value
+	return b
+}
+
+// WithMaxAgeSeconds sets the MaxAgeSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAgeSeconds field is set to the value of the last call.
+func (b *StickySessionSpecApplyConfiguration) WithMaxAgeSeconds(value int32) *StickySessionSpecApplyConfiguration {
+	b.MaxAgeSeconds = &value
+	return b
+}
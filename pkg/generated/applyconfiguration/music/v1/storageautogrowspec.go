@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// StorageAutoGrowSpecApplyConfiguration represents an declarative configuration of the StorageAutoGrowSpec type for use
+// with apply.
+type StorageAutoGrowSpecApplyConfiguration struct {
+	ThresholdPercent *int32  `json:"thresholdPercent,omitempty"`
+	Step             *string `json:"step,omitempty"`
+	MaxSize          *string `json:"maxSize,omitempty"`
+}
+
+// StorageAutoGrowSpecApplyConfiguration constructs an declarative configuration of the StorageAutoGrowSpec type for use with
+// apply.
+func StorageAutoGrowSpec() *StorageAutoGrowSpecApplyConfiguration {
+	return &StorageAutoGrowSpecApplyConfiguration{}
+}
+
+// WithThresholdPercent sets the ThresholdPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ThresholdPercent field is set to the value of the last call.
+func (b *StorageAutoGrowSpecApplyConfiguration) WithThresholdPercent(value int32) *StorageAutoGrowSpecApplyConfiguration {
+	b.ThresholdPercent = &value
+	return b
+}
+
+// WithStep sets the Step field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Step field is set to the value of the last call.
+func (b *StorageAutoGrowSpecApplyConfiguration) WithStep(value string) *StorageAutoGrowSpecApplyConfiguration {
+	b.Step = &value
+	return b
+}
+
+// WithMaxSize sets the MaxSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSize field is set to the value of the last call.
+func (b *StorageAutoGrowSpecApplyConfiguration) WithMaxSize(value string) *StorageAutoGrowSpecApplyConfiguration {
+	b.MaxSize = &value
+	return b
+}
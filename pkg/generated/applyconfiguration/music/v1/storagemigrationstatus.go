@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StorageMigrationStatusApplyConfiguration represents an declarative configuration of the StorageMigrationStatus type for use
+// with apply.
+type StorageMigrationStatusApplyConfiguration struct {
+	Phase       *string               `json:"phase,omitempty"`
+	AccessMode  *v1.StorageAccessMode `json:"accessMode,omitempty"`
+	FailedPhase *string               `json:"failedPhase,omitempty"`
+	FromSize    *string               `json:"fromSize,omitempty"`
+	TargetSize  *string               `json:"targetSize,omitempty"`
+	Replicas    *int32                `json:"replicas,omitempty"`
+	StartTime   *metav1.Time          `json:"startTime,omitempty"`
+	Message     *string               `json:"message,omitempty"`
+}
+
+// StorageMigrationStatusApplyConfiguration constructs an declarative configuration of the StorageMigrationStatus type for use with
+// apply.
+func StorageMigrationStatus() *StorageMigrationStatusApplyConfiguration {
+	return &StorageMigrationStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithPhase(value string) *StorageMigrationStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithAccessMode sets the AccessMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccessMode field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithAccessMode(value v1.StorageAccessMode) *StorageMigrationStatusApplyConfiguration {
+	b.AccessMode = &value
+	return b
+}
+
+// WithFailedPhase sets the FailedPhase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedPhase field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithFailedPhase(value string) *StorageMigrationStatusApplyConfiguration {
+	b.FailedPhase = &value
+	return b
+}
+
+// WithFromSize sets the FromSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FromSize field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithFromSize(value string) *StorageMigrationStatusApplyConfiguration {
+	b.FromSize = &value
+	return b
+}
+
+// WithTargetSize sets the TargetSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetSize field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithTargetSize(value string) *StorageMigrationStatusApplyConfiguration {
+	b.TargetSize = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithReplicas(value int32) *StorageMigrationStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithStartTime(value metav1.Time) *StorageMigrationStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *StorageMigrationStatusApplyConfiguration) WithMessage(value string) *StorageMigrationStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// StorageSpecApplyConfiguration represents an declarative configuration of the StorageSpec type for use
+// with apply.
+type StorageSpecApplyConfiguration struct {
+	Size                    *string                                 `json:"size,omitempty"`
+	UpdatePolicy            *v1.StorageUpdatePolicy                 `json:"updatePolicy,omitempty"`
+	AccessMode              *v1.StorageAccessMode                   `json:"accessMode,omitempty"`
+	StorageClassName        *string                                 `json:"storageClassName,omitempty"`
+	VolumeMode              *corev1.PersistentVolumeMode            `json:"volumeMode,omitempty"`
+	DataSource              *corev1.TypedLocalObjectReference       `json:"dataSource,omitempty"`
+	Selector                *metav1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	VolumeSnapshotClassName *string                                 `json:"volumeSnapshotClassName,omitempty"`
+	ClaimLabels             map[string]string                       `json:"claimLabels,omitempty"`
+	ClaimAnnotations        map[string]string                       `json:"claimAnnotations,omitempty"`
+	Cache                   *CacheVolumeSpecApplyConfiguration      `json:"cache,omitempty"`
+	AutoGrow                *StorageAutoGrowSpecApplyConfiguration  `json:"autoGrow,omitempty"`
+	AlmostFullPercent       *int32                                  `json:"almostFullPercent,omitempty"`
+}
+
+// StorageSpecApplyConfiguration constructs an declarative configuration of the StorageSpec type for use with
+// apply.
+func StorageSpec() *StorageSpecApplyConfiguration {
+	return &StorageSpecApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithSize(value string) *StorageSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithUpdatePolicy sets the UpdatePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatePolicy field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithUpdatePolicy(value v1.StorageUpdatePolicy) *StorageSpecApplyConfiguration {
+	b.UpdatePolicy = &value
+	return b
+}
+
+// WithAccessMode sets the AccessMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccessMode field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithAccessMode(value v1.StorageAccessMode) *StorageSpecApplyConfiguration {
+	b.AccessMode = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithStorageClassName(value string) *StorageSpecApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithVolumeMode sets the VolumeMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeMode field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithVolumeMode(value corev1.PersistentVolumeMode) *StorageSpecApplyConfiguration {
+	b.VolumeMode = &value
+	return b
+}
+
+// WithDataSource sets the DataSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataSource field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithDataSource(value corev1.TypedLocalObjectReference) *StorageSpecApplyConfiguration {
+	b.DataSource = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithSelector(value *metav1.LabelSelectorApplyConfiguration) *StorageSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithVolumeSnapshotClassName sets the VolumeSnapshotClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSnapshotClassName field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithVolumeSnapshotClassName(value string) *StorageSpecApplyConfiguration {
+	b.VolumeSnapshotClassName = &value
+	return b
+}
+
+// WithClaimLabels puts the entries into the ClaimLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ClaimLabels field,
+// overwriting an existing map entries in ClaimLabels field with the same key.
+func (b *StorageSpecApplyConfiguration) WithClaimLabels(entries map[string]string) *StorageSpecApplyConfiguration {
+	if b.ClaimLabels == nil && len(entries) > 0 {
+		b.ClaimLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ClaimLabels[k] = v
+	}
+	return b
+}
+
+// WithClaimAnnotations puts the entries into the ClaimAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ClaimAnnotations field,
+// overwriting an existing map entries in ClaimAnnotations field with the same key.
+func (b *StorageSpecApplyConfiguration) WithClaimAnnotations(entries map[string]string) *StorageSpecApplyConfiguration {
+	if b.ClaimAnnotations == nil && len(entries) > 0 {
+		b.ClaimAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ClaimAnnotations[k] = v
+	}
+	return b
+}
+
+// WithCache sets the Cache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cache field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithCache(value *CacheVolumeSpecApplyConfiguration) *StorageSpecApplyConfiguration {
+	b.Cache = value
+	return b
+}
+
+// WithAutoGrow sets the AutoGrow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoGrow field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithAutoGrow(value *StorageAutoGrowSpecApplyConfiguration) *StorageSpecApplyConfiguration {
+	b.AutoGrow = value
+	return b
+}
+
+// WithAlmostFullPercent sets the AlmostFullPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlmostFullPercent field is set to the value of the last call.
+func (b *StorageSpecApplyConfiguration) WithAlmostFullPercent(value int32) *StorageSpecApplyConfiguration {
+	b.AlmostFullPercent = &value
+	return b
+}
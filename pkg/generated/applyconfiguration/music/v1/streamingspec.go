@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// StreamingSpecApplyConfiguration represents an declarative configuration of the StreamingSpec type for use
+// with apply.
+type StreamingSpecApplyConfiguration struct {
+	Bitrate        *string                      `json:"bitrate,omitempty"`
+	MaxConnections *int32                       `json:"maxConnections,omitempty"`
+	Drain          *DrainSpecApplyConfiguration `json:"drain,omitempty"`
+}
+
+// StreamingSpecApplyConfiguration constructs an declarative configuration of the StreamingSpec type for use with
+// apply.
+func StreamingSpec() *StreamingSpecApplyConfiguration {
+	return &StreamingSpecApplyConfiguration{}
+}
+
+// WithBitrate sets the Bitrate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bitrate field is set to the value of the last call.
+func (b *StreamingSpecApplyConfiguration) WithBitrate(value string) *StreamingSpecApplyConfiguration {
+	b.Bitrate = &value
+	return b
+}
+
+// WithMaxConnections sets the MaxConnections field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConnections field is set to the value of the last call.
+func (b *StreamingSpecApplyConfiguration) WithMaxConnections(value int32) *StreamingSpecApplyConfiguration {
+	b.MaxConnections = &value
+	return b
+}
+
+// WithDrain sets the Drain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Drain field is set to the value of the last call.
+func (b *StreamingSpecApplyConfiguration) WithDrain(value *DrainSpecApplyConfiguration) *StreamingSpecApplyConfiguration {
+	b.Drain = value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TenancySpecApplyConfiguration represents an declarative configuration of the TenancySpec type for use
+// with apply.
+type TenancySpecApplyConfiguration struct {
+	Mode      *v1.TenancyMode      `json:"mode,omitempty"`
+	Namespace *string              `json:"namespace,omitempty"`
+	Quota     *corev1.ResourceList `json:"quota,omitempty"`
+}
+
+// TenancySpecApplyConfiguration constructs an declarative configuration of the TenancySpec type for use with
+// apply.
+func TenancySpec() *TenancySpecApplyConfiguration {
+	return &TenancySpecApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *TenancySpecApplyConfiguration) WithMode(value v1.TenancyMode) *TenancySpecApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *TenancySpecApplyConfiguration) WithNamespace(value string) *TenancySpecApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithQuota sets the Quota field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quota field is set to the value of the last call.
+func (b *TenancySpecApplyConfiguration) WithQuota(value corev1.ResourceList) *TenancySpecApplyConfiguration {
+	b.Quota = &value
+	return b
+}
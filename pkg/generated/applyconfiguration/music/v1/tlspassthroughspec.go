@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TLSPassthroughSpecApplyConfiguration represents an declarative configuration of the TLSPassthroughSpec type for use
+// with apply.
+type TLSPassthroughSpecApplyConfiguration struct {
+	SecretName  *string `json:"secretName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+	ServicePort *int32  `json:"servicePort,omitempty"`
+	MountPath   *string `json:"mountPath,omitempty"`
+}
+
+// TLSPassthroughSpecApplyConfiguration constructs an declarative configuration of the TLSPassthroughSpec type for use with
+// apply.
+func TLSPassthroughSpec() *TLSPassthroughSpecApplyConfiguration {
+	return &TLSPassthroughSpecApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *TLSPassthroughSpecApplyConfiguration) WithSecretName(value string) *TLSPassthroughSpecApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *TLSPassthroughSpecApplyConfiguration) WithPort(value int32) *TLSPassthroughSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithServicePort sets the ServicePort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServicePort field is set to the value of the last call.
+func (b *TLSPassthroughSpecApplyConfiguration) WithServicePort(value int32) *TLSPassthroughSpecApplyConfiguration {
+	b.ServicePort = &value
+	return b
+}
+
+// WithMountPath sets the MountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountPath field is set to the value of the last call.
+func (b *TLSPassthroughSpecApplyConfiguration) WithMountPath(value string) *TLSPassthroughSpecApplyConfiguration {
+	b.MountPath = &value
+	return b
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// VolumeUsageStatusApplyConfiguration represents an declarative configuration of the VolumeUsageStatus type for use
+// with apply.
+type VolumeUsageStatusApplyConfiguration struct {
+	Component     *string `json:"component,omitempty"`
+	PVC           *string `json:"pvc,omitempty"`
+	UsedBytes     *int64  `json:"usedBytes,omitempty"`
+	CapacityBytes *int64  `json:"capacityBytes,omitempty"`
+	UsedPercent   *int32  `json:"usedPercent,omitempty"`
+}
+
+// VolumeUsageStatusApplyConfiguration constructs an declarative configuration of the VolumeUsageStatus type for use with
+// apply.
+func VolumeUsageStatus() *VolumeUsageStatusApplyConfiguration {
+	return &VolumeUsageStatusApplyConfiguration{}
+}
+
+// WithComponent sets the Component field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Component field is set to the value of the last call.
+func (b *VolumeUsageStatusApplyConfiguration) WithComponent(value string) *VolumeUsageStatusApplyConfiguration {
+	b.Component = &value
+	return b
+}
+
+// WithPVC sets the PVC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PVC field is set to the value of the last call.
+func (b *VolumeUsageStatusApplyConfiguration) WithPVC(value string) *VolumeUsageStatusApplyConfiguration {
+	b.PVC = &value
+	return b
+}
+
+// WithUsedBytes sets the UsedBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsedBytes field is set to the value of the last call.
+func (b *VolumeUsageStatusApplyConfiguration) WithUsedBytes(value int64) *VolumeUsageStatusApplyConfiguration {
+	b.UsedBytes = &value
+	return b
+}
+
+// WithCapacityBytes sets the CapacityBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CapacityBytes field is set to the value of the last call.
+func (b *VolumeUsageStatusApplyConfiguration) WithCapacityBytes(value int64) *VolumeUsageStatusApplyConfiguration {
+	b.CapacityBytes = &value
+	return b
+}
+
+// WithUsedPercent sets the UsedPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsedPercent field is set to the value of the last call.
+func (b *VolumeUsageStatusApplyConfiguration) WithUsedPercent(value int32) *VolumeUsageStatusApplyConfiguration {
+	b.UsedPercent = &value
+	return b
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	musicv1 "github.com/example/managedapp-operator/pkg/generated/applyconfiguration/music/v1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=music.mixcorp.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("AccessLogShippingSpec"):
+		return &musicv1.AccessLogShippingSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AccessLogSinkSpec"):
+		return &musicv1.AccessLogSinkSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AppConfigSpec"):
+		return &musicv1.AppConfigSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AppLogsSpec"):
+		return &musicv1.AppLogsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AppShardSpec"):
+		return &musicv1.AppShardSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AutoscalingSpec"):
+		return &musicv1.AutoscalingSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackupDestinationSpec"):
+		return &musicv1.BackupDestinationSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackupObjectStorageDestination"):
+		return &musicv1.BackupObjectStorageDestinationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackupPVCDestination"):
+		return &musicv1.BackupPVCDestinationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackupStatus"):
+		return &musicv1.BackupStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CacheVolumeSpec"):
+		return &musicv1.CacheVolumeSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ComponentStatus"):
+		return &musicv1.ComponentStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigReloadSpec"):
+		return &musicv1.ConfigReloadSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectionMetricsSpec"):
+		return &musicv1.ConnectionMetricsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseAuditLogSpec"):
+		return &musicv1.DatabaseAuditLogSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseBackupSpec"):
+		return &musicv1.DatabaseBackupSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseHighAvailabilitySpec"):
+		return &musicv1.DatabaseHighAvailabilitySpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseInitFromSpec"):
+		return &musicv1.DatabaseInitFromSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseNodeStatus"):
+		return &musicv1.DatabaseNodeStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseProbesSpec"):
+		return &musicv1.DatabaseProbesSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseReplicationSpec"):
+		return &musicv1.DatabaseReplicationSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseSpec"):
+		return &musicv1.DatabaseSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DatabaseStatus"):
+		return &musicv1.DatabaseStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DrainSpec"):
+		return &musicv1.DrainSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DriftedResource"):
+		return &musicv1.DriftedResourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalQueueSpec"):
+		return &musicv1.ExternalQueueSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FailoverEvent"):
+		return &musicv1.FailoverEventApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GPUSpec"):
+		return &musicv1.GPUSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HashRange"):
+		return &musicv1.HashRangeApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HealthCheckSpec"):
+		return &musicv1.HealthCheckSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IngressSpec"):
+		return &musicv1.IngressSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LibraryBucketSource"):
+		return &musicv1.LibraryBucketSourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LibraryMount"):
+		return &musicv1.LibraryMountApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LibraryPVCSource"):
+		return &musicv1.LibraryPVCSourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MonitoringSpec"):
+		return &musicv1.MonitoringSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicLibrary"):
+		return &musicv1.MusicLibraryApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicLibrarySpec"):
+		return &musicv1.MusicLibrarySpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicLibraryStatus"):
+		return &musicv1.MusicLibraryStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicService"):
+		return &musicv1.MusicServiceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceSet"):
+		return &musicv1.MusicServiceSetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceSetInstance"):
+		return &musicv1.MusicServiceSetInstanceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceSetSpec"):
+		return &musicv1.MusicServiceSetSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceSetStatus"):
+		return &musicv1.MusicServiceSetStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceSetTenant"):
+		return &musicv1.MusicServiceSetTenantApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceSpec"):
+		return &musicv1.MusicServiceSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceStatus"):
+		return &musicv1.MusicServiceStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MusicServiceTemplate"):
+		return &musicv1.MusicServiceTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ObjectStorageSpec"):
+		return &musicv1.ObjectStorageSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PartitioningSpec"):
+		return &musicv1.PartitioningSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PartitioningStatus"):
+		return &musicv1.PartitioningStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeTimingSpec"):
+		return &musicv1.ProbeTimingSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("QueueSpec"):
+		return &musicv1.QueueSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReadPoolSpec"):
+		return &musicv1.ReadPoolSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RegistryCredentialsSpec"):
+		return &musicv1.RegistryCredentialsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReplicationCredentialsStatus"):
+		return &musicv1.ReplicationCredentialsStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReplicationFiltersSpec"):
+		return &musicv1.ReplicationFiltersSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ResourceFootprint"):
+		return &musicv1.ResourceFootprintApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SearchSpec"):
+		return &musicv1.SearchSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SeedInitContainerSpec"):
+		return &musicv1.SeedInitContainerSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SeedSource"):
+		return &musicv1.SeedSourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SeedSpec"):
+		return &musicv1.SeedSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SeedStatus"):
+		return &musicv1.SeedStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServiceOptionsSpec"):
+		return &musicv1.ServiceOptionsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SessionStoreSpec"):
+		return &musicv1.SessionStoreSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardPartition"):
+		return &musicv1.ShardPartitionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardStatus"):
+		return &musicv1.ShardStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SnapshotSpec"):
+		return &musicv1.SnapshotSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StickySessionSpec"):
+		return &musicv1.StickySessionSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StorageAutoGrowSpec"):
+		return &musicv1.StorageAutoGrowSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StorageMigrationStatus"):
+		return &musicv1.StorageMigrationStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StorageSpec"):
+		return &musicv1.StorageSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StreamingSpec"):
+		return &musicv1.StreamingSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TenancySpec"):
+		return &musicv1.TenancySpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TLSPassthroughSpec"):
+		return &musicv1.TLSPassthroughSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VolumeUsageStatus"):
+		return &musicv1.VolumeUsageStatusApplyConfiguration{}
+
+	}
+	return nil
+}
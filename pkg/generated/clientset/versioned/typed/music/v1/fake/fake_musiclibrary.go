@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/example/managedapp-operator/api/v1"
+	musicv1 "github.com/example/managedapp-operator/pkg/generated/applyconfiguration/music/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMusicLibraries implements MusicLibraryInterface
+type FakeMusicLibraries struct {
+	Fake *FakeMusicV1
+	ns   string
+}
+
+var musiclibrariesResource = v1.SchemeGroupVersion.WithResource("musiclibraries")
+
+var musiclibrariesKind = v1.SchemeGroupVersion.WithKind("MusicLibrary")
+
+// Get takes name of the musicLibrary, and returns the corresponding musicLibrary object, and an error if there is any.
+func (c *FakeMusicLibraries) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.MusicLibrary, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(musiclibrariesResource, c.ns, name), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
+
+// List takes label and field selectors, and returns the list of MusicLibraries that match those selectors.
+func (c *FakeMusicLibraries) List(ctx context.Context, opts metav1.ListOptions) (result *v1.MusicLibraryList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(musiclibrariesResource, musiclibrariesKind, c.ns, opts), &v1.MusicLibraryList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.MusicLibraryList{ListMeta: obj.(*v1.MusicLibraryList).ListMeta}
+	for _, item := range obj.(*v1.MusicLibraryList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested musicLibraries.
+func (c *FakeMusicLibraries) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(musiclibrariesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a musicLibrary and creates it.  Returns the server's representation of the musicLibrary, and an error, if there is any.
+func (c *FakeMusicLibraries) Create(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.CreateOptions) (result *v1.MusicLibrary, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(musiclibrariesResource, c.ns, musicLibrary), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
+
+// Update takes the representation of a musicLibrary and updates it. Returns the server's representation of the musicLibrary, and an error, if there is any.
+func (c *FakeMusicLibraries) Update(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.UpdateOptions) (result *v1.MusicLibrary, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(musiclibrariesResource, c.ns, musicLibrary), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMusicLibraries) UpdateStatus(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.UpdateOptions) (*v1.MusicLibrary, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(musiclibrariesResource, "status", c.ns, musicLibrary), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
+
+// Delete takes name of the musicLibrary and deletes it. Returns an error if one occurs.
+func (c *FakeMusicLibraries) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(musiclibrariesResource, c.ns, name, opts), &v1.MusicLibrary{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMusicLibraries) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(musiclibrariesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.MusicLibraryList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched musicLibrary.
+func (c *FakeMusicLibraries) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicLibrary, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musiclibrariesResource, c.ns, name, pt, data, subresources...), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied musicLibrary.
+func (c *FakeMusicLibraries) Apply(ctx context.Context, musicLibrary *musicv1.MusicLibraryApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicLibrary, err error) {
+	if musicLibrary == nil {
+		return nil, fmt.Errorf("musicLibrary provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(musicLibrary)
+	if err != nil {
+		return nil, err
+	}
+	name := musicLibrary.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicLibrary.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musiclibrariesResource, c.ns, *name, types.ApplyPatchType, data), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMusicLibraries) ApplyStatus(ctx context.Context, musicLibrary *musicv1.MusicLibraryApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicLibrary, err error) {
+	if musicLibrary == nil {
+		return nil, fmt.Errorf("musicLibrary provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(musicLibrary)
+	if err != nil {
+		return nil, err
+	}
+	name := musicLibrary.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicLibrary.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musiclibrariesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1.MusicLibrary{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicLibrary), err
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/example/managedapp-operator/api/v1"
+	musicv1 "github.com/example/managedapp-operator/pkg/generated/applyconfiguration/music/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMusicServices implements MusicServiceInterface
+type FakeMusicServices struct {
+	Fake *FakeMusicV1
+	ns   string
+}
+
+var musicservicesResource = v1.SchemeGroupVersion.WithResource("musicservices")
+
+var musicservicesKind = v1.SchemeGroupVersion.WithKind("MusicService")
+
+// Get takes name of the musicService, and returns the corresponding musicService object, and an error if there is any.
+func (c *FakeMusicServices) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.MusicService, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(musicservicesResource, c.ns, name), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
+
+// List takes label and field selectors, and returns the list of MusicServices that match those selectors.
+func (c *FakeMusicServices) List(ctx context.Context, opts metav1.ListOptions) (result *v1.MusicServiceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(musicservicesResource, musicservicesKind, c.ns, opts), &v1.MusicServiceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.MusicServiceList{ListMeta: obj.(*v1.MusicServiceList).ListMeta}
+	for _, item := range obj.(*v1.MusicServiceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested musicServices.
+func (c *FakeMusicServices) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(musicservicesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a musicService and creates it.  Returns the server's representation of the musicService, and an error, if there is any.
+func (c *FakeMusicServices) Create(ctx context.Context, musicService *v1.MusicService, opts metav1.CreateOptions) (result *v1.MusicService, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(musicservicesResource, c.ns, musicService), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
+
+// Update takes the representation of a musicService and updates it. Returns the server's representation of the musicService, and an error, if there is any.
+func (c *FakeMusicServices) Update(ctx context.Context, musicService *v1.MusicService, opts metav1.UpdateOptions) (result *v1.MusicService, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(musicservicesResource, c.ns, musicService), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMusicServices) UpdateStatus(ctx context.Context, musicService *v1.MusicService, opts metav1.UpdateOptions) (*v1.MusicService, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(musicservicesResource, "status", c.ns, musicService), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
+
+// Delete takes name of the musicService and deletes it. Returns an error if one occurs.
+func (c *FakeMusicServices) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(musicservicesResource, c.ns, name, opts), &v1.MusicService{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMusicServices) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(musicservicesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.MusicServiceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched musicService.
+func (c *FakeMusicServices) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicService, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musicservicesResource, c.ns, name, pt, data, subresources...), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied musicService.
+func (c *FakeMusicServices) Apply(ctx context.Context, musicService *musicv1.MusicServiceApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicService, err error) {
+	if musicService == nil {
+		return nil, fmt.Errorf("musicService provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(musicService)
+	if err != nil {
+		return nil, err
+	}
+	name := musicService.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicService.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musicservicesResource, c.ns, *name, types.ApplyPatchType, data), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMusicServices) ApplyStatus(ctx context.Context, musicService *musicv1.MusicServiceApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicService, err error) {
+	if musicService == nil {
+		return nil, fmt.Errorf("musicService provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(musicService)
+	if err != nil {
+		return nil, err
+	}
+	name := musicService.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicService.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musicservicesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1.MusicService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicService), err
+}
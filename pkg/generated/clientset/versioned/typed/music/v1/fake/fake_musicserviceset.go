@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/example/managedapp-operator/api/v1"
+	musicv1 "github.com/example/managedapp-operator/pkg/generated/applyconfiguration/music/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMusicServiceSets implements MusicServiceSetInterface
+type FakeMusicServiceSets struct {
+	Fake *FakeMusicV1
+	ns   string
+}
+
+var musicservicesetsResource = v1.SchemeGroupVersion.WithResource("musicservicesets")
+
+var musicservicesetsKind = v1.SchemeGroupVersion.WithKind("MusicServiceSet")
+
+// Get takes name of the musicServiceSet, and returns the corresponding musicServiceSet object, and an error if there is any.
+func (c *FakeMusicServiceSets) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.MusicServiceSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(musicservicesetsResource, c.ns, name), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
+
+// List takes label and field selectors, and returns the list of MusicServiceSets that match those selectors.
+func (c *FakeMusicServiceSets) List(ctx context.Context, opts metav1.ListOptions) (result *v1.MusicServiceSetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(musicservicesetsResource, musicservicesetsKind, c.ns, opts), &v1.MusicServiceSetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.MusicServiceSetList{ListMeta: obj.(*v1.MusicServiceSetList).ListMeta}
+	for _, item := range obj.(*v1.MusicServiceSetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested musicServiceSets.
+func (c *FakeMusicServiceSets) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(musicservicesetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a musicServiceSet and creates it.  Returns the server's representation of the musicServiceSet, and an error, if there is any.
+func (c *FakeMusicServiceSets) Create(ctx context.Context, musicServiceSet *v1.MusicServiceSet, opts metav1.CreateOptions) (result *v1.MusicServiceSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(musicservicesetsResource, c.ns, musicServiceSet), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
+
+// Update takes the representation of a musicServiceSet and updates it. Returns the server's representation of the musicServiceSet, and an error, if there is any.
+func (c *FakeMusicServiceSets) Update(ctx context.Context, musicServiceSet *v1.MusicServiceSet, opts metav1.UpdateOptions) (result *v1.MusicServiceSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(musicservicesetsResource, c.ns, musicServiceSet), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMusicServiceSets) UpdateStatus(ctx context.Context, musicServiceSet *v1.MusicServiceSet, opts metav1.UpdateOptions) (*v1.MusicServiceSet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(musicservicesetsResource, "status", c.ns, musicServiceSet), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
+
+// Delete takes name of the musicServiceSet and deletes it. Returns an error if one occurs.
+func (c *FakeMusicServiceSets) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(musicservicesetsResource, c.ns, name, opts), &v1.MusicServiceSet{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMusicServiceSets) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(musicservicesetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.MusicServiceSetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched musicServiceSet.
+func (c *FakeMusicServiceSets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicServiceSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musicservicesetsResource, c.ns, name, pt, data, subresources...), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied musicServiceSet.
+func (c *FakeMusicServiceSets) Apply(ctx context.Context, musicServiceSet *musicv1.MusicServiceSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicServiceSet, err error) {
+	if musicServiceSet == nil {
+		return nil, fmt.Errorf("musicServiceSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(musicServiceSet)
+	if err != nil {
+		return nil, err
+	}
+	name := musicServiceSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicServiceSet.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musicservicesetsResource, c.ns, *name, types.ApplyPatchType, data), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMusicServiceSets) ApplyStatus(ctx context.Context, musicServiceSet *musicv1.MusicServiceSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicServiceSet, err error) {
+	if musicServiceSet == nil {
+		return nil, fmt.Errorf("musicServiceSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(musicServiceSet)
+	if err != nil {
+		return nil, err
+	}
+	name := musicServiceSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicServiceSet.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(musicservicesetsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1.MusicServiceSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MusicServiceSet), err
+}
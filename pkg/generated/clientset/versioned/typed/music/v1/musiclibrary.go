@@ -0,0 +1,255 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/example/managedapp-operator/api/v1"
+	musicv1 "github.com/example/managedapp-operator/pkg/generated/applyconfiguration/music/v1"
+	scheme "github.com/example/managedapp-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// MusicLibrariesGetter has a method to return a MusicLibraryInterface.
+// A group's client should implement this interface.
+type MusicLibrariesGetter interface {
+	MusicLibraries(namespace string) MusicLibraryInterface
+}
+
+// MusicLibraryInterface has methods to work with MusicLibrary resources.
+type MusicLibraryInterface interface {
+	Create(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.CreateOptions) (*v1.MusicLibrary, error)
+	Update(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.UpdateOptions) (*v1.MusicLibrary, error)
+	UpdateStatus(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.UpdateOptions) (*v1.MusicLibrary, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.MusicLibrary, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.MusicLibraryList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicLibrary, err error)
+	Apply(ctx context.Context, musicLibrary *musicv1.MusicLibraryApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicLibrary, err error)
+	ApplyStatus(ctx context.Context, musicLibrary *musicv1.MusicLibraryApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicLibrary, err error)
+	MusicLibraryExpansion
+}
+
+// musicLibraries implements MusicLibraryInterface
+type musicLibraries struct {
+	client rest.Interface
+	ns     string
+}
+
+// newMusicLibraries returns a MusicLibraries
+func newMusicLibraries(c *MusicV1Client, namespace string) *musicLibraries {
+	return &musicLibraries{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the musicLibrary, and returns the corresponding musicLibrary object, and an error if there is any.
+func (c *musicLibraries) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.MusicLibrary, err error) {
+	result = &v1.MusicLibrary{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of MusicLibraries that match those selectors.
+func (c *musicLibraries) List(ctx context.Context, opts metav1.ListOptions) (result *v1.MusicLibraryList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.MusicLibraryList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested musicLibraries.
+func (c *musicLibraries) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a musicLibrary and creates it.  Returns the server's representation of the musicLibrary, and an error, if there is any.
+func (c *musicLibraries) Create(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.CreateOptions) (result *v1.MusicLibrary, err error) {
+	result = &v1.MusicLibrary{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(musicLibrary).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a musicLibrary and updates it. Returns the server's representation of the musicLibrary, and an error, if there is any.
+func (c *musicLibraries) Update(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.UpdateOptions) (result *v1.MusicLibrary, err error) {
+	result = &v1.MusicLibrary{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(musicLibrary.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(musicLibrary).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *musicLibraries) UpdateStatus(ctx context.Context, musicLibrary *v1.MusicLibrary, opts metav1.UpdateOptions) (result *v1.MusicLibrary, err error) {
+	result = &v1.MusicLibrary{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(musicLibrary.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(musicLibrary).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the musicLibrary and deletes it. Returns an error if one occurs.
+func (c *musicLibraries) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *musicLibraries) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched musicLibrary.
+func (c *musicLibraries) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicLibrary, err error) {
+	result = &v1.MusicLibrary{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied musicLibrary.
+func (c *musicLibraries) Apply(ctx context.Context, musicLibrary *musicv1.MusicLibraryApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicLibrary, err error) {
+	if musicLibrary == nil {
+		return nil, fmt.Errorf("musicLibrary provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(musicLibrary)
+	if err != nil {
+		return nil, err
+	}
+	name := musicLibrary.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicLibrary.Name must be provided to Apply")
+	}
+	result = &v1.MusicLibrary{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *musicLibraries) ApplyStatus(ctx context.Context, musicLibrary *musicv1.MusicLibraryApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicLibrary, err error) {
+	if musicLibrary == nil {
+		return nil, fmt.Errorf("musicLibrary provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(musicLibrary)
+	if err != nil {
+		return nil, err
+	}
+
+	name := musicLibrary.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicLibrary.Name must be provided to Apply")
+	}
+
+	result = &v1.MusicLibrary{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("musiclibraries").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/example/managedapp-operator/api/v1"
+	musicv1 "github.com/example/managedapp-operator/pkg/generated/applyconfiguration/music/v1"
+	scheme "github.com/example/managedapp-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// MusicServicesGetter has a method to return a MusicServiceInterface.
+// A group's client should implement this interface.
+type MusicServicesGetter interface {
+	MusicServices(namespace string) MusicServiceInterface
+}
+
+// MusicServiceInterface has methods to work with MusicService resources.
+type MusicServiceInterface interface {
+	Create(ctx context.Context, musicService *v1.MusicService, opts metav1.CreateOptions) (*v1.MusicService, error)
+	Update(ctx context.Context, musicService *v1.MusicService, opts metav1.UpdateOptions) (*v1.MusicService, error)
+	UpdateStatus(ctx context.Context, musicService *v1.MusicService, opts metav1.UpdateOptions) (*v1.MusicService, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.MusicService, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.MusicServiceList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicService, err error)
+	Apply(ctx context.Context, musicService *musicv1.MusicServiceApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicService, err error)
+	ApplyStatus(ctx context.Context, musicService *musicv1.MusicServiceApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicService, err error)
+	MusicServiceExpansion
+}
+
+// musicServices implements MusicServiceInterface
+type musicServices struct {
+	client rest.Interface
+	ns     string
+}
+
+// newMusicServices returns a MusicServices
+func newMusicServices(c *MusicV1Client, namespace string) *musicServices {
+	return &musicServices{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the musicService, and returns the corresponding musicService object, and an error if there is any.
+func (c *musicServices) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.MusicService, err error) {
+	result = &v1.MusicService{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of MusicServices that match those selectors.
+func (c *musicServices) List(ctx context.Context, opts metav1.ListOptions) (result *v1.MusicServiceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.MusicServiceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("musicservices").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested musicServices.
+func (c *musicServices) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("musicservices").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a musicService and creates it.  Returns the server's representation of the musicService, and an error, if there is any.
+func (c *musicServices) Create(ctx context.Context, musicService *v1.MusicService, opts metav1.CreateOptions) (result *v1.MusicService, err error) {
+	result = &v1.MusicService{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("musicservices").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(musicService).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a musicService and updates it. Returns the server's representation of the musicService, and an error, if there is any.
+func (c *musicServices) Update(ctx context.Context, musicService *v1.MusicService, opts metav1.UpdateOptions) (result *v1.MusicService, err error) {
+	result = &v1.MusicService{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(musicService.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(musicService).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *musicServices) UpdateStatus(ctx context.Context, musicService *v1.MusicService, opts metav1.UpdateOptions) (result *v1.MusicService, err error) {
+	result = &v1.MusicService{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(musicService.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(musicService).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the musicService and deletes it. Returns an error if one occurs.
+func (c *musicServices) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *musicServices) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("musicservices").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched musicService.
+func (c *musicServices) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MusicService, err error) {
+	result = &v1.MusicService{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied musicService.
+func (c *musicServices) Apply(ctx context.Context, musicService *musicv1.MusicServiceApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicService, err error) {
+	if musicService == nil {
+		return nil, fmt.Errorf("musicService provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(musicService)
+	if err != nil {
+		return nil, err
+	}
+	name := musicService.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicService.Name must be provided to Apply")
+	}
+	result = &v1.MusicService{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *musicServices) ApplyStatus(ctx context.Context, musicService *musicv1.MusicServiceApplyConfiguration, opts metav1.ApplyOptions) (result *v1.MusicService, err error) {
+	if musicService == nil {
+		return nil, fmt.Errorf("musicService provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(musicService)
+	if err != nil {
+		return nil, err
+	}
+
+	name := musicService.Name
+	if name == nil {
+		return nil, fmt.Errorf("musicService.Name must be provided to Apply")
+	}
+
+	result = &v1.MusicService{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("musicservices").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	internalinterfaces "github.com/example/managedapp-operator/pkg/generated/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// MusicLibraries returns a MusicLibraryInformer.
+	MusicLibraries() MusicLibraryInformer
+	// MusicServices returns a MusicServiceInformer.
+	MusicServices() MusicServiceInformer
+	// MusicServiceSets returns a MusicServiceSetInformer.
+	MusicServiceSets() MusicServiceSetInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// MusicLibraries returns a MusicLibraryInformer.
+func (v *version) MusicLibraries() MusicLibraryInformer {
+	return &musicLibraryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MusicServices returns a MusicServiceInformer.
+func (v *version) MusicServices() MusicServiceInformer {
+	return &musicServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MusicServiceSets returns a MusicServiceSetInformer.
+func (v *version) MusicServiceSets() MusicServiceSetInformer {
+	return &musicServiceSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
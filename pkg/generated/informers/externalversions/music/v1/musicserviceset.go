@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	versioned "github.com/example/managedapp-operator/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/example/managedapp-operator/pkg/generated/informers/externalversions/internalinterfaces"
+	v1 "github.com/example/managedapp-operator/pkg/generated/listers/music/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MusicServiceSetInformer provides access to a shared informer and lister for
+// MusicServiceSets.
+type MusicServiceSetInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.MusicServiceSetLister
+}
+
+type musicServiceSetInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewMusicServiceSetInformer constructs a new informer for MusicServiceSet type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewMusicServiceSetInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredMusicServiceSetInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredMusicServiceSetInformer constructs a new informer for MusicServiceSet type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredMusicServiceSetInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.MusicV1().MusicServiceSets(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.MusicV1().MusicServiceSets(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&musicv1.MusicServiceSet{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *musicServiceSetInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredMusicServiceSetInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *musicServiceSetInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&musicv1.MusicServiceSet{}, f.defaultInformer)
+}
+
+func (f *musicServiceSetInformer) Lister() v1.MusicServiceSetLister {
+	return v1.NewMusicServiceSetLister(f.Informer().GetIndexer())
+}
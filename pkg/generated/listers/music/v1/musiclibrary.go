@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MusicLibraryLister helps list MusicLibraries.
+// All objects returned here must be treated as read-only.
+type MusicLibraryLister interface {
+	// List lists all MusicLibraries in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MusicLibrary, err error)
+	// MusicLibraries returns an object that can list and get MusicLibraries.
+	MusicLibraries(namespace string) MusicLibraryNamespaceLister
+	MusicLibraryListerExpansion
+}
+
+// musicLibraryLister implements the MusicLibraryLister interface.
+type musicLibraryLister struct {
+	indexer cache.Indexer
+}
+
+// NewMusicLibraryLister returns a new MusicLibraryLister.
+func NewMusicLibraryLister(indexer cache.Indexer) MusicLibraryLister {
+	return &musicLibraryLister{indexer: indexer}
+}
+
+// List lists all MusicLibraries in the indexer.
+func (s *musicLibraryLister) List(selector labels.Selector) (ret []*v1.MusicLibrary, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MusicLibrary))
+	})
+	return ret, err
+}
+
+// MusicLibraries returns an object that can list and get MusicLibraries.
+func (s *musicLibraryLister) MusicLibraries(namespace string) MusicLibraryNamespaceLister {
+	return musicLibraryNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// MusicLibraryNamespaceLister helps list and get MusicLibraries.
+// All objects returned here must be treated as read-only.
+type MusicLibraryNamespaceLister interface {
+	// List lists all MusicLibraries in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MusicLibrary, err error)
+	// Get retrieves the MusicLibrary from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.MusicLibrary, error)
+	MusicLibraryNamespaceListerExpansion
+}
+
+// musicLibraryNamespaceLister implements the MusicLibraryNamespaceLister
+// interface.
+type musicLibraryNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all MusicLibraries in the indexer for a given namespace.
+func (s musicLibraryNamespaceLister) List(selector labels.Selector) (ret []*v1.MusicLibrary, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MusicLibrary))
+	})
+	return ret, err
+}
+
+// Get retrieves the MusicLibrary from the indexer for a given namespace and name.
+func (s musicLibraryNamespaceLister) Get(name string) (*v1.MusicLibrary, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("musiclibrary"), name)
+	}
+	return obj.(*v1.MusicLibrary), nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MusicServiceLister helps list MusicServices.
+// All objects returned here must be treated as read-only.
+type MusicServiceLister interface {
+	// List lists all MusicServices in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MusicService, err error)
+	// MusicServices returns an object that can list and get MusicServices.
+	MusicServices(namespace string) MusicServiceNamespaceLister
+	MusicServiceListerExpansion
+}
+
+// musicServiceLister implements the MusicServiceLister interface.
+type musicServiceLister struct {
+	indexer cache.Indexer
+}
+
+// NewMusicServiceLister returns a new MusicServiceLister.
+func NewMusicServiceLister(indexer cache.Indexer) MusicServiceLister {
+	return &musicServiceLister{indexer: indexer}
+}
+
+// List lists all MusicServices in the indexer.
+func (s *musicServiceLister) List(selector labels.Selector) (ret []*v1.MusicService, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MusicService))
+	})
+	return ret, err
+}
+
+// MusicServices returns an object that can list and get MusicServices.
+func (s *musicServiceLister) MusicServices(namespace string) MusicServiceNamespaceLister {
+	return musicServiceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// MusicServiceNamespaceLister helps list and get MusicServices.
+// All objects returned here must be treated as read-only.
+type MusicServiceNamespaceLister interface {
+	// List lists all MusicServices in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MusicService, err error)
+	// Get retrieves the MusicService from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.MusicService, error)
+	MusicServiceNamespaceListerExpansion
+}
+
+// musicServiceNamespaceLister implements the MusicServiceNamespaceLister
+// interface.
+type musicServiceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all MusicServices in the indexer for a given namespace.
+func (s musicServiceNamespaceLister) List(selector labels.Selector) (ret []*v1.MusicService, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MusicService))
+	})
+	return ret, err
+}
+
+// Get retrieves the MusicService from the indexer for a given namespace and name.
+func (s musicServiceNamespaceLister) Get(name string) (*v1.MusicService, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("musicservice"), name)
+	}
+	return obj.(*v1.MusicService), nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/example/managedapp-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MusicServiceSetLister helps list MusicServiceSets.
+// All objects returned here must be treated as read-only.
+type MusicServiceSetLister interface {
+	// List lists all MusicServiceSets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MusicServiceSet, err error)
+	// MusicServiceSets returns an object that can list and get MusicServiceSets.
+	MusicServiceSets(namespace string) MusicServiceSetNamespaceLister
+	MusicServiceSetListerExpansion
+}
+
+// musicServiceSetLister implements the MusicServiceSetLister interface.
+type musicServiceSetLister struct {
+	indexer cache.Indexer
+}
+
+// NewMusicServiceSetLister returns a new MusicServiceSetLister.
+func NewMusicServiceSetLister(indexer cache.Indexer) MusicServiceSetLister {
+	return &musicServiceSetLister{indexer: indexer}
+}
+
+// List lists all MusicServiceSets in the indexer.
+func (s *musicServiceSetLister) List(selector labels.Selector) (ret []*v1.MusicServiceSet, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MusicServiceSet))
+	})
+	return ret, err
+}
+
+// MusicServiceSets returns an object that can list and get MusicServiceSets.
+func (s *musicServiceSetLister) MusicServiceSets(namespace string) MusicServiceSetNamespaceLister {
+	return musicServiceSetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// MusicServiceSetNamespaceLister helps list and get MusicServiceSets.
+// All objects returned here must be treated as read-only.
+type MusicServiceSetNamespaceLister interface {
+	// List lists all MusicServiceSets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MusicServiceSet, err error)
+	// Get retrieves the MusicServiceSet from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.MusicServiceSet, error)
+	MusicServiceSetNamespaceListerExpansion
+}
+
+// musicServiceSetNamespaceLister implements the MusicServiceSetNamespaceLister
+// interface.
+type musicServiceSetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all MusicServiceSets in the indexer for a given namespace.
+func (s musicServiceSetNamespaceLister) List(selector labels.Selector) (ret []*v1.MusicServiceSet, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MusicServiceSet))
+	})
+	return ret, err
+}
+
+// Get retrieves the MusicServiceSet from the indexer for a given namespace and name.
+func (s musicServiceSetNamespaceLister) Get(name string) (*v1.MusicServiceSet, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("musicserviceset"), name)
+	}
+	return obj.(*v1.MusicServiceSet), nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest render các tài nguyên con của một MusicService thành YAML mà không cần cluster,
+// phục vụ review offline, pre-render cho GitOps và golden-file test.
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+	"github.com/example/managedapp-operator/pkg/builder"
+)
+
+// kindOrder là thứ tự apply: tài nguyên bao ngoài và cấu hình trước, workload sau
+var kindOrder = []string{
+	"Namespace",
+	"ResourceQuota",
+	"NetworkPolicy",
+	"ConfigMap",
+	"PersistentVolumeClaim",
+	"Service",
+	"StatefulSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"CronJob",
+	"Ingress",
+	"PodMonitor",
+}
+
+// Render dựng mọi tài nguyên con của ms, gán apiVersion/kind và sắp xếp ổn định theo kind, namespace rồi name.
+// ms nên là object đã qua webhook defaulting (ví dụ lấy bằng kubectl get -o yaml), nếu không manifest sẽ thiếu giá trị mặc định.
+// opts được truyền cho builder, ví dụ builder.WithImageOverride để render với registry mirror
+func Render(ms *musicv1.MusicService, opts ...builder.Option) ([]client.Object, error) {
+	b := builder.NewResourceBuilder(nil, opts...)
+	objects, err := b.BuildManifests(ms)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objects {
+		gvk, err := apiutil.GVKForObject(obj, b.Scheme())
+		if err != nil {
+			return nil, err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		if ra, rb := kindRank(a), kindRank(b); ra != rb {
+			return ra < rb
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	return objects, nil
+}
+
+// WriteYAML ghi kết quả Render của ms ra w dưới dạng YAML nhiều document, bỏ status và mọi creationTimestamp rỗng
+func WriteYAML(w io.Writer, ms *musicv1.MusicService, opts ...builder.Option) error {
+	objects, err := Render(ms, opts...)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for i, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("convert %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		delete(content, "status")
+		removeEmptyCreationTimestamps(content)
+
+		data, err := yaml.Marshal(content)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// removeEmptyCreationTimestamps xóa creationTimestamp null ở metadata của object và của mọi template lồng bên trong
+// (pod template, volumeClaimTemplates, jobTemplate)
+func removeEmptyCreationTimestamps(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if metadata, ok := v["metadata"].(map[string]interface{}); ok {
+			if timestamp, set := metadata["creationTimestamp"]; set && timestamp == nil {
+				delete(metadata, "creationTimestamp")
+			}
+		}
+		for _, nested := range v {
+			removeEmptyCreationTimestamps(nested)
+		}
+	case []interface{}:
+		for _, nested := range v {
+			removeEmptyCreationTimestamps(nested)
+		}
+	}
+}
+
+func kindRank(obj client.Object) int {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	for i, k := range kindOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(kindOrder)
+}
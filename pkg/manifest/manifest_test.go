@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	musicv1 "github.com/example/managedapp-operator/api/v1"
+)
+
+func newExportMusicService() *musicv1.MusicService {
+	return &musicv1.MusicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "export",
+			Namespace: "default",
+		},
+		Spec: musicv1.MusicServiceSpec{
+			Image:    "nginx:latest",
+			Replicas: 2,
+			Port:     8080,
+			Database: &musicv1.DatabaseSpec{
+				Enabled: true,
+				Image:   "mariadb:10.11",
+				RootPasswordSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "db-root"},
+					Key:                  "password",
+				},
+				Storage:  &musicv1.StorageSpec{Size: "20Gi"},
+				Replicas: 1,
+			},
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	objects, err := Render(newExportMusicService())
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	var got []string
+	for _, obj := range objects {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		if gvk.Version == "" {
+			t.Errorf("expected apiVersion to be set on %s", obj.GetName())
+		}
+		got = append(got, gvk.Kind+"/"+obj.GetNamespace()+"/"+obj.GetName())
+	}
+	// Sắp xếp theo thứ tự apply của kind, rồi namespace và name
+	want := []string{
+		"Service/default/export",
+		"Service/default/export-db-master",
+		"Service/default/export-db-read",
+		"StatefulSet/default/export",
+		"StatefulSet/default/export-db-master",
+		"StatefulSet/default/export-db-replica",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteYAML(&buf, newExportMusicService()); err != nil {
+		t.Fatalf("WriteYAML: %v", err)
+	}
+
+	docs := strings.Split(buf.String(), "---\n")
+	if len(docs) != 6 {
+		t.Fatalf("expected 6 documents separated by ---, got %d:\n%s", len(docs), buf.String())
+	}
+	for i, doc := range docs {
+		content := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(doc), &content); err != nil {
+			t.Fatalf("document %d is not valid YAML: %v", i, err)
+		}
+		if content["apiVersion"] == nil || content["kind"] == nil {
+			t.Errorf("expected apiVersion and kind in document %d, got %v", i, content)
+		}
+		if _, ok := content["status"]; ok {
+			t.Errorf("expected no status in document %d", i)
+		}
+		if strings.Contains(doc, "creationTimestamp") {
+			t.Errorf("expected no creationTimestamp in document %d:\n%s", i, doc)
+		}
+	}
+}